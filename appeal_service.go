@@ -0,0 +1,254 @@
+package community_bl
+
+import (
+	"errors"
+	"fmt"
+	"github.com/satori/go.uuid"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type appealService struct {
+	memberRepository      MemberRepository
+	applicationRepository ApplicationRepository
+	appealRepository      AppealRepository
+	communityService      *communityService
+}
+
+// FileAppeal appeals against the rejection of the last application of the member. Every application can only be appealed once.
+func (s *appealService) FileAppeal(applicationID ApplicationID, statement string, memberID MemberIdentifier) (AppealEntity, error) {
+
+	if strings.TrimSpace(statement) == "" {
+		return AppealEntity{}, errors.New("statement must not be empty")
+	}
+
+	if utf8.RuneCountInString(statement) > MaxApplicationTextLength {
+		return AppealEntity{}, fmt.Errorf("statement must not be longer than %d characters", MaxApplicationTextLength)
+	}
+
+	application, err := s.applicationRepository.FetchByID(applicationID)
+	if err != nil {
+		return AppealEntity{}, err
+	}
+
+	if application == nil {
+		return AppealEntity{}, errors.New("ApplicationDoesNotExist")
+	}
+
+	if application.MemberID != memberID {
+		return AppealEntity{}, AuthorizationErrorNotAllowedToAccessApplication
+	}
+
+	if application.State != ApplicationStateRejected {
+		return AppealEntity{}, errors.New("ApplicationNotRejected")
+	}
+
+	last, err := s.applicationRepository.FetchLast(memberID)
+	if err != nil {
+		return AppealEntity{}, err
+	}
+
+	if last == nil || last.ID != application.ID {
+		return AppealEntity{}, errors.New("ApplicationSuperseded")
+	}
+
+	existing, err := s.appealRepository.FetchByApplication(application.ID)
+	if err != nil {
+		return AppealEntity{}, err
+	}
+
+	if existing != nil {
+		return AppealEntity{}, errors.New("ApplicationAlreadyAppealed")
+	}
+
+	appeal := AppealEntity{
+		ID:            uuid.NewV4(),
+		ApplicationID: application.ID,
+		MemberID:      memberID,
+		Statement:     statement,
+		State:         AppealStatePending,
+		CreatedAt:     time.Now(),
+	}
+
+	if err := s.appealRepository.Save(appeal); err != nil {
+		return AppealEntity{}, err
+	}
+
+	return appeal, nil
+
+}
+
+// OverturnAppeal approves the rejected application and verifies the member.
+// Appeals are decided by a single reviewer that didn't take part in the rejection, even if
+// the review policy requires a quorum. The quorum only applies to the votes on open applications.
+func (s *appealService) OverturnAppeal(appealID uuid.UUID, decision string, reviewerID MemberIdentifier) error {
+
+	appeal, application, reviewer, err := s.authorize(appealID, reviewerID)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(decision) == "" {
+		return errors.New("decision must not be empty")
+	}
+
+	// the application is no longer rejected
+	application.RejectionReason = ""
+	application.RejectedAt = nil
+	application.RejectedBy = nil
+	application.ReapplyAfter = nil
+	application.PermanentlyRejected = false
+
+	// the appeal stays pending if the application can't be approved
+	verified, err := s.communityService.verify(application, []MemberIdentifier{reviewer.ID}, reviewer.ID, decision)
+	if err != nil {
+		return err
+	}
+
+	if err := s.decide(appeal, AppealStateOverturned, decision, reviewer.ID); err != nil {
+		return err
+	}
+
+	// the approval is only reported once the appeal is decided
+	s.communityService.approved(verified)
+
+	return nil
+
+}
+
+// UpholdAppeal confirms the rejection of the application
+func (s *appealService) UpholdAppeal(appealID uuid.UUID, decision string, reviewerID MemberIdentifier) error {
+
+	appeal, _, reviewer, err := s.authorize(appealID, reviewerID)
+	if err != nil {
+		return err
+	}
+
+	return s.decide(appeal, AppealStateUpheld, decision, reviewer.ID)
+
+}
+
+func (s *appealService) decide(appeal *AppealEntity, state AppealState, decision string, reviewerID MemberIdentifier) error {
+
+	if strings.TrimSpace(decision) == "" {
+		return errors.New("decision must not be empty")
+	}
+
+	now := time.Now()
+	appeal.State = state
+	appeal.Decision = decision
+	appeal.DecidedBy = &reviewerID
+	appeal.DecidedAt = &now
+
+	return s.appealRepository.Save(*appeal)
+
+}
+
+// authorize makes sure the reviewer is allowed to decide the appeal.
+// Reviewers that rejected the application can't decide the appeal against their own decision
+// and applicants can't decide the appeal of their own application.
+func (s *appealService) authorize(appealID uuid.UUID, reviewerID MemberIdentifier) (*AppealEntity, *ApplicationEntity, *MemberEntity, error) {
+
+	reviewer, err := s.memberRepository.FetchByID(reviewerID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if reviewer == nil {
+		return nil, nil, nil, errors.New("ReviewerDoesNotExist")
+	}
+
+	if !reviewer.HasPermission(PermissionApplicationsReview) {
+		return nil, nil, nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	appeal, err := s.appealRepository.FetchByID(appealID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if appeal == nil {
+		return nil, nil, nil, errors.New("AppealDoesNotExist")
+	}
+
+	if appeal.State != AppealStatePending {
+		return nil, nil, nil, errors.New("AppealDecided")
+	}
+
+	application, err := s.applicationRepository.FetchByID(appeal.ApplicationID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if application == nil {
+		return nil, nil, nil, fmt.Errorf("couldn't find appealed application (application id: %s)", appeal.ApplicationID.String())
+	}
+
+	// members can't decide the appeal against the rejection of their own application
+	if application.MemberID == reviewer.ID {
+		return nil, nil, nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	for _, rejectedBy := range application.RejectedBy {
+		if rejectedBy == reviewer.ID {
+			return nil, nil, nil, AuthorizationErrorCannotDecideOwnRejection
+		}
+	}
+
+	return appeal, application, reviewer, nil
+
+}
+
+// Appeals returns the appeals in the given state
+func (s *appealService) Appeals(state AppealState, requesterID MemberIdentifier) ([]AppealEntity, error) {
+
+	if !state.Valid() {
+		return nil, fmt.Errorf("appeal state: '%s' is invalid", state)
+	}
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if requester == nil {
+		return nil, errors.New("RequesterNotFound")
+	}
+
+	if !requester.HasPermission(PermissionApplicationsRead) {
+		return nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	return s.appealRepository.FetchByState(state)
+
+}
+
+// ApplicationAppeal returns the appeal against the application to the applicant and reviewers
+func (s *appealService) ApplicationAppeal(applicationID ApplicationID, requesterID MemberIdentifier) (AppealEntity, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return AppealEntity{}, err
+	}
+
+	if requester == nil {
+		return AppealEntity{}, errors.New("RequesterNotFound")
+	}
+
+	appeal, err := s.appealRepository.FetchByApplication(applicationID)
+	if err != nil {
+		return AppealEntity{}, err
+	}
+
+	if appeal == nil {
+		return AppealEntity{}, errors.New("AppealDoesNotExist")
+	}
+
+	if appeal.MemberID != requester.ID && !requester.HasPermission(PermissionApplicationsRead) {
+		return AppealEntity{}, AuthorizationErrorNotAllowedToAccessApplication
+	}
+
+	return *appeal, nil
+
+}
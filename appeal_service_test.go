@@ -0,0 +1,126 @@
+package community_bl
+
+import (
+	"testing"
+)
+
+// rejectAndAppeal rejects the application of the member by the reviewer and files an appeal against it
+func (c *testCommunity) rejectAndAppeal(member MemberEntity, reviewer MemberEntity) (ApplicationEntity, AppealEntity) {
+
+	c.t.Helper()
+
+	application := c.apply(member)
+
+	if err := c.RejectApplication(application.ID, "not yet", reviewer.ID); err != nil {
+		c.t.Fatal(err)
+	}
+
+	appeal, err := c.FileAppeal(application.ID, "please reconsider", member.ID)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	return application, appeal
+
+}
+
+func TestOverturnAppeal(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	rejecting := c.signUp("rejecting", RoleReviewer)
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	member := c.signUp("member")
+
+	application, appeal := c.rejectAndAppeal(member, rejecting)
+
+	_, err := c.FileAppeal(application.ID, "please reconsider", member.ID)
+	expectError(t, err, "ApplicationAlreadyAppealed")
+
+	expectError(t, c.OverturnAppeal(appeal.ID, "approved", rejecting.ID), "CannotDecideOwnRejection")
+	expectError(t, c.OverturnAppeal(appeal.ID, "", reviewer.ID), "decision must not be empty")
+
+	// the approval is reported once the appeal is decided
+	reported := []AppealState{}
+	c.OnApplicationApproved(func(approved MemberEntity) {
+		decided, err := c.ApplicationAppeal(application.ID, reviewer.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reported = append(reported, decided.State)
+	})
+
+	if err := c.OverturnAppeal(appeal.ID, "approved", reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(reported) != 1 || reported[0] != AppealStateOverturned {
+		t.Fatalf("expected the approval to be reported after the decision, got: %v", reported)
+	}
+
+	approved := c.application(application.ID)
+	if approved.State != ApplicationStateApproved || approved.RejectedAt != nil || !c.member(member.ID).Verified {
+		t.Fatalf("expected the application to be approved, got: %s", approved.State)
+	}
+
+	expectError(t, c.UpholdAppeal(appeal.ID, "rejected", reviewer.ID), "AppealDecided")
+
+}
+
+func TestOverturnAppealOfVerifiedMembersIsNotReported(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	rejecting := c.signUp("rejecting", RoleReviewer)
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	member := c.signUp("member", RoleModerator)
+
+	_, appeal := c.rejectAndAppeal(member, rejecting)
+
+	reported := 0
+	c.OnApplicationApproved(func(approved MemberEntity) {
+		reported++
+	})
+
+	if err := c.OverturnAppeal(appeal.ID, "approved", reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if reported != 0 {
+		t.Fatalf("expected the already verified member not to be reported, got: %d", reported)
+	}
+
+}
+
+func TestUpholdAppeal(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	rejecting := c.signUp("rejecting", RoleReviewer)
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	applicant := c.signUp("applicant", RoleReviewer)
+
+	application, appeal := c.rejectAndAppeal(applicant, rejecting)
+
+	// applicants can't decide the appeal of their own application
+	expectError(t, c.OverturnAppeal(appeal.ID, "approved", applicant.ID), "InsufficientPermissions")
+	expectError(t, c.UpholdAppeal(appeal.ID, "rejected", applicant.ID), "InsufficientPermissions")
+
+	if err := c.UpholdAppeal(appeal.ID, "rejected", reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	upheld, err := c.ApplicationAppeal(application.ID, reviewer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if upheld.State != AppealStateUpheld || upheld.DecidedBy == nil || *upheld.DecidedBy != reviewer.ID {
+		t.Fatalf("expected the appeal to be upheld by the reviewer, got: %s", upheld.State)
+	}
+
+	if c.application(application.ID).State != ApplicationStateRejected {
+		t.Fatal("expected the application to stay rejected")
+	}
+
+}
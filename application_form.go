@@ -0,0 +1,301 @@
+package community_bl
+
+import (
+	"errors"
+	"fmt"
+	"github.com/satori/go.uuid"
+	vo "github.com/214alphadev/community-bl/value_objects"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type FormQuestionType string
+
+func (t FormQuestionType) Valid() bool {
+
+	switch t {
+	case FormQuestionTypeText:
+		return true
+	case FormQuestionTypeChoice:
+		return true
+	case FormQuestionTypeURL:
+		return true
+	case FormQuestionTypeFile:
+		return true
+	default:
+		return false
+	}
+
+}
+
+var FormQuestionTypeText = FormQuestionType("Text")
+var FormQuestionTypeChoice = FormQuestionType("Choice")
+var FormQuestionTypeURL = FormQuestionType("URL")
+var FormQuestionTypeFile = FormQuestionType("File")
+
+type FormQuestion struct {
+	// ID identifies the question within the form. Answers reference it.
+	ID       string
+	Label    string
+	Type     FormQuestionType
+	Required bool
+	// MinLength and MaxLength limit the characters of text answers. A MaxLength of zero means unlimited.
+	MinLength uint
+	MaxLength uint
+	// Choices are the options of choice questions
+	Choices []string
+	// MaxFileSize limits the bytes of file answers. Zero means unlimited.
+	MaxFileSize uint
+}
+
+// ApplicationFormEntity is a version of the application form. Published forms never change,
+// a new version is published instead so old applications can still be rendered.
+type ApplicationFormEntity struct {
+	ID          uuid.UUID
+	Version     uint
+	Questions   []FormQuestion
+	PublishedBy MemberIdentifier
+	PublishedAt time.Time
+}
+
+// ApplicationAnswer answers a question of the application form. Files are stored in File, all other answers in Value.
+type ApplicationAnswer struct {
+	QuestionID string
+	Value      string
+	File       *vo.Base64String
+}
+
+// ApplicationFormError is returned if answers don't match the application form
+type ApplicationFormError struct {
+	QuestionID string
+	Reason     string
+}
+
+func (e ApplicationFormError) Error() string {
+	return fmt.Sprintf("invalid answer to question '%s': %s", e.QuestionID, e.Reason)
+}
+
+// InvalidFormError is returned if the questions of a published application form are invalid
+type InvalidFormError struct {
+	Reason string
+}
+
+func (e InvalidFormError) Error() string {
+	return e.Reason
+}
+
+func (f ApplicationFormEntity) Question(id string) (FormQuestion, bool) {
+
+	for _, question := range f.Questions {
+		if question.ID == id {
+			return question, true
+		}
+	}
+
+	return FormQuestion{}, false
+
+}
+
+func (q FormQuestion) validate() error {
+
+	if strings.TrimSpace(q.ID) == "" {
+		return InvalidFormError{Reason: "question id must not be empty"}
+	}
+
+	if strings.TrimSpace(q.Label) == "" {
+		return InvalidFormError{Reason: fmt.Sprintf("label of question '%s' must not be empty", q.ID)}
+	}
+
+	if !q.Type.Valid() {
+		return InvalidFormError{Reason: fmt.Sprintf("type: '%s' of question '%s' is invalid", q.Type, q.ID)}
+	}
+
+	if q.MaxLength > 0 && q.MinLength > q.MaxLength {
+		return InvalidFormError{Reason: fmt.Sprintf("min length of question '%s' exceeds max length", q.ID)}
+	}
+
+	if q.Type == FormQuestionTypeChoice && len(q.Choices) == 0 {
+		return InvalidFormError{Reason: fmt.Sprintf("choice question '%s' needs choices", q.ID)}
+	}
+
+	return nil
+
+}
+
+// validate makes sure the answers match the questions of the form
+func (f ApplicationFormEntity) validate(answers []ApplicationAnswer) error {
+
+	given := map[string]ApplicationAnswer{}
+
+	for _, answer := range answers {
+
+		question, exists := f.Question(answer.QuestionID)
+		if !exists {
+			return ApplicationFormError{QuestionID: answer.QuestionID, Reason: "question doesn't exist"}
+		}
+
+		if _, answered := given[answer.QuestionID]; answered {
+			return ApplicationFormError{QuestionID: answer.QuestionID, Reason: "question has been answered more than once"}
+		}
+
+		given[answer.QuestionID] = answer
+
+		if err := question.validateAnswer(answer); err != nil {
+			return err
+		}
+
+	}
+
+	for _, question := range f.Questions {
+		if _, answered := given[question.ID]; question.Required && !answered {
+			return ApplicationFormError{QuestionID: question.ID, Reason: "answer is required"}
+		}
+	}
+
+	return nil
+
+}
+
+func (q FormQuestion) validateAnswer(answer ApplicationAnswer) error {
+
+	invalid := func(reason string) error {
+		return ApplicationFormError{QuestionID: q.ID, Reason: reason}
+	}
+
+	if q.Type == FormQuestionTypeFile {
+
+		if answer.File == nil || reflect.DeepEqual(*answer.File, vo.Base64String{}) {
+			return invalid("file is missing")
+		}
+
+		if q.MaxFileSize > 0 && uint(len(answer.File.Bytes())) > q.MaxFileSize {
+			return invalid(fmt.Sprintf("file must not be bigger than %d bytes", q.MaxFileSize))
+		}
+
+		return nil
+
+	}
+
+	if answer.File != nil {
+		return invalid("only file questions can be answered with a file")
+	}
+
+	if strings.TrimSpace(answer.Value) == "" {
+		return invalid("answer must not be empty")
+	}
+
+	switch q.Type {
+	case FormQuestionTypeText:
+		length := uint(utf8.RuneCountInString(answer.Value))
+		if length < q.MinLength {
+			return invalid(fmt.Sprintf("answer must be at least %d characters long", q.MinLength))
+		}
+		if q.MaxLength > 0 && length > q.MaxLength {
+			return invalid(fmt.Sprintf("answer must not be longer than %d characters", q.MaxLength))
+		}
+	case FormQuestionTypeChoice:
+		for _, choice := range q.Choices {
+			if choice == answer.Value {
+				return nil
+			}
+		}
+		return invalid("answer is not one of the choices")
+	case FormQuestionTypeURL:
+		u, err := url.ParseRequestURI(answer.Value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return invalid("answer must be a http or https url")
+		}
+	}
+
+	return nil
+
+}
+
+type formService struct {
+	memberRepository          MemberRepository
+	applicationFormRepository ApplicationFormRepository
+}
+
+// PublishApplicationForm publishes a new version of the application form that applies to all new applications
+func (s *formService) PublishApplicationForm(questions []FormQuestion, requesterID MemberIdentifier) (ApplicationFormEntity, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return ApplicationFormEntity{}, err
+	}
+
+	if requester == nil {
+		return ApplicationFormEntity{}, errors.New("RequesterNotFound")
+	}
+
+	if !requester.HasPermission(PermissionApplicationsManage) {
+		return ApplicationFormEntity{}, AuthorizationErrorInsufficientPermissions
+	}
+
+	if len(questions) == 0 {
+		return ApplicationFormEntity{}, InvalidFormError{Reason: "application form needs at least one question"}
+	}
+
+	ids := map[string]bool{}
+	for _, question := range questions {
+		if err := question.validate(); err != nil {
+			return ApplicationFormEntity{}, err
+		}
+		if ids[question.ID] {
+			return ApplicationFormEntity{}, InvalidFormError{Reason: fmt.Sprintf("question id '%s' is used more than once", question.ID)}
+		}
+		ids[question.ID] = true
+	}
+
+	active, err := s.applicationFormRepository.FetchActive()
+	if err != nil {
+		return ApplicationFormEntity{}, err
+	}
+
+	form := ApplicationFormEntity{
+		ID:          uuid.NewV4(),
+		Version:     1,
+		Questions:   questions,
+		PublishedBy: requester.ID,
+		PublishedAt: time.Now(),
+	}
+
+	if active != nil {
+		form.Version = active.Version + 1
+	}
+
+	if err := s.applicationFormRepository.Save(form); err != nil {
+		return ApplicationFormEntity{}, err
+	}
+
+	return form, nil
+
+}
+
+// ApplicationForm returns the given version of the application form. Version zero returns the active form.
+func (s *formService) ApplicationForm(version uint) (ApplicationFormEntity, error) {
+
+	var form *ApplicationFormEntity
+	var err error
+
+	switch version {
+	case 0:
+		form, err = s.applicationFormRepository.FetchActive()
+	default:
+		form, err = s.applicationFormRepository.FetchByVersion(version)
+	}
+
+	if err != nil {
+		return ApplicationFormEntity{}, err
+	}
+
+	if form == nil {
+		return ApplicationFormEntity{}, errors.New("ApplicationFormDoesNotExist")
+	}
+
+	return *form, nil
+
+}
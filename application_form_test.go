@@ -0,0 +1,117 @@
+package community_bl
+
+import (
+	"testing"
+)
+
+var testQuestions = []FormQuestion{
+	{ID: "motivation", Label: "Why do you want to join?", Type: FormQuestionTypeText, Required: true, MinLength: 10},
+	{ID: "referral", Label: "How did you hear about us?", Type: FormQuestionTypeChoice, Choices: []string{"Friend", "Search"}},
+	{ID: "website", Label: "Website", Type: FormQuestionTypeURL},
+}
+
+func TestPublishApplicationForm(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	reviewer := c.signUp("reviewer", RoleReviewer)
+
+	_, err := c.PublishApplicationForm(testQuestions, reviewer.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	_, err = c.PublishApplicationForm(nil, admin.ID)
+	if _, ok := err.(InvalidFormError); !ok {
+		t.Fatalf("expected a form without questions to be invalid, got: %v", err)
+	}
+
+	_, err = c.PublishApplicationForm([]FormQuestion{testQuestions[0], testQuestions[0]}, admin.ID)
+	expectError(t, err, "question id 'motivation' is used more than once")
+
+	_, err = c.PublishApplicationForm([]FormQuestion{{ID: "referral", Label: "Referral", Type: FormQuestionTypeChoice}}, admin.ID)
+	expectError(t, err, "choice question 'referral' needs choices")
+
+	first, err := c.PublishApplicationForm(testQuestions, admin.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := c.PublishApplicationForm(testQuestions[:1], admin.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first.Version != 1 || second.Version != 2 {
+		t.Fatalf("expected the versions to increase, got: %d and %d", first.Version, second.Version)
+	}
+
+	active, err := c.ApplicationForm(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if active.ID != second.ID {
+		t.Fatalf("expected the second form to be active, got version: %d", active.Version)
+	}
+
+	previous, err := c.ApplicationForm(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if previous.ID != first.ID || len(previous.Questions) != 3 {
+		t.Fatalf("expected the first form to be kept, got: %v", previous)
+	}
+
+}
+
+func TestSubmitApplication(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	applicant := c.signUp("applicant")
+
+	_, err := c.SubmitApplication(applicant.ID, nil)
+	expectError(t, err, "ApplicationFormDoesNotExist")
+
+	if _, err := c.PublishApplicationForm(testQuestions, admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.ApplyForVerification("I would like to join", applicant.ID)
+	expectError(t, err, "ApplicationFormRequired")
+
+	cases := []struct {
+		answers []ApplicationAnswer
+		err     string
+	}{
+		{nil, "invalid answer to question 'motivation': answer is required"},
+		{[]ApplicationAnswer{{QuestionID: "motivation", Value: "short"}}, "invalid answer to question 'motivation': answer must be at least 10 characters long"},
+		{[]ApplicationAnswer{{QuestionID: "motivation", Value: "I like the community"}, {QuestionID: "referral", Value: "Newspaper"}}, "invalid answer to question 'referral': answer is not one of the choices"},
+		{[]ApplicationAnswer{{QuestionID: "motivation", Value: "I like the community"}, {QuestionID: "website", Value: "ftp://example.com"}}, "invalid answer to question 'website': answer must be a http or https url"},
+		{[]ApplicationAnswer{{QuestionID: "motivation", Value: "I like the community"}, {QuestionID: "unknown", Value: "answer"}}, "invalid answer to question 'unknown': question doesn't exist"},
+	}
+
+	for _, testCase := range cases {
+		_, err := c.SubmitApplication(applicant.ID, testCase.answers)
+		if _, ok := err.(ApplicationFormError); !ok {
+			t.Fatalf("expected an application form error, got: %v", err)
+		}
+		expectError(t, err, testCase.err)
+	}
+
+	application, err := c.SubmitApplication(applicant.ID, []ApplicationAnswer{
+		{QuestionID: "motivation", Value: "I like the community"},
+		{QuestionID: "referral", Value: "Friend"},
+		{QuestionID: "website", Value: "https://example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if application.FormVersion != 1 || len(application.Answers) != 3 || application.State != ApplicationStatePending {
+		t.Fatalf("expected a pending application for the first form, got: %v", application)
+	}
+
+}
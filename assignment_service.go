@@ -0,0 +1,334 @@
+package community_bl
+
+import (
+	"errors"
+	"time"
+)
+
+type AssignmentStrategy string
+
+// AssignmentStrategyRoundRobin assigns new applications to the reviewers in turn
+var AssignmentStrategyRoundRobin = AssignmentStrategy("RoundRobin")
+
+// AssignmentStrategyLeastLoaded assigns new applications to the reviewer with the fewest open claims
+var AssignmentStrategyLeastLoaded = AssignmentStrategy("LeastLoaded")
+
+func (s AssignmentStrategy) Valid() bool {
+
+	switch s {
+	case AssignmentStrategyRoundRobin:
+		return true
+	case AssignmentStrategyLeastLoaded:
+		return true
+	default:
+		return false
+	}
+
+}
+
+// AssignmentPolicy controls how reviewers claim applications. Claims are advisory: they organize the
+// review queues, but don't keep other reviewers from voting, since a quorum needs the votes of several reviewers.
+type AssignmentPolicy struct {
+	// ClaimDuration is how long a claim lasts before the application is released again.
+	// Zero means claims don't expire.
+	ClaimDuration time.Duration
+	// AutoAssign assigns every submitted application to a reviewer. New applications
+	// stay unassigned if it's empty.
+	AutoAssign AssignmentStrategy
+}
+
+type assignmentService struct {
+	memberRepository           MemberRepository
+	applicationRepository      ApplicationRepository
+	assignmentCursorRepository AssignmentCursorRepository
+	assignmentPolicy           AssignmentPolicy
+	onAutoAssignFailed         []func(application ApplicationEntity, err error)
+}
+
+func claimActive(application ApplicationEntity, now time.Time) bool {
+	return application.AssignedTo != nil && (application.ClaimExpiresAt == nil || now.Before(*application.ClaimExpiresAt))
+}
+
+func (s *assignmentService) reviewer(reviewerID MemberIdentifier) (*MemberEntity, error) {
+
+	reviewer, err := s.memberRepository.FetchByID(reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if reviewer == nil {
+		return nil, errors.New("ReviewerDoesNotExist")
+	}
+
+	if !reviewer.HasPermission(PermissionApplicationsReview) {
+		return nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	return reviewer, nil
+
+}
+
+// ClaimApplication assigns the open application to the reviewer. Claims of other reviewers
+// have to expire or be released before the application can be claimed.
+func (s *assignmentService) ClaimApplication(applicationID ApplicationID, reviewerID MemberIdentifier) (ApplicationEntity, error) {
+
+	reviewer, err := s.reviewer(reviewerID)
+	if err != nil {
+		return ApplicationEntity{}, err
+	}
+
+	application, err := s.applicationRepository.FetchByID(applicationID)
+	if err != nil {
+		return ApplicationEntity{}, err
+	}
+
+	if application == nil {
+		return ApplicationEntity{}, errors.New("ApplicationDoesNotExist")
+	}
+
+	if !application.State.open() {
+		return ApplicationEntity{}, errors.New("ApplicationReviewed")
+	}
+
+	if application.MemberID == reviewer.ID {
+		return ApplicationEntity{}, AuthorizationErrorInsufficientPermissions
+	}
+
+	now := time.Now()
+
+	if claimActive(*application, now) && *application.AssignedTo != reviewer.ID {
+		return ApplicationEntity{}, errors.New("ApplicationClaimed")
+	}
+
+	if err := s.assign(application, reviewer.ID, now); err != nil {
+		return ApplicationEntity{}, err
+	}
+
+	return *application, nil
+
+}
+
+// UnclaimApplication releases the claim. Only the assigned reviewer and members
+// that manage applications can release a claim.
+func (s *assignmentService) UnclaimApplication(applicationID ApplicationID, requesterID MemberIdentifier) error {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return err
+	}
+
+	if requester == nil {
+		return errors.New("RequesterNotFound")
+	}
+
+	application, err := s.applicationRepository.FetchByID(applicationID)
+	if err != nil {
+		return err
+	}
+
+	if application == nil {
+		return errors.New("ApplicationDoesNotExist")
+	}
+
+	if !application.State.open() || !claimActive(*application, time.Now()) {
+		return errors.New("ApplicationNotClaimed")
+	}
+
+	if *application.AssignedTo != requester.ID && !requester.HasPermission(PermissionApplicationsManage) {
+		return AuthorizationErrorInsufficientPermissions
+	}
+
+	return s.release(application)
+
+}
+
+// ReviewQueue returns the open applications claimed by the reviewer, oldest first
+func (s *assignmentService) ReviewQueue(reviewerID MemberIdentifier) ([]ApplicationEntity, error) {
+
+	reviewer, err := s.reviewer(reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	query := ApplicationsQuery{
+		States:         []ApplicationState{ApplicationStatePending, ApplicationStateInformationRequested},
+		AssignedTo:     &reviewer.ID,
+		ClaimsActiveAt: &now,
+	}
+
+	queue := []ApplicationEntity{}
+	var after *ApplicationsCursor
+
+	for {
+
+		applications, err := s.applicationRepository.FetchByQuery(query, after, MaxApplicationsQueryNext)
+		if err != nil {
+			return nil, err
+		}
+
+		queue = append(queue, applications...)
+
+		if len(applications) < MaxApplicationsQueryNext {
+			break
+		}
+
+		last := applications[len(applications)-1]
+		after = &ApplicationsCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+
+	}
+
+	return queue, nil
+
+}
+
+// autoAssign assigns a freshly submitted application according to the assignment policy
+func (s *assignmentService) autoAssign(application *ApplicationEntity) error {
+
+	if !s.assignmentPolicy.AutoAssign.Valid() {
+		return nil
+	}
+
+	now := time.Now()
+
+	if err := s.releaseExpiredClaims(now); err != nil {
+		return err
+	}
+
+	candidates, err := s.candidates(application.MemberID)
+	if err != nil {
+		return err
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	var reviewer MemberIdentifier
+
+	switch s.assignmentPolicy.AutoAssign {
+	case AssignmentStrategyRoundRobin:
+
+		reviewer = candidates[0].ID
+
+		cursor, err := s.assignmentCursorRepository.Fetch()
+		if err != nil {
+			return err
+		}
+
+		if cursor != nil {
+			for _, candidate := range candidates {
+				if candidate.ID.String() > cursor.String() {
+					reviewer = candidate.ID
+					break
+				}
+			}
+		}
+
+	case AssignmentStrategyLeastLoaded:
+
+		lowest := -1
+		for _, candidate := range candidates {
+
+			load, err := s.applicationRepository.CountClaimed(candidate.ID)
+			if err != nil {
+				return err
+			}
+
+			if lowest == -1 || int(load) < lowest {
+				lowest = int(load)
+				reviewer = candidate.ID
+			}
+
+		}
+
+	}
+
+	if err := s.assign(application, reviewer, now); err != nil {
+		return err
+	}
+
+	if s.assignmentPolicy.AutoAssign == AssignmentStrategyRoundRobin {
+		return s.assignmentCursorRepository.Save(reviewer)
+	}
+
+	return nil
+
+}
+
+// autoAssignFailed reports an application that couldn't be assigned. The application has been submitted
+// at this point and stays unassigned, so the failure doesn't fail the submission.
+func (s *assignmentService) autoAssignFailed(application ApplicationEntity, err error) {
+	for _, onAutoAssignFailed := range s.onAutoAssignFailed {
+		onAutoAssignFailed(application, err)
+	}
+}
+
+func (s *assignmentService) OnAutoAssignFailed(cb func(application ApplicationEntity, err error)) {
+	s.onAutoAssignFailed = append(s.onAutoAssignFailed, cb)
+}
+
+// candidates returns the reviewers in good standing ordered by their id. The applicant is never a candidate.
+func (s *assignmentService) candidates(applicant MemberIdentifier) ([]MemberEntity, error) {
+
+	reviewers, err := membersWithPermission(s.memberRepository, PermissionApplicationsReview)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []MemberEntity{}
+	for _, reviewer := range reviewers {
+		if reviewer.ID != applicant {
+			candidates = append(candidates, reviewer)
+		}
+	}
+
+	return candidates, nil
+
+}
+
+func (s *assignmentService) assign(application *ApplicationEntity, reviewer MemberIdentifier, now time.Time) error {
+
+	application.AssignedTo = &reviewer
+	application.AssignedAt = &now
+	application.ClaimExpiresAt = nil
+
+	if s.assignmentPolicy.ClaimDuration > 0 {
+		expiresAt := now.Add(s.assignmentPolicy.ClaimDuration)
+		application.ClaimExpiresAt = &expiresAt
+	}
+
+	return s.applicationRepository.Save(*application)
+
+}
+
+func (s *assignmentService) release(application *ApplicationEntity) error {
+
+	application.AssignedTo = nil
+	application.AssignedAt = nil
+	application.ClaimExpiresAt = nil
+
+	return s.applicationRepository.Save(*application)
+
+}
+
+// releaseExpiredClaims releases the claims that expired before now, so that expired
+// claims don't count towards the load of a reviewer. Queries treat expired claims as
+// released on their own, so it's only called before writes.
+func (s *assignmentService) releaseExpiredClaims(now time.Time) error {
+
+	applications, err := s.applicationRepository.FetchExpiredClaims(now)
+	if err != nil {
+		return err
+	}
+
+	for _, application := range applications {
+		if err := s.release(&application); err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
@@ -0,0 +1,283 @@
+package community_bl
+
+import (
+	"testing"
+	"time"
+)
+
+func withAssignmentPolicy(policy AssignmentPolicy) func(dependencies *Dependencies) {
+	return func(dependencies *Dependencies) {
+		dependencies.AssignmentPolicy = policy
+	}
+}
+
+func TestClaimApplication(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	first := c.signUp("first", RoleReviewer)
+	second := c.signUp("second", RoleReviewer)
+	moderator := c.signUp("moderator", RoleModerator)
+	applicant := c.signUp("applicant", RoleReviewer)
+	application := c.apply(applicant)
+
+	_, err := c.ClaimApplication(application.ID, moderator.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	// reviewers can't claim their own application
+	_, err = c.ClaimApplication(application.ID, applicant.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	claimed, err := c.ClaimApplication(application.ID, first.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if claimed.AssignedTo == nil || *claimed.AssignedTo != first.ID || claimed.ClaimExpiresAt != nil {
+		t.Fatalf("expected a claim of %s without expiry, got: %v", first.ID.String(), claimed.AssignedTo)
+	}
+
+	// claiming again is a no-op for the assigned reviewer
+	if _, err := c.ClaimApplication(application.ID, first.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.ClaimApplication(application.ID, second.ID)
+	expectError(t, err, "ApplicationClaimed")
+
+	// claims are advisory and don't keep other reviewers from voting
+	if err := c.ApproveApplication(application.ID, second.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.ClaimApplication(application.ID, first.ID)
+	expectError(t, err, "ApplicationReviewed")
+
+}
+
+func TestExpiredClaimsAreTreatedAsReleased(t *testing.T) {
+
+	c := newTestCommunity(t, withAssignmentPolicy(AssignmentPolicy{ClaimDuration: time.Hour}))
+
+	first := c.signUp("first", RoleReviewer)
+	second := c.signUp("second", RoleReviewer)
+	applicant := c.signUp("applicant")
+	application := c.apply(applicant)
+
+	claimed, err := c.ClaimApplication(application.ID, first.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if claimed.ClaimExpiresAt == nil || !claimed.ClaimExpiresAt.After(time.Now()) {
+		t.Fatalf("expected the claim to expire in the future, got: %v", claimed.ClaimExpiresAt)
+	}
+
+	queue, err := c.ReviewQueue(first.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(queue) != 1 || queue[0].ID != application.ID {
+		t.Fatalf("expected the claimed application in the queue, got: %v", queue)
+	}
+
+	expired := time.Now().Add(-time.Minute)
+	claimed.ClaimExpiresAt = &expired
+	if err := c.applications.Save(claimed); err != nil {
+		t.Fatal(err)
+	}
+
+	queue, err = c.ReviewQueue(first.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(queue) != 0 {
+		t.Fatalf("expected the expired claim to be left out of the queue, got: %v", queue)
+	}
+
+	unassigned, err := c.Applications(ApplicationsQuery{Unassigned: true}, first.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(unassigned.Applications) != 1 || unassigned.Applications[0].ID != application.ID {
+		t.Fatalf("expected the expired claim to be unassigned, got: %v", unassigned.Applications)
+	}
+
+	// reading doesn't release the claim
+	if c.application(application.ID).AssignedTo == nil {
+		t.Fatal("expected the expired claim to be kept by reads")
+	}
+
+	expectError(t, c.UnclaimApplication(application.ID, first.ID), "ApplicationNotClaimed")
+
+	if _, err := c.ClaimApplication(application.ID, second.ID); err != nil {
+		t.Fatalf("expected the expired claim to be claimable, got: %v", err)
+	}
+
+}
+
+func TestUnclaimApplication(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	first := c.signUp("first", RoleReviewer)
+	second := c.signUp("second", RoleReviewer)
+	admin := c.signUp("admin", RoleAdmin)
+	applicant := c.signUp("applicant")
+	application := c.apply(applicant)
+
+	expectError(t, c.UnclaimApplication(application.ID, first.ID), "ApplicationNotClaimed")
+
+	if _, err := c.ClaimApplication(application.ID, first.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	expectError(t, c.UnclaimApplication(application.ID, second.ID), "InsufficientPermissions")
+
+	if err := c.UnclaimApplication(application.ID, first.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.application(application.ID).AssignedTo != nil {
+		t.Fatal("expected the claim to be released")
+	}
+
+	if _, err := c.ClaimApplication(application.ID, second.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// members that manage applications can release the claims of others
+	if err := c.UnclaimApplication(application.ID, admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.application(application.ID).AssignedTo != nil {
+		t.Fatal("expected the claim to be released")
+	}
+
+}
+
+func TestRoundRobinAssignment(t *testing.T) {
+
+	cursor := &memoryAssignmentCursorRepository{}
+
+	c := newTestCommunity(t, withAssignmentPolicy(AssignmentPolicy{AutoAssign: AssignmentStrategyRoundRobin}), func(dependencies *Dependencies) {
+		dependencies.AssignmentCursorRepository = cursor
+	})
+
+	first := c.signUp("first", RoleReviewer)
+	second := c.signUp("second", RoleReviewer)
+
+	// reviewers take turns in the order of their ids
+	if second.ID.String() < first.ID.String() {
+		first, second = second, first
+	}
+
+	expected := []MemberIdentifier{first.ID, second.ID, first.ID}
+
+	for i, username := range []string{"a", "b", "c"} {
+
+		application := c.apply(c.signUp(username))
+
+		if application.AssignedTo == nil || *application.AssignedTo != expected[i] {
+			t.Fatalf("expected application %d to be assigned to %s, got: %v", i, expected[i].String(), application.AssignedTo)
+		}
+
+		if c.application(application.ID).AssignedTo == nil {
+			t.Fatal("expected the assignment to be saved")
+		}
+
+	}
+
+	// claims don't move the cursor
+	unassigned := c.apply(c.signUp("d"))
+	if err := c.UnclaimApplication(unassigned.ID, *unassigned.AssignedTo); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ClaimApplication(unassigned.ID, first.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if cursor.cursor == nil || *cursor.cursor != second.ID {
+		t.Fatalf("expected the cursor to stay at the last assigned reviewer, got: %v", cursor.cursor)
+	}
+
+}
+
+func TestLeastLoadedAssignment(t *testing.T) {
+
+	c := newTestCommunity(t, withAssignmentPolicy(AssignmentPolicy{AutoAssign: AssignmentStrategyLeastLoaded}))
+
+	busy := c.signUp("busy", RoleReviewer)
+	idle := c.signUp("idle", RoleReviewer)
+
+	claimed := c.apply(c.signUp("claimed"))
+	if claimed.AssignedTo != nil && *claimed.AssignedTo != busy.ID {
+		if err := c.UnclaimApplication(claimed.ID, *claimed.AssignedTo); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.ClaimApplication(claimed.ID, busy.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	application := c.apply(c.signUp("applicant"))
+
+	if application.AssignedTo == nil || *application.AssignedTo != idle.ID {
+		t.Fatalf("expected the application to be assigned to the idle reviewer, got: %v", application.AssignedTo)
+	}
+
+}
+
+func TestFailedAutoAssignDoesntFailTheSubmission(t *testing.T) {
+
+	c := newTestCommunity(t, withAssignmentPolicy(AssignmentPolicy{AutoAssign: AssignmentStrategyRoundRobin}), func(dependencies *Dependencies) {
+		dependencies.AssignmentCursorRepository = &memoryAssignmentCursorRepository{unavailable: true}
+	})
+
+	c.signUp("reviewer", RoleReviewer)
+
+	failed := 0
+	c.OnAutoAssignFailed(func(application ApplicationEntity, err error) {
+		failed++
+	})
+
+	submitted := 0
+	c.OnApplicationSubmitted(func(application ApplicationEntity) {
+		submitted++
+	})
+
+	application := c.apply(c.signUp("applicant"))
+
+	if failed != 1 || submitted != 1 {
+		t.Fatalf("expected the failed assignment and the submission to be reported, got: %d %d", failed, submitted)
+	}
+
+	if application.AssignedTo != nil || c.application(application.ID).AssignedTo != nil {
+		t.Fatal("expected the application to stay unassigned")
+	}
+
+}
+
+func TestRoundRobinRequiresAssignmentCursorRepository(t *testing.T) {
+
+	var dependencies Dependencies
+	newTestCommunity(t, func(d *Dependencies) {
+		dependencies = *d
+	})
+
+	dependencies.AssignmentCursorRepository = nil
+
+	if _, err := NewCommunity(dependencies); err != nil {
+		t.Fatalf("expected the cursor to be optional without round robin, got: %v", err)
+	}
+
+	dependencies.AssignmentPolicy.AutoAssign = AssignmentStrategyRoundRobin
+
+	_, err := NewCommunity(dependencies)
+	expectError(t, err, "missing assignment cursor repository")
+
+}
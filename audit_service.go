@@ -0,0 +1,318 @@
+package community_bl
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/satori/go.uuid"
+	vo "github.com/214alphadev/community-bl/value_objects"
+	"strings"
+	"sync"
+	"time"
+)
+
+type AuditAction string
+
+var AuditActionLogin = AuditAction("member.login")
+var AuditActionAccessTokenRevoked = AuditAction("access_token.revoked")
+var AuditActionConfirmationCodeResent = AuditAction("confirmation_code.resent")
+var AuditActionConfirmationCodesInvalidated = AuditAction("confirmation_code.invalidated")
+var AuditActionProfileChanged = AuditAction("member.profile_changed")
+var AuditActionMemberVerified = AuditAction("member.verified")
+var AuditActionMemberDeleted = AuditAction("member.deleted")
+var AuditActionMemberErased = AuditAction("member.erased")
+var AuditActionMemberDeletionCancelled = AuditAction("member.deletion_cancelled")
+var AuditActionMemberExported = AuditAction("member.exported")
+var AuditActionMemberSuspended = AuditAction("member.suspended")
+var AuditActionMemberBanned = AuditAction("member.banned")
+var AuditActionMemberUnsuspended = AuditAction("member.unsuspended")
+var AuditActionBanLifted = AuditAction("member.ban_lifted")
+var AuditActionVerificationRevoked = AuditAction("member.verification_revoked")
+var AuditActionMembersRead = AuditAction("members.read")
+var AuditActionRoleGranted = AuditAction("role.granted")
+var AuditActionRoleRevoked = AuditAction("role.revoked")
+var AuditActionInvitationCreated = AuditAction("invitation.created")
+var AuditActionInvitationRevoked = AuditAction("invitation.revoked")
+var AuditActionApplicationReviewed = AuditAction("application.reviewed")
+var AuditActionApplicationVoted = AuditAction("application.voted")
+var AuditActionApplicationApproved = AuditAction("application.approved")
+var AuditActionApplicationClaimed = AuditAction("application.claimed")
+var AuditActionApplicationUnclaimed = AuditAction("application.unclaimed")
+var AuditActionInformationRequested = AuditAction("application.information_requested")
+var AuditActionApplicationExpired = AuditAction("application.expired")
+var AuditActionApplicationsRead = AuditAction("applications.read")
+var AuditActionApplicationFormPublished = AuditAction("application_form.published")
+var AuditActionAppealDecided = AuditAction("appeal.decided")
+var AuditActionAuditLogRead = AuditAction("audit_log.read")
+var AuditActionStatsRead = AuditAction("stats.read")
+
+type AuditOutcome string
+
+var AuditOutcomeSucceeded = AuditOutcome("Succeeded")
+var AuditOutcomeFailed = AuditOutcome("Failed")
+
+// AuditOutcomeDenied is recorded for failed authorization attempts
+var AuditOutcomeDenied = AuditOutcome("Denied")
+
+// AuditEntryEntity is an entry of the audit log. Every entry includes the hash of its
+// predecessor, so changing or removing an entry breaks the chain of all following entries.
+type AuditEntryEntity struct {
+	ID uuid.UUID
+	// Sequence starts at one and has no gaps
+	Sequence uint64
+	Action   AuditAction
+	// Actor is nil if the action hasn't been taken by an authenticated member,
+	// e.g. Promote called by the host application or a failed login.
+	Actor *MemberIdentifier
+	// Target is the id of the member, application, invitation or appeal the action affected
+	Target       *uuid.UUID
+	Outcome      AuditOutcome
+	Detail       string
+	OccurredAt   time.Time
+	PreviousHash string
+	Hash         string
+}
+
+func (e AuditEntryEntity) computeHash() string {
+
+	actor := ""
+	if e.Actor != nil {
+		actor = e.Actor.String()
+	}
+
+	target := ""
+	if e.Target != nil {
+		target = e.Target.String()
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		e.PreviousHash,
+		e.ID.String(),
+		fmt.Sprint(e.Sequence),
+		string(e.Action),
+		actor,
+		target,
+		string(e.Outcome),
+		e.Detail,
+		// seconds only, so repositories don't need to keep the full precision
+		fmt.Sprint(e.OccurredAt.Unix()),
+	}, "\n")))
+
+	return hex.EncodeToString(sum[:])
+
+}
+
+type AuditLogQuery struct {
+	// Position is the sequence of the entry to start after
+	Position *uint64
+	Next     uint
+	Actor    *MemberIdentifier
+	Target   *uuid.UUID
+	Action   AuditAction
+	Outcome  AuditOutcome
+	Since    *time.Time
+	Until    *time.Time
+}
+
+// AuditLogTamperedError is returned by VerifyAuditLog for the first entry that doesn't match the chain
+type AuditLogTamperedError struct {
+	Sequence uint64
+}
+
+func (e AuditLogTamperedError) Error() string {
+	return fmt.Sprintf("audit log has been tampered with at entry %d", e.Sequence)
+}
+
+type auditService struct {
+	auditLogRepository AuditLogRepository
+	memberRepository   MemberRepository
+	// lock serializes appending so that two entries never share a predecessor
+	lock          sync.Mutex
+	onAuditFailed []func(action AuditAction, err error)
+}
+
+// record appends an entry for an action that ended with err. The error of the action is returned unchanged.
+// The action has already been committed at this point, so an entry that couldn't be appended is reported
+// to the OnAuditFailed callbacks instead of failing the action.
+func (s *auditService) record(action AuditAction, actor *MemberIdentifier, target *uuid.UUID, detail string, err error) error {
+
+	if auditErr := s.append(action, actor, target, outcomeOf(err), detail); auditErr != nil {
+		s.failed(action, auditErr)
+	}
+
+	return err
+
+}
+
+func (s *auditService) failed(action AuditAction, err error) {
+	for _, onAuditFailed := range s.onAuditFailed {
+		onAuditFailed(action, err)
+	}
+}
+
+func (s *auditService) OnAuditFailed(cb func(action AuditAction, err error)) {
+	s.onAuditFailed = append(s.onAuditFailed, cb)
+}
+
+// recordDenied only records failed authorization attempts. It's used for reads.
+func (s *auditService) recordDenied(action AuditAction, actor MemberIdentifier, target *uuid.UUID, err error) error {
+
+	if outcomeOf(err) != AuditOutcomeDenied {
+		return err
+	}
+
+	if auditErr := s.append(action, &actor, target, AuditOutcomeDenied, ""); auditErr != nil {
+		s.failed(action, auditErr)
+	}
+
+	return err
+
+}
+
+// memberByEmailAddress resolves the target of actions that address members by their email address
+func (s *auditService) memberByEmailAddress(emailAddress vo.EmailAddress) *uuid.UUID {
+
+	member, err := s.memberRepository.FetchByEmailAddress(emailAddress)
+	if err != nil || member == nil {
+		return nil
+	}
+
+	return &member.ID
+
+}
+
+func (s *auditService) append(action AuditAction, actor *MemberIdentifier, target *uuid.UUID, outcome AuditOutcome, detail string) error {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// the target of entities that couldn't be created
+	if target != nil && *target == uuid.Nil {
+		target = nil
+	}
+
+	last, err := s.auditLogRepository.Last()
+	if err != nil {
+		return err
+	}
+
+	entry := AuditEntryEntity{
+		ID:         uuid.NewV4(),
+		Sequence:   1,
+		Action:     action,
+		Actor:      actor,
+		Target:     target,
+		Outcome:    outcome,
+		Detail:     detail,
+		OccurredAt: time.Now(),
+	}
+
+	if last != nil {
+		entry.Sequence = last.Sequence + 1
+		entry.PreviousHash = last.Hash
+	}
+
+	entry.Hash = entry.computeHash()
+
+	return s.auditLogRepository.Append(entry)
+
+}
+
+func outcomeOf(err error) AuditOutcome {
+
+	if err == nil {
+		return AuditOutcomeSucceeded
+	}
+
+	switch err.(type) {
+	case MemberSuspendedError, MemberBannedError:
+		return AuditOutcomeDenied
+	}
+
+	switch err {
+	case AuthorizationErrorInsufficientPermissions,
+		AuthorizationErrorNotAllowedToAccessApplication,
+		AuthorizationErrorCannotDecideOwnRejection:
+		return AuditOutcomeDenied
+	}
+
+	return AuditOutcomeFailed
+
+}
+
+func (s *auditService) authorize(requesterID MemberIdentifier) error {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return err
+	}
+
+	if requester == nil {
+		return errors.New("RequesterNotFound")
+	}
+
+	if !requester.HasPermission(PermissionAuditRead) {
+		return AuthorizationErrorInsufficientPermissions
+	}
+
+	return nil
+
+}
+
+func (s *auditService) AuditLog(query AuditLogQuery, requesterID MemberIdentifier) ([]AuditEntryEntity, error) {
+
+	if err := s.authorize(requesterID); err != nil {
+		return nil, err
+	}
+
+	if query.Next == 0 || query.Next > 100 {
+		query.Next = 100
+	}
+
+	return s.auditLogRepository.FetchByQuery(query)
+
+}
+
+// VerifyAuditLog walks the whole chain and recomputes the hash of every entry. Removing entries from
+// the end of the log can only be detected by comparing the hash of the last entry with a copy kept elsewhere.
+func (s *auditService) VerifyAuditLog(requesterID MemberIdentifier) error {
+
+	if err := s.authorize(requesterID); err != nil {
+		return err
+	}
+
+	query := AuditLogQuery{Next: 100}
+	previousHash := ""
+	expectedSequence := uint64(1)
+
+	for {
+
+		entries, err := s.auditLogRepository.FetchByQuery(query)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+
+			if entry.Sequence != expectedSequence || entry.PreviousHash != previousHash || entry.computeHash() != entry.Hash {
+				return AuditLogTamperedError{Sequence: expectedSequence}
+			}
+
+			previousHash = entry.Hash
+			expectedSequence++
+
+		}
+
+		if uint(len(entries)) < query.Next {
+			break
+		}
+
+		position := entries[len(entries)-1].Sequence
+		query.Position = &position
+
+	}
+
+	return nil
+
+}
@@ -0,0 +1,185 @@
+package community_bl
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAuditEntriesAreChained(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	member := c.signUp("member")
+
+	if err := c.GrantRole(member.ID, RoleReviewer, admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// spans more than one page of VerifyAuditLog
+	for i := 0; i < 150; i++ {
+		c.auditService.record(AuditActionRoleGranted, &admin.ID, &member.ID, "", nil)
+	}
+
+	previousHash := ""
+	for i, entry := range c.auditLog.entries {
+
+		if entry.Sequence != uint64(i+1) {
+			t.Fatalf("expected sequence %d, got: %d", i+1, entry.Sequence)
+		}
+
+		if entry.PreviousHash != previousHash || entry.Hash != entry.computeHash() {
+			t.Fatalf("expected entry %d to be linked to its predecessor", entry.Sequence)
+		}
+
+		previousHash = entry.Hash
+
+	}
+
+	if err := c.VerifyAuditLog(admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+func TestVerifyAuditLogDetectsTampering(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	member := c.signUp("member")
+
+	for i := 0; i < 120; i++ {
+		c.auditService.record(AuditActionRoleGranted, &admin.ID, &member.ID, "", nil)
+	}
+
+	entries := append([]AuditEntryEntity{}, c.auditLog.entries...)
+
+	// an altered entry
+	c.auditLog.entries[109].Detail = "altered"
+
+	err := c.VerifyAuditLog(admin.ID)
+	if e, ok := err.(AuditLogTamperedError); !ok || e.Sequence != 110 {
+		t.Fatalf("expected the altered entry to be detected, got: %v", err)
+	}
+
+	// a removed entry
+	c.auditLog.entries = append(append([]AuditEntryEntity{}, entries[:49]...), entries[50:]...)
+
+	err = c.VerifyAuditLog(admin.ID)
+	if e, ok := err.(AuditLogTamperedError); !ok || e.Sequence != 50 {
+		t.Fatalf("expected the removed entry to be detected, got: %v", err)
+	}
+
+	// an entry whose hash has been recomputed after altering it
+	c.auditLog.entries = append([]AuditEntryEntity{}, entries...)
+	c.auditLog.entries[9].Detail = "altered"
+	c.auditLog.entries[9].Hash = c.auditLog.entries[9].computeHash()
+
+	err = c.VerifyAuditLog(admin.ID)
+	if e, ok := err.(AuditLogTamperedError); !ok || e.Sequence != 11 {
+		t.Fatalf("expected the broken link to be detected, got: %v", err)
+	}
+
+}
+
+func TestVerifyAuditLogRequiresPermission(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	moderator := c.signUp("moderator", RoleModerator)
+
+	expectError(t, c.VerifyAuditLog(moderator.ID), "InsufficientPermissions")
+
+	last := c.auditLog.entries[len(c.auditLog.entries)-1]
+	if last.Action != AuditActionAuditLogRead || last.Outcome != AuditOutcomeDenied {
+		t.Fatalf("expected the denied read to be recorded, got: %s %s", last.Action, last.Outcome)
+	}
+
+}
+
+func TestAuditFailureDoesntFailTheAction(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	member := c.signUp("member")
+
+	failed := []AuditAction{}
+	c.OnAuditFailed(func(action AuditAction, err error) {
+		failed = append(failed, action)
+	})
+
+	c.auditLog.unavailable = true
+
+	if err := c.GrantRole(member.ID, RoleReviewer, admin.ID); err != nil {
+		t.Fatalf("expected the action to succeed, got: %v", err)
+	}
+
+	if !c.member(member.ID).HasRole(RoleReviewer) {
+		t.Fatal("expected the role to be granted")
+	}
+
+	if len(failed) != 1 || failed[0] != AuditActionRoleGranted {
+		t.Fatalf("expected the failed audit to be reported, got: %v", failed)
+	}
+
+	// the error of the action is returned unchanged
+	expectError(t, c.GrantRole(member.ID, RoleReviewer, admin.ID), "RoleAlreadyGranted")
+
+	c.auditLog.unavailable = false
+
+	if err := c.VerifyAuditLog(admin.ID); err != nil {
+		t.Fatalf("expected the chain to stay intact, got: %v", err)
+	}
+
+}
+
+func TestOutcomeOfDenials(t *testing.T) {
+
+	cases := []struct {
+		err     error
+		outcome AuditOutcome
+	}{
+		{nil, AuditOutcomeSucceeded},
+		{AuthorizationErrorInsufficientPermissions, AuditOutcomeDenied},
+		{AuthorizationErrorNotAllowedToAccessApplication, AuditOutcomeDenied},
+		{AuthorizationErrorCannotDecideOwnRejection, AuditOutcomeDenied},
+		{MemberBannedError{}, AuditOutcomeDenied},
+		{MemberSuspendedError{}, AuditOutcomeDenied},
+		// errors that only share the message are not denials
+		{errors.New("InsufficientPermissions"), AuditOutcomeFailed},
+		{errors.New("RoleNotGranted"), AuditOutcomeFailed},
+	}
+
+	for _, c := range cases {
+		if outcome := outcomeOf(c.err); outcome != c.outcome {
+			t.Errorf("%v: expected %s, got: %s", c.err, c.outcome, outcome)
+		}
+	}
+
+}
+
+func TestLoginIsRecordedOnce(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	member := c.signUp("member")
+	accessToken := c.login(member)
+
+	entries := 0
+	for _, entry := range c.auditLog.entries {
+		if entry.Actor == nil || *entry.Actor != member.ID {
+			continue
+		}
+		entries++
+		if entry.Action != AuditActionLogin || entry.Detail != accessToken.ID.String() {
+			t.Fatalf("expected the login to reference the access token, got: %s %s", entry.Action, entry.Detail)
+		}
+	}
+
+	if entries != 1 {
+		t.Fatalf("expected a single entry for the login, got: %d", entries)
+	}
+
+}
@@ -0,0 +1,190 @@
+// Package cli implements the community-admin command line tool.
+//
+// The community doesn't ship with storage implementations, so the binary
+// is built by the host application that wires up its configured stores:
+//
+//	func main() {
+//		community, err := community_bl.NewCommunity(dependencies)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		os.Exit(cli.Run(community, os.Args[1:], os.Stdout, os.Stderr))
+//	}
+//
+// Standalone commands like generate-signing-key don't need a community. They're
+// available in the cmd/community-admin binary, which is run without storage.
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	bl "github.com/214alphadev/community-bl"
+	vo "github.com/214alphadev/community-bl/value_objects"
+)
+
+type command struct {
+	usage string
+	run   func(c *cli, args []string) error
+	// standalone commands don't use the community
+	standalone bool
+}
+
+var commands = map[string]command{
+	"bootstrap-owner":      {usage: "bootstrap-owner <email address>", run: bootstrapOwner},
+	"promote":              {usage: "promote <email address>", run: promote},
+	"demote":               {usage: "demote <email address>", run: demote},
+	"grant-role":           {usage: "grant-role <email address> <role>", run: grantRole},
+	"revoke-role":          {usage: "revoke-role <email address> <role>", run: revokeRole},
+	"applications":         {usage: "applications [-state Pending,InformationRequested] [-member <email address>] [-reviewer <email address>] [-assignee <email address> | -unassigned] [-older-than 48h] [-search <words>] [-desc] [-cursor <cursor>] [-next 20]", run: applications},
+	"application":          {usage: "application <application id>", run: application},
+	"claim":                {usage: "claim <application id>", run: claim},
+	"unclaim":              {usage: "unclaim <application id>", run: unclaim},
+	"queue":                {usage: "queue", run: queue},
+	"history":              {usage: "history <email address>", run: history},
+	"approve":              {usage: "approve <application id>", run: approve},
+	"reject":               {usage: "reject [-cool-down 720h] <application id> <reason>", run: reject},
+	"publish-form":         {usage: "publish-form <questions json file>", run: publishForm},
+	"form":                 {usage: "form [-version 0]", run: form},
+	"appeals":              {usage: "appeals [-state Pending]", run: appeals},
+	"overturn":             {usage: "overturn <appeal id> <decision>", run: overturn},
+	"uphold":               {usage: "uphold <appeal id> <decision>", run: uphold},
+	"request-info":         {usage: "request-info <application id> <question>", run: requestInfo},
+	"note":                 {usage: "note <application id> <note>", run: note},
+	"revoke-verification":  {usage: "revoke-verification <email address> <reason>", run: revokeVerification},
+	"audit":                {usage: "audit [-actor <email address>] [-target <id>] [-action <action>] [-outcome <outcome>] [-since <RFC3339>] [-until <RFC3339>] [-position <sequence>] [-next 100]", run: audit},
+	"verify-audit":         {usage: "verify-audit", run: verifyAudit},
+	"maintenance":          {usage: "maintenance", run: maintenance},
+	"stats":                {usage: "stats [-interval Month] [-from <RFC3339>] [-until <RFC3339>]", run: stats},
+	"member":               {usage: "member (-email <email address> | -username <username>)", run: member},
+	"revoke-token":         {usage: "revoke-token <email address>", run: revokeToken},
+	"resend-code":          {usage: "resend-code <email address>", run: resendCode},
+	"invalidate-codes":     {usage: "invalidate-codes <email address>", run: invalidateCodes},
+	"generate-signing-key": {usage: "generate-signing-key", run: generateSigningKey, standalone: true},
+}
+
+type cli struct {
+	community bl.CommunityInterface
+	out       io.Writer
+	json      bool
+	as        string
+}
+
+// Run executes the command line tool with the given arguments and returns the exit code.
+// The community can be nil if only standalone commands are used.
+func Run(community bl.CommunityInterface, args []string, stdout io.Writer, stderr io.Writer) int {
+
+	c := &cli{
+		community: community,
+		out:       stdout,
+	}
+
+	flags := flag.NewFlagSet("community-admin", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.BoolVar(&c.json, "json", false, "print output as json")
+	flags.StringVar(&c.as, "as", "", "email address of the admin the command is executed as")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: community-admin [-json] [-as <email address>] <command> [arguments]")
+		fmt.Fprintln(stderr, "\ncommands:")
+		names := make([]string, 0, len(commands))
+		for name := range commands {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(stderr, "  %s\n", commands[name].usage)
+		}
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	cmd, exists := commands[flags.Arg(0)]
+	if !exists {
+		fmt.Fprintf(stderr, "unknown command: %s\n", flags.Arg(0))
+		flags.Usage()
+		return 2
+	}
+
+	if community == nil && !cmd.standalone {
+		fmt.Fprintf(stderr, "error: %s requires a community, build community-admin with your storage (see package cli)\n", flags.Arg(0))
+		return 1
+	}
+
+	if err := cmd.run(c, flags.Args()[1:]); err != nil {
+		fmt.Fprintf(stderr, "error: %s\n", err.Error())
+		return 1
+	}
+
+	return 0
+
+}
+
+// print writes the value as json or in a human readable format
+func (c *cli) print(value interface{}, human func(w io.Writer)) error {
+
+	if c.json {
+		encoder := json.NewEncoder(c.out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+	}
+
+	human(c.out)
+
+	return nil
+
+}
+
+func (c *cli) done(message string) error {
+	return c.print(map[string]string{"result": message}, func(w io.Writer) {
+		fmt.Fprintln(w, message)
+	})
+}
+
+// actor returns the member the command is executed as
+func (c *cli) actor() (bl.MemberEntity, error) {
+
+	if c.as == "" {
+		return bl.MemberEntity{}, errors.New("this command requires the -as flag")
+	}
+
+	emailAddress, err := vo.NewEmailAddress(c.as)
+	if err != nil {
+		return bl.MemberEntity{}, err
+	}
+
+	return c.community.GetMemberByEmailAddress(emailAddress)
+
+}
+
+func (c *cli) memberByEmailAddress(address string) (bl.MemberEntity, error) {
+
+	emailAddress, err := vo.NewEmailAddress(address)
+	if err != nil {
+		return bl.MemberEntity{}, err
+	}
+
+	return c.community.GetMemberByEmailAddress(emailAddress)
+
+}
+
+func emailAddressArg(args []string) (vo.EmailAddress, error) {
+
+	if len(args) != 1 {
+		return vo.EmailAddress{}, errors.New("expected exactly one email address")
+	}
+
+	return vo.NewEmailAddress(strings.TrimSpace(args[0]))
+
+}
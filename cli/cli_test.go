@@ -0,0 +1,243 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	bl "github.com/214alphadev/community-bl"
+	vo "github.com/214alphadev/community-bl/value_objects"
+	"github.com/satori/go.uuid"
+)
+
+// fakeCommunity implements the parts of the community the tests use.
+// Calling any other method panics.
+type fakeCommunity struct {
+	bl.CommunityInterface
+	// members maps email addresses to the members they belong to
+	members map[string]bl.MemberEntity
+	// owner is set by BootstrapOwner
+	owner *vo.EmailAddress
+	// granted records the roles GrantRole granted
+	granted map[bl.MemberIdentifier][]bl.Role
+}
+
+func (c *fakeCommunity) GetMemberByEmailAddress(emailAddress vo.EmailAddress) (bl.MemberEntity, error) {
+
+	member, ok := c.members[emailAddress.String()]
+	if !ok {
+		return bl.MemberEntity{}, errors.New("MemberDoesNotExist")
+	}
+
+	return member, nil
+
+}
+
+func (c *fakeCommunity) BootstrapOwner(emailAddress vo.EmailAddress) error {
+
+	if c.owner != nil {
+		return errors.New("CommunityHasOwner")
+	}
+
+	c.owner = &emailAddress
+
+	return nil
+
+}
+
+func (c *fakeCommunity) GrantRole(memberID bl.MemberIdentifier, role bl.Role, requester bl.MemberIdentifier) error {
+
+	if c.granted == nil {
+		c.granted = map[bl.MemberIdentifier][]bl.Role{}
+	}
+	c.granted[memberID] = append(c.granted[memberID], role)
+
+	return nil
+
+}
+
+func newMember(t *testing.T, username string, roles ...bl.Role) bl.MemberEntity {
+
+	t.Helper()
+
+	u, err := vo.NewUsername(username)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	emailAddress, err := vo.NewEmailAddress(username + "@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return bl.MemberEntity{
+		ID:           uuid.NewV4(),
+		Username:     u,
+		EmailAddress: emailAddress,
+		Roles:        roles,
+	}
+
+}
+
+// run executes the command line tool and returns the exit code, stdout and stderr
+func run(community bl.CommunityInterface, args ...string) (int, string, string) {
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	code := Run(community, args, stdout, stderr)
+
+	return code, stdout.String(), stderr.String()
+
+}
+
+func TestGenerateSigningKeyWithoutCommunity(t *testing.T) {
+
+	code, stdout, stderr := run(nil, "generate-signing-key")
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got: %d (%s)", code, stderr)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(stdout))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := vo.NewAccessTokenSigningKey(key); err != nil {
+		t.Fatalf("expected a valid signing key, got: %s", err.Error())
+	}
+
+}
+
+func TestCommandRequiresCommunity(t *testing.T) {
+
+	code, _, stderr := run(nil, "bootstrap-owner", "owner@example.com")
+	if code != 1 {
+		t.Fatalf("expected exit code 1, got: %d", code)
+	}
+
+	if !strings.Contains(stderr, "bootstrap-owner requires a community") {
+		t.Fatalf("expected an explanation on stderr, got: %s", stderr)
+	}
+
+}
+
+func TestUnknownCommand(t *testing.T) {
+
+	code, _, stderr := run(&fakeCommunity{}, "unknown")
+	if code != 2 {
+		t.Fatalf("expected exit code 2, got: %d", code)
+	}
+
+	if !strings.Contains(stderr, "unknown command: unknown") || !strings.Contains(stderr, "usage: community-admin") {
+		t.Fatalf("expected the usage on stderr, got: %s", stderr)
+	}
+
+	if code, _, _ := run(&fakeCommunity{}); code != 2 {
+		t.Fatalf("expected exit code 2 without a command, got: %d", code)
+	}
+
+}
+
+func TestMissingActor(t *testing.T) {
+
+	member := newMember(t, "member")
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"member@example.com": member}}
+
+	code, _, stderr := run(community, "grant-role", "member@example.com", string(bl.RoleReviewer))
+	if code != 1 {
+		t.Fatalf("expected exit code 1, got: %d", code)
+	}
+
+	if !strings.Contains(stderr, "this command requires the -as flag") {
+		t.Fatalf("expected the missing -as flag to be reported, got: %s", stderr)
+	}
+
+	if len(community.granted) != 0 {
+		t.Fatalf("expected no role to be granted, got: %v", community.granted)
+	}
+
+}
+
+func TestGrantRole(t *testing.T) {
+
+	admin := newMember(t, "admin", bl.RoleAdmin)
+	member := newMember(t, "member")
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{
+		"admin@example.com":  admin,
+		"member@example.com": member,
+	}}
+
+	code, stdout, stderr := run(community, "-as", "admin@example.com", "grant-role", "member@example.com", string(bl.RoleReviewer))
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got: %d (%s)", code, stderr)
+	}
+
+	if roles := community.granted[member.ID]; len(roles) != 1 || roles[0] != bl.RoleReviewer {
+		t.Fatalf("expected the reviewer role to be granted, got: %v", roles)
+	}
+
+	if strings.TrimSpace(stdout) != "granted role Reviewer to member@example.com" {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+
+	code, _, stderr = run(community, "-as", "admin@example.com", "grant-role", "member@example.com")
+	if code != 1 || !strings.Contains(stderr, "expected an email address and a role") {
+		t.Fatalf("expected the missing role to be reported, got: %d (%s)", code, stderr)
+	}
+
+}
+
+func TestBootstrapOwner(t *testing.T) {
+
+	community := &fakeCommunity{}
+
+	code, _, stderr := run(community, "bootstrap-owner", "owner@example.com")
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got: %d (%s)", code, stderr)
+	}
+
+	if community.owner == nil || community.owner.String() != "owner@example.com" {
+		t.Fatalf("expected owner@example.com to be the owner, got: %v", community.owner)
+	}
+
+	code, _, stderr = run(community, "bootstrap-owner", "other@example.com")
+	if code != 1 || !strings.Contains(stderr, "CommunityHasOwner") {
+		t.Fatalf("expected a second owner to be refused, got: %d (%s)", code, stderr)
+	}
+
+}
+
+func TestJSONOutput(t *testing.T) {
+
+	member := newMember(t, "member", bl.RoleReviewer)
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"member@example.com": member}}
+
+	code, stdout, stderr := run(community, "-json", "member", "-email", "member@example.com")
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got: %d (%s)", code, stderr)
+	}
+
+	view := memberView{}
+	if err := json.Unmarshal([]byte(stdout), &view); err != nil {
+		t.Fatalf("expected json output, got: %s", stdout)
+	}
+
+	if view.ID != member.ID.String() || view.Username != "member" || len(view.Roles) != 1 || view.Roles[0] != string(bl.RoleReviewer) {
+		t.Fatalf("unexpected member: %+v", view)
+	}
+
+	code, stdout, _ = run(community, "-json", "bootstrap-owner", "owner@example.com")
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got: %d", code)
+	}
+
+	result := map[string]string{}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil || result["result"] != "made owner@example.com the owner of the community" {
+		t.Fatalf("expected the result as json, got: %s", stdout)
+	}
+
+}
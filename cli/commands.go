@@ -0,0 +1,1004 @@
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	bl "github.com/214alphadev/community-bl"
+	vo "github.com/214alphadev/community-bl/value_objects"
+	"github.com/satori/go.uuid"
+)
+
+func promote(c *cli, args []string) error {
+
+	emailAddress, err := emailAddressArg(args)
+	if err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	if err := c.community.Promote(emailAddress, actor.ID); err != nil {
+		return err
+	}
+
+	return c.done(fmt.Sprintf("made %s an admin", emailAddress.String()))
+
+}
+
+// bootstrapOwner makes the member the first owner of the community. It doesn't need the -as flag,
+// since the community has nobody to execute it as yet.
+func bootstrapOwner(c *cli, args []string) error {
+
+	emailAddress, err := emailAddressArg(args)
+	if err != nil {
+		return err
+	}
+
+	if err := c.community.BootstrapOwner(emailAddress); err != nil {
+		return err
+	}
+
+	return c.done(fmt.Sprintf("made %s the owner of the community", emailAddress.String()))
+
+}
+
+func demote(c *cli, args []string) error {
+
+	emailAddress, err := emailAddressArg(args)
+	if err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	if err := c.community.Demote(emailAddress, actor.ID); err != nil {
+		return err
+	}
+
+	return c.done(fmt.Sprintf("revoked the admin role of %s", emailAddress.String()))
+
+}
+
+func grantRole(c *cli, args []string) error {
+
+	if len(args) != 2 {
+		return errors.New("expected an email address and a role")
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	emailAddress, err := vo.NewEmailAddress(args[0])
+	if err != nil {
+		return err
+	}
+
+	fetchedMember, err := c.community.GetMemberByEmailAddress(emailAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := c.community.GrantRole(fetchedMember.ID, bl.Role(args[1]), actor.ID); err != nil {
+		return err
+	}
+
+	return c.done(fmt.Sprintf("granted role %s to %s", args[1], emailAddress.String()))
+
+}
+
+func revokeRole(c *cli, args []string) error {
+
+	if len(args) != 2 {
+		return errors.New("expected an email address and a role")
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	emailAddress, err := vo.NewEmailAddress(args[0])
+	if err != nil {
+		return err
+	}
+
+	fetchedMember, err := c.community.GetMemberByEmailAddress(emailAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := c.community.RevokeRole(fetchedMember.ID, bl.Role(args[1]), actor.ID); err != nil {
+		return err
+	}
+
+	return c.done(fmt.Sprintf("revoked role %s from %s", args[1], emailAddress.String()))
+
+}
+
+func revokeVerification(c *cli, args []string) error {
+
+	if len(args) < 2 {
+		return errors.New("expected an email address and a reason")
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	emailAddress, err := vo.NewEmailAddress(args[0])
+	if err != nil {
+		return err
+	}
+
+	fetchedMember, err := c.community.GetMemberByEmailAddress(emailAddress)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.community.RevokeVerification(fetchedMember.ID, strings.Join(args[1:], " "), actor.ID); err != nil {
+		return err
+	}
+
+	return c.done(fmt.Sprintf("revoked verification of %s", emailAddress.String()))
+
+}
+
+func applications(c *cli, args []string) error {
+
+	flags := flag.NewFlagSet("applications", flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	states := flags.String("state", string(bl.ApplicationStatePending), "comma separated states of the applications, empty for every state")
+	memberEmail := flags.String("member", "", "email address of the applicant")
+	reviewerEmail := flags.String("reviewer", "", "email address of the reviewer that approved or rejected the applications")
+	assignee := flags.String("assignee", "", "email address of the reviewer the applications are assigned to")
+	unassigned := flags.Bool("unassigned", false, "only applications nobody claimed")
+	olderThan := flags.Duration("older-than", 0, "only applications submitted at least this long ago")
+	search := flags.String("search", "", "words to search for in the application text")
+	descending := flags.Bool("desc", false, "newest applications first")
+	cursor := flags.String("cursor", "", "cursor of the previous page")
+	next := flags.Uint("next", 20, "amount of applications to fetch")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	query := bl.ApplicationsQuery{
+		Cursor:         *cursor,
+		Next:           *next,
+		Unassigned:     *unassigned,
+		Search:         *search,
+		SortDescending: *descending,
+	}
+
+	for _, state := range strings.Split(*states, ",") {
+		if state = strings.TrimSpace(state); state == "" {
+			continue
+		}
+		if !bl.ApplicationState(state).Valid() {
+			return fmt.Errorf("invalid application state: '%s'", state)
+		}
+		query.States = append(query.States, bl.ApplicationState(state))
+	}
+
+	if *memberEmail != "" {
+		fetchedMember, err := c.memberByEmailAddress(*memberEmail)
+		if err != nil {
+			return err
+		}
+		query.Member = &fetchedMember.ID
+	}
+
+	if *reviewerEmail != "" {
+		fetchedMember, err := c.memberByEmailAddress(*reviewerEmail)
+		if err != nil {
+			return err
+		}
+		query.Reviewer = &fetchedMember.ID
+	}
+
+	if *assignee != "" {
+		fetchedMember, err := c.memberByEmailAddress(*assignee)
+		if err != nil {
+			return err
+		}
+		query.AssignedTo = &fetchedMember.ID
+	}
+
+	if *olderThan > 0 {
+		createdBefore := time.Now().Add(-*olderThan)
+		query.CreatedBefore = &createdBefore
+	}
+
+	result, err := c.community.Applications(query, actor.ID)
+	if err != nil {
+		return err
+	}
+
+	view := applicationsResultView{
+		Applications: make([]applicationView, 0, len(result.Applications)),
+		Total:        result.Total,
+		NextCursor:   result.NextCursor,
+		HasMore:      result.HasMore,
+	}
+	for _, application := range result.Applications {
+		view.Applications = append(view.Applications, newApplicationView(application))
+	}
+
+	return c.print(view, func(w io.Writer) {
+		printApplicationList(w, view.Applications)
+		fmt.Fprintf(w, "\n%d applications in total\n", view.Total)
+		if view.HasMore {
+			fmt.Fprintf(w, "more with -cursor %s\n", view.NextCursor)
+		}
+	})
+
+}
+
+func printApplicationList(w io.Writer, views []applicationView) {
+	if len(views) == 0 {
+		fmt.Fprintln(w, "no applications found")
+	}
+	for _, view := range views {
+		fmt.Fprintf(w, "%s  %-8s  %s  member: %s", view.ID, view.State, view.CreatedAt, view.MemberID)
+		if view.AssignedTo != "" {
+			fmt.Fprintf(w, "  assigned to: %s", view.AssignedTo)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+func claim(c *cli, args []string) error {
+
+	if len(args) != 1 {
+		return errors.New("expected exactly one application id")
+	}
+
+	applicationID, err := uuid.FromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	claimed, err := c.community.ClaimApplication(applicationID, actor.ID)
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("claimed application %s", applicationID.String())
+	if claimed.ClaimExpiresAt != nil {
+		message = fmt.Sprintf("%s until %s", message, claimed.ClaimExpiresAt.Format(time.RFC3339))
+	}
+
+	return c.done(message)
+
+}
+
+func unclaim(c *cli, args []string) error {
+
+	if len(args) != 1 {
+		return errors.New("expected exactly one application id")
+	}
+
+	applicationID, err := uuid.FromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	if err := c.community.UnclaimApplication(applicationID, actor.ID); err != nil {
+		return err
+	}
+
+	return c.done(fmt.Sprintf("released application %s", applicationID.String()))
+
+}
+
+func queue(c *cli, args []string) error {
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	fetchedApplications, err := c.community.ReviewQueue(actor.ID)
+	if err != nil {
+		return err
+	}
+
+	views := make([]applicationView, 0, len(fetchedApplications))
+	for _, application := range fetchedApplications {
+		views = append(views, newApplicationView(application))
+	}
+
+	return c.print(views, func(w io.Writer) {
+		printApplicationList(w, views)
+	})
+
+}
+
+func application(c *cli, args []string) error {
+
+	if len(args) != 1 {
+		return errors.New("expected exactly one application id")
+	}
+
+	applicationID, err := uuid.FromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	fetchedApplication, err := c.community.Application(applicationID, actor.ID)
+	if err != nil {
+		return err
+	}
+
+	votes, err := c.community.Votes(applicationID, actor.ID)
+	if err != nil {
+		return err
+	}
+
+	comments, err := c.community.ApplicationComments(applicationID, actor.ID)
+	if err != nil {
+		return err
+	}
+
+	revisions, err := c.community.ApplicationRevisions(applicationID, actor.ID)
+	if err != nil {
+		return err
+	}
+
+	view := newApplicationView(fetchedApplication)
+	for _, revision := range revisions {
+		view.Revisions = append(view.Revisions, revisionView{
+			ApplicationText: revision.ApplicationText,
+			Answers:         newAnswerViews(revision.Answers),
+			RevisedAt:       revision.RevisedAt.Format(time.RFC3339),
+		})
+	}
+	for _, comment := range comments {
+		view.Comments = append(view.Comments, commentView{
+			AuthorID:  comment.AuthorID.String(),
+			Text:      comment.Text,
+			Internal:  comment.Internal,
+			Question:  comment.Question,
+			CreatedAt: comment.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	for _, vote := range votes {
+		view.Votes = append(view.Votes, voteView{
+			ReviewerID: vote.ReviewerID.String(),
+			Decision:   string(vote.Decision),
+			Comment:    vote.Comment,
+			CastAt:     vote.CastAt.Format(time.RFC3339),
+		})
+	}
+
+	return c.print(view, view.print)
+
+}
+
+func approve(c *cli, args []string) error {
+
+	if len(args) != 1 {
+		return errors.New("expected exactly one application id")
+	}
+
+	applicationID, err := uuid.FromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	if err := c.community.ApproveApplication(applicationID, actor.ID); err != nil {
+		return err
+	}
+
+	// under a quorum the vote might leave the application open
+	application, err := c.community.Application(applicationID, actor.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.done(fmt.Sprintf("voted on application %s, state: %s", applicationID.String(), application.State))
+
+}
+
+func reject(c *cli, args []string) error {
+
+	flags := flag.NewFlagSet("reject", flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	coolDown := flags.Duration("cool-down", 0, "time until the member can apply again, overrides the reapplication policy")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	overrideCoolDown := false
+	flags.Visit(func(f *flag.Flag) {
+		if f.Name == "cool-down" {
+			overrideCoolDown = true
+		}
+	})
+
+	args = flags.Args()
+
+	if len(args) < 2 {
+		return errors.New("expected an application id and a reason")
+	}
+
+	applicationID, err := uuid.FromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	reason := strings.Join(args[1:], " ")
+
+	switch overrideCoolDown {
+	case true:
+		err = c.community.RejectApplicationWithCoolDown(applicationID, reason, *coolDown, actor.ID)
+	default:
+		err = c.community.RejectApplication(applicationID, reason, actor.ID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// under a quorum the vote might leave the application open
+	application, err := c.community.Application(applicationID, actor.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.done(fmt.Sprintf("voted on application %s, state: %s", applicationID.String(), application.State))
+
+}
+
+// publishForm publishes the questions of a json file in the format printed by "form -json"
+func publishForm(c *cli, args []string) error {
+
+	if len(args) != 1 {
+		return errors.New("expected exactly one questions json file")
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	data, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	views := []formQuestionView{}
+	if err := json.Unmarshal(data, &views); err != nil {
+		return err
+	}
+
+	questions := make([]bl.FormQuestion, 0, len(views))
+	for _, view := range views {
+		questions = append(questions, bl.FormQuestion{
+			ID:          view.ID,
+			Label:       view.Label,
+			Type:        bl.FormQuestionType(view.Type),
+			Required:    view.Required,
+			MinLength:   view.MinLength,
+			MaxLength:   view.MaxLength,
+			Choices:     view.Choices,
+			MaxFileSize: view.MaxFileSize,
+		})
+	}
+
+	publishedForm, err := c.community.PublishApplicationForm(questions, actor.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.done(fmt.Sprintf("published application form version %d", publishedForm.Version))
+
+}
+
+func form(c *cli, args []string) error {
+
+	flags := flag.NewFlagSet("form", flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	version := flags.Uint("version", 0, "version of the form, zero for the active form")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	fetchedForm, err := c.community.ApplicationForm(*version)
+	if err != nil {
+		return err
+	}
+
+	view := newFormView(fetchedForm)
+
+	return c.print(view, view.print)
+
+}
+
+func history(c *cli, args []string) error {
+
+	emailAddress, err := emailAddressArg(args)
+	if err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	fetchedMember, err := c.community.GetMemberByEmailAddress(emailAddress)
+	if err != nil {
+		return err
+	}
+
+	entries, err := c.community.ApplicationHistory(fetchedMember.ID, actor.ID)
+	if err != nil {
+		return err
+	}
+
+	views := make([]historyEntryView, 0, len(entries))
+	for _, entry := range entries {
+		views = append(views, newHistoryEntryView(entry))
+	}
+
+	return c.print(views, func(w io.Writer) {
+		if len(views) == 0 {
+			fmt.Fprintln(w, "no applications found")
+		}
+		for _, view := range views {
+			view.print(w)
+		}
+	})
+
+}
+
+func appeals(c *cli, args []string) error {
+
+	flags := flag.NewFlagSet("appeals", flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	state := flags.String("state", string(bl.AppealStatePending), "state of the appeals")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	fetchedAppeals, err := c.community.Appeals(bl.AppealState(*state), actor.ID)
+	if err != nil {
+		return err
+	}
+
+	views := make([]appealView, 0, len(fetchedAppeals))
+	for _, appeal := range fetchedAppeals {
+		views = append(views, newAppealView(appeal))
+	}
+
+	return c.print(views, func(w io.Writer) {
+		if len(views) == 0 {
+			fmt.Fprintln(w, "no appeals found")
+		}
+		for _, view := range views {
+			fmt.Fprintf(w, "%s  %-10s  %s  application: %s\n%s\n\n", view.ID, view.State, view.CreatedAt, view.ApplicationID, view.Statement)
+		}
+	})
+
+}
+
+func overturn(c *cli, args []string) error {
+	return decideAppeal(c, args, c.community.OverturnAppeal, "overturned")
+}
+
+func uphold(c *cli, args []string) error {
+	return decideAppeal(c, args, c.community.UpholdAppeal, "upheld")
+}
+
+func decideAppeal(c *cli, args []string, decide func(appeal uuid.UUID, decision string, reviewer bl.MemberIdentifier) error, outcome string) error {
+
+	if len(args) < 2 {
+		return errors.New("expected an appeal id and a decision")
+	}
+
+	appealID, err := uuid.FromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	if err := decide(appealID, strings.Join(args[1:], " "), actor.ID); err != nil {
+		return err
+	}
+
+	return c.done(fmt.Sprintf("%s appeal %s", outcome, appealID.String()))
+
+}
+
+func requestInfo(c *cli, args []string) error {
+
+	if len(args) < 2 {
+		return errors.New("expected an application id and a question")
+	}
+
+	applicationID, err := uuid.FromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.community.RequestInformation(applicationID, strings.Join(args[1:], " "), actor.ID); err != nil {
+		return err
+	}
+
+	return c.done(fmt.Sprintf("requested information for application %s", applicationID.String()))
+
+}
+
+func note(c *cli, args []string) error {
+
+	if len(args) < 2 {
+		return errors.New("expected an application id and a note")
+	}
+
+	applicationID, err := uuid.FromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.community.AddInternalNote(applicationID, strings.Join(args[1:], " "), actor.ID); err != nil {
+		return err
+	}
+
+	return c.done(fmt.Sprintf("added note to application %s", applicationID.String()))
+
+}
+
+func audit(c *cli, args []string) error {
+
+	flags := flag.NewFlagSet("audit", flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	actorEmail := flags.String("actor", "", "email address of the acting member")
+	target := flags.String("target", "", "id of the affected member, application, invitation or appeal")
+	action := flags.String("action", "", "action to filter by")
+	outcome := flags.String("outcome", "", "outcome to filter by")
+	since := flags.String("since", "", "only entries that occurred at or after this time")
+	until := flags.String("until", "", "only entries that occurred before this time")
+	position := flags.Uint64("position", 0, "sequence of the entry to start after")
+	next := flags.Uint("next", 100, "amount of entries to fetch")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	query := bl.AuditLogQuery{
+		Next:    *next,
+		Action:  bl.AuditAction(*action),
+		Outcome: bl.AuditOutcome(*outcome),
+	}
+
+	if *actorEmail != "" {
+		emailAddress, err := vo.NewEmailAddress(*actorEmail)
+		if err != nil {
+			return err
+		}
+		fetchedMember, err := c.community.GetMemberByEmailAddress(emailAddress)
+		if err != nil {
+			return err
+		}
+		query.Actor = &fetchedMember.ID
+	}
+
+	if *target != "" {
+		targetID, err := uuid.FromString(*target)
+		if err != nil {
+			return err
+		}
+		query.Target = &targetID
+	}
+
+	if *since != "" {
+		t, err := time.Parse(time.RFC3339, *since)
+		if err != nil {
+			return err
+		}
+		query.Since = &t
+	}
+
+	if *until != "" {
+		t, err := time.Parse(time.RFC3339, *until)
+		if err != nil {
+			return err
+		}
+		query.Until = &t
+	}
+
+	if *position > 0 {
+		query.Position = position
+	}
+
+	entries, err := c.community.AuditLog(query, actor.ID)
+	if err != nil {
+		return err
+	}
+
+	views := make([]auditEntryView, 0, len(entries))
+	for _, entry := range entries {
+		views = append(views, newAuditEntryView(entry))
+	}
+
+	return c.print(views, func(w io.Writer) {
+		if len(views) == 0 {
+			fmt.Fprintln(w, "no audit log entries found")
+		}
+		for _, view := range views {
+			view.print(w)
+		}
+	})
+
+}
+
+func verifyAudit(c *cli, args []string) error {
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	if err := c.community.VerifyAuditLog(actor.ID); err != nil {
+		return err
+	}
+
+	return c.done("audit log is intact")
+
+}
+
+func stats(c *cli, args []string) error {
+
+	flags := flag.NewFlagSet("stats", flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	interval := flags.String("interval", string(bl.StatsIntervalMonth), "length of the reported periods: Day, Week or Month")
+	from := flags.String("from", "", "beginning of the reported range")
+	until := flags.String("until", "", "end of the reported range")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	query := bl.StatsQuery{
+		Interval: bl.StatsInterval(*interval),
+	}
+
+	if *from != "" {
+		t, err := time.Parse(time.RFC3339, *from)
+		if err != nil {
+			return err
+		}
+		query.From = &t
+	}
+
+	if *until != "" {
+		t, err := time.Parse(time.RFC3339, *until)
+		if err != nil {
+			return err
+		}
+		query.Until = &t
+	}
+
+	fetchedStats, err := c.community.Stats(query, actor.ID)
+	if err != nil {
+		return err
+	}
+
+	view := newStatsView(fetchedStats)
+
+	return c.print(view, view.print)
+
+}
+
+// maintenance runs the periodic jobs once. Hosts without a scheduler can run it from cron.
+func maintenance(c *cli, args []string) error {
+
+	if len(args) != 0 {
+		return errors.New("maintenance doesn't take arguments")
+	}
+
+	report, err := c.community.RunMaintenance(time.Now())
+
+	view := newMaintenanceReportView(report)
+	if printErr := c.print(view, view.print); printErr != nil {
+		return printErr
+	}
+
+	return err
+
+}
+
+func member(c *cli, args []string) error {
+
+	flags := flag.NewFlagSet("member", flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	email := flags.String("email", "", "email address of the member")
+	username := flags.String("username", "", "username of the member")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var fetchedMember bl.MemberEntity
+
+	switch {
+	case *email != "" && *username == "":
+		emailAddress, err := vo.NewEmailAddress(*email)
+		if err != nil {
+			return err
+		}
+		fetchedMember, err = c.community.GetMemberByEmailAddress(emailAddress)
+		if err != nil {
+			return err
+		}
+	case *username != "" && *email == "":
+		u, err := vo.NewUsername(*username)
+		if err != nil {
+			return err
+		}
+		fetchedMember, err = c.community.GetMemberByUsername(u)
+		if err != nil {
+			return err
+		}
+	default:
+		return errors.New("expected either -email or -username")
+	}
+
+	view := newMemberView(fetchedMember)
+
+	return c.print(view, view.print)
+
+}
+
+func revokeToken(c *cli, args []string) error {
+
+	emailAddress, err := emailAddressArg(args)
+	if err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	fetchedMember, err := c.community.GetMemberByEmailAddress(emailAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := c.community.RevokeAccessToken(fetchedMember.ID, actor.ID); err != nil {
+		return err
+	}
+
+	return c.done(fmt.Sprintf("revoked access token of %s", emailAddress.String()))
+
+}
+
+func resendCode(c *cli, args []string) error {
+
+	emailAddress, err := emailAddressArg(args)
+	if err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	if err := c.community.ResendConfirmationCode(emailAddress, actor.ID); err != nil {
+		return err
+	}
+
+	return c.done(fmt.Sprintf("sent new confirmation code to %s", emailAddress.String()))
+
+}
+
+func invalidateCodes(c *cli, args []string) error {
+
+	emailAddress, err := emailAddressArg(args)
+	if err != nil {
+		return err
+	}
+
+	actor, err := c.actor()
+	if err != nil {
+		return err
+	}
+
+	if err := c.community.InvalidateConfirmationCodes(emailAddress, actor.ID); err != nil {
+		return err
+	}
+
+	return c.done(fmt.Sprintf("invalidated confirmation codes of %s", emailAddress.String()))
+
+}
+
+func generateSigningKey(c *cli, args []string) error {
+
+	key, err := vo.AccessTokenSigningKeyFactory()
+	if err != nil {
+		return err
+	}
+
+	encodedKey := base64.StdEncoding.EncodeToString(key.Bytes())
+
+	return c.print(map[string]string{"access_token_signing_key": encodedKey}, func(w io.Writer) {
+		fmt.Fprintln(w, encodedKey)
+	})
+
+}
@@ -0,0 +1,616 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	bl "github.com/214alphadev/community-bl"
+)
+
+type memberView struct {
+	ID                   string   `json:"id"`
+	Username             string   `json:"username"`
+	EmailAddress         string   `json:"email_address"`
+	FirstName            string   `json:"first_name"`
+	LastName             string   `json:"last_name"`
+	CreatedAt            string   `json:"created_at"`
+	VerifiedEmailAddress bool     `json:"verified_email_address"`
+	Verified             bool     `json:"verified"`
+	Roles                []string `json:"roles"`
+	HasAccessToken       bool     `json:"has_access_token"`
+}
+
+func newMemberView(member bl.MemberEntity) memberView {
+
+	roles := make([]string, 0, len(member.Roles))
+	for _, role := range member.Roles {
+		roles = append(roles, string(role))
+	}
+
+	return memberView{
+		ID:                   member.ID.String(),
+		Username:             member.Username.String(),
+		EmailAddress:         member.EmailAddress.String(),
+		FirstName:            member.Metadata.ProperName.FirstName(),
+		LastName:             member.Metadata.ProperName.LastName(),
+		CreatedAt:            member.CreatedAt.Format(time.RFC3339),
+		VerifiedEmailAddress: member.VerifiedEmailAddress,
+		Verified:             member.Verified,
+		Roles:                roles,
+		HasAccessToken:       member.AccessTokenID != nil,
+	}
+
+}
+
+func (v memberView) print(w io.Writer) {
+	fmt.Fprintf(w, "id:                     %s\n", v.ID)
+	fmt.Fprintf(w, "username:               %s\n", v.Username)
+	fmt.Fprintf(w, "email address:          %s\n", v.EmailAddress)
+	fmt.Fprintf(w, "name:                   %s %s\n", v.FirstName, v.LastName)
+	fmt.Fprintf(w, "created at:             %s\n", v.CreatedAt)
+	fmt.Fprintf(w, "verified email address: %t\n", v.VerifiedEmailAddress)
+	fmt.Fprintf(w, "verified:               %t\n", v.Verified)
+	fmt.Fprintf(w, "roles:                  %s\n", strings.Join(v.Roles, ", "))
+	fmt.Fprintf(w, "has access token:       %t\n", v.HasAccessToken)
+}
+
+type applicationView struct {
+	ID                  string         `json:"id"`
+	MemberID            string         `json:"member_id"`
+	State               string         `json:"state"`
+	ApplicationText     string         `json:"application_text"`
+	RejectionReason     string         `json:"rejection_reason,omitempty"`
+	CreatedAt           string         `json:"created_at"`
+	ApprovedAt          string         `json:"approved_at,omitempty"`
+	ApprovedBy          []string       `json:"approved_by,omitempty"`
+	RejectedAt          string         `json:"rejected_at,omitempty"`
+	RejectedBy          []string       `json:"rejected_by,omitempty"`
+	ReapplyAfter        string         `json:"reapply_after,omitempty"`
+	PermanentlyRejected bool           `json:"permanently_rejected,omitempty"`
+	FormVersion         uint           `json:"form_version,omitempty"`
+	Answers             []answerView   `json:"answers,omitempty"`
+	Votes               []voteView     `json:"votes,omitempty"`
+	Revisions           []revisionView `json:"revisions,omitempty"`
+	Comments            []commentView  `json:"comments,omitempty"`
+	AssignedTo          string         `json:"assigned_to,omitempty"`
+	ClaimExpiresAt      string         `json:"claim_expires_at,omitempty"`
+	EscalatedAt         string         `json:"escalated_at,omitempty"`
+	ExpiredAt           string         `json:"expired_at,omitempty"`
+}
+
+type applicationsResultView struct {
+	Applications []applicationView `json:"applications"`
+	Total        uint              `json:"total"`
+	NextCursor   string            `json:"next_cursor,omitempty"`
+	HasMore      bool              `json:"has_more"`
+}
+
+type commentView struct {
+	AuthorID  string `json:"author_id"`
+	Text      string `json:"text"`
+	Internal  bool   `json:"internal"`
+	Question  bool   `json:"question"`
+	CreatedAt string `json:"created_at"`
+}
+
+type answerView struct {
+	QuestionID string `json:"question_id"`
+	Value      string `json:"value,omitempty"`
+	HasFile    bool   `json:"has_file,omitempty"`
+}
+
+type revisionView struct {
+	ApplicationText string       `json:"application_text,omitempty"`
+	Answers         []answerView `json:"answers,omitempty"`
+	RevisedAt       string       `json:"revised_at"`
+}
+
+type voteView struct {
+	ReviewerID string `json:"reviewer_id"`
+	Decision   string `json:"decision"`
+	Comment    string `json:"comment,omitempty"`
+	CastAt     string `json:"cast_at"`
+}
+
+func newApplicationView(application bl.ApplicationEntity) applicationView {
+
+	view := applicationView{
+		ID:              application.ID.String(),
+		MemberID:        application.MemberID.String(),
+		State:           string(application.State),
+		ApplicationText: application.ApplicationText,
+		RejectionReason: application.RejectionReason,
+		CreatedAt:       application.CreatedAt.Format(time.RFC3339),
+		FormVersion:     application.FormVersion,
+	}
+
+	view.Answers = newAnswerViews(application.Answers)
+
+	if application.ReapplyAfter != nil {
+		view.ReapplyAfter = application.ReapplyAfter.Format(time.RFC3339)
+	}
+
+	view.PermanentlyRejected = application.PermanentlyRejected
+
+	if application.ApprovedAt != nil {
+		view.ApprovedAt = application.ApprovedAt.Format(time.RFC3339)
+	}
+
+	for _, reviewer := range application.ApprovedBy {
+		view.ApprovedBy = append(view.ApprovedBy, reviewer.String())
+	}
+
+	if application.RejectedAt != nil {
+		view.RejectedAt = application.RejectedAt.Format(time.RFC3339)
+	}
+
+	for _, reviewer := range application.RejectedBy {
+		view.RejectedBy = append(view.RejectedBy, reviewer.String())
+	}
+
+	if application.AssignedTo != nil {
+		view.AssignedTo = application.AssignedTo.String()
+	}
+
+	if application.ClaimExpiresAt != nil {
+		view.ClaimExpiresAt = application.ClaimExpiresAt.Format(time.RFC3339)
+	}
+
+	if application.EscalatedAt != nil {
+		view.EscalatedAt = application.EscalatedAt.Format(time.RFC3339)
+	}
+
+	if application.ExpiredAt != nil {
+		view.ExpiredAt = application.ExpiredAt.Format(time.RFC3339)
+	}
+
+	return view
+
+}
+
+func newAnswerViews(answers []bl.ApplicationAnswer) []answerView {
+
+	var views []answerView
+
+	for _, answer := range answers {
+		views = append(views, answerView{
+			QuestionID: answer.QuestionID,
+			Value:      answer.Value,
+			HasFile:    answer.File != nil,
+		})
+	}
+
+	return views
+
+}
+
+func (v applicationView) print(w io.Writer) {
+	fmt.Fprintf(w, "id:         %s\n", v.ID)
+	fmt.Fprintf(w, "member:     %s\n", v.MemberID)
+	fmt.Fprintf(w, "state:      %s\n", v.State)
+	fmt.Fprintf(w, "created at: %s\n", v.CreatedAt)
+	if v.AssignedTo != "" {
+		fmt.Fprintf(w, "assigned:   %s", v.AssignedTo)
+		if v.ClaimExpiresAt != "" {
+			fmt.Fprintf(w, " until %s", v.ClaimExpiresAt)
+		}
+		fmt.Fprintln(w)
+	}
+	if v.EscalatedAt != "" {
+		fmt.Fprintf(w, "escalated:  %s\n", v.EscalatedAt)
+	}
+	if v.ExpiredAt != "" {
+		fmt.Fprintf(w, "expired:    %s\n", v.ExpiredAt)
+	}
+	if v.ApprovedAt != "" {
+		fmt.Fprintf(w, "approved:   %s by %s\n", v.ApprovedAt, strings.Join(v.ApprovedBy, ", "))
+	}
+	if v.RejectedAt != "" {
+		fmt.Fprintf(w, "rejected:   %s by %s\n", v.RejectedAt, strings.Join(v.RejectedBy, ", "))
+		fmt.Fprintf(w, "reason:     %s\n", v.RejectionReason)
+		switch {
+		case v.PermanentlyRejected:
+			fmt.Fprintln(w, "reapply:    never")
+		case v.ReapplyAfter != "":
+			fmt.Fprintf(w, "reapply:    after %s\n", v.ReapplyAfter)
+		}
+	}
+	for _, vote := range v.Votes {
+		fmt.Fprintf(w, "vote:       %s by %s at %s\n", vote.Decision, vote.ReviewerID, vote.CastAt)
+		if vote.Comment != "" {
+			fmt.Fprintf(w, "            %s\n", vote.Comment)
+		}
+	}
+	if v.FormVersion > 0 {
+		fmt.Fprintf(w, "form:       version %d\n", v.FormVersion)
+	}
+	if v.ApplicationText != "" {
+		fmt.Fprintf(w, "\n%s\n", v.ApplicationText)
+	}
+	for _, answer := range v.Answers {
+		value := answer.Value
+		if answer.HasFile {
+			value = "(file)"
+		}
+		fmt.Fprintf(w, "\n%s:\n%s\n", answer.QuestionID, value)
+	}
+	for _, revision := range v.Revisions {
+		fmt.Fprintf(w, "\nrevised at %s, previously:\n", revision.RevisedAt)
+		if revision.ApplicationText != "" {
+			fmt.Fprintf(w, "%s\n", revision.ApplicationText)
+		}
+		for _, answer := range revision.Answers {
+			fmt.Fprintf(w, "%s: %s\n", answer.QuestionID, answer.Value)
+		}
+	}
+	for _, comment := range v.Comments {
+		kind := "comment"
+		switch {
+		case comment.Internal:
+			kind = "note"
+		case comment.Question:
+			kind = "question"
+		}
+		fmt.Fprintf(w, "\n%s by %s at %s:\n%s\n", kind, comment.AuthorID, comment.CreatedAt, comment.Text)
+	}
+}
+
+type formQuestionView struct {
+	ID          string   `json:"id"`
+	Label       string   `json:"label"`
+	Type        string   `json:"type"`
+	Required    bool     `json:"required"`
+	MinLength   uint     `json:"min_length,omitempty"`
+	MaxLength   uint     `json:"max_length,omitempty"`
+	Choices     []string `json:"choices,omitempty"`
+	MaxFileSize uint     `json:"max_file_size,omitempty"`
+}
+
+type formView struct {
+	ID          string             `json:"id"`
+	Version     uint               `json:"version"`
+	PublishedAt string             `json:"published_at"`
+	Questions   []formQuestionView `json:"questions"`
+}
+
+func newFormView(form bl.ApplicationFormEntity) formView {
+
+	view := formView{
+		ID:          form.ID.String(),
+		Version:     form.Version,
+		PublishedAt: form.PublishedAt.Format(time.RFC3339),
+		Questions:   []formQuestionView{},
+	}
+
+	for _, question := range form.Questions {
+		view.Questions = append(view.Questions, formQuestionView{
+			ID:          question.ID,
+			Label:       question.Label,
+			Type:        string(question.Type),
+			Required:    question.Required,
+			MinLength:   question.MinLength,
+			MaxLength:   question.MaxLength,
+			Choices:     question.Choices,
+			MaxFileSize: question.MaxFileSize,
+		})
+	}
+
+	return view
+
+}
+
+func (v formView) print(w io.Writer) {
+	fmt.Fprintf(w, "version:      %d\n", v.Version)
+	fmt.Fprintf(w, "published at: %s\n", v.PublishedAt)
+	for _, question := range v.Questions {
+		required := ""
+		if question.Required {
+			required = " (required)"
+		}
+		fmt.Fprintf(w, "\n%s [%s]%s\n%s\n", question.ID, question.Type, required, question.Label)
+		for _, choice := range question.Choices {
+			fmt.Fprintf(w, "  - %s\n", choice)
+		}
+	}
+}
+
+type appealView struct {
+	ID            string `json:"id"`
+	ApplicationID string `json:"application_id"`
+	MemberID      string `json:"member_id"`
+	State         string `json:"state"`
+	Statement     string `json:"statement"`
+	CreatedAt     string `json:"created_at"`
+	DecidedBy     string `json:"decided_by,omitempty"`
+	DecidedAt     string `json:"decided_at,omitempty"`
+	Decision      string `json:"decision,omitempty"`
+}
+
+func newAppealView(appeal bl.AppealEntity) appealView {
+
+	view := appealView{
+		ID:            appeal.ID.String(),
+		ApplicationID: appeal.ApplicationID.String(),
+		MemberID:      appeal.MemberID.String(),
+		State:         string(appeal.State),
+		Statement:     appeal.Statement,
+		CreatedAt:     appeal.CreatedAt.Format(time.RFC3339),
+		Decision:      appeal.Decision,
+	}
+
+	if appeal.DecidedBy != nil {
+		view.DecidedBy = appeal.DecidedBy.String()
+	}
+
+	if appeal.DecidedAt != nil {
+		view.DecidedAt = appeal.DecidedAt.Format(time.RFC3339)
+	}
+
+	return view
+
+}
+
+type transitionView struct {
+	From           string `json:"from,omitempty"`
+	To             string `json:"to"`
+	Actor          string `json:"actor,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+	TransitionedAt string `json:"transitioned_at"`
+}
+
+type historyEntryView struct {
+	ApplicationID string           `json:"application_id"`
+	State         string           `json:"state"`
+	CreatedAt     string           `json:"created_at"`
+	Transitions   []transitionView `json:"transitions"`
+}
+
+func newHistoryEntryView(entry bl.ApplicationHistoryEntry) historyEntryView {
+
+	view := historyEntryView{
+		ApplicationID: entry.Application.ID.String(),
+		State:         string(entry.Application.State),
+		CreatedAt:     entry.Application.CreatedAt.Format(time.RFC3339),
+		Transitions:   []transitionView{},
+	}
+
+	for _, transition := range entry.Transitions {
+
+		t := transitionView{
+			From:           string(transition.From),
+			To:             string(transition.To),
+			Reason:         transition.Reason,
+			TransitionedAt: transition.TransitionedAt.Format(time.RFC3339),
+		}
+
+		if transition.Actor != nil {
+			t.Actor = transition.Actor.String()
+		}
+
+		view.Transitions = append(view.Transitions, t)
+
+	}
+
+	return view
+
+}
+
+func (v historyEntryView) print(w io.Writer) {
+	fmt.Fprintf(w, "%s  %-20s  %s\n", v.ApplicationID, v.State, v.CreatedAt)
+	for _, t := range v.Transitions {
+		from := t.From
+		if from == "" {
+			from = "-"
+		}
+		actor := t.Actor
+		if actor == "" {
+			actor = "community"
+		}
+		fmt.Fprintf(w, "    %s  %s -> %s by %s\n", t.TransitionedAt, from, t.To, actor)
+		if t.Reason != "" {
+			fmt.Fprintf(w, "        %s\n", t.Reason)
+		}
+	}
+}
+
+type auditEntryView struct {
+	Sequence   uint64 `json:"sequence"`
+	Action     string `json:"action"`
+	Actor      string `json:"actor,omitempty"`
+	Target     string `json:"target,omitempty"`
+	Outcome    string `json:"outcome"`
+	Detail     string `json:"detail,omitempty"`
+	OccurredAt string `json:"occurred_at"`
+	Hash       string `json:"hash"`
+}
+
+func newAuditEntryView(entry bl.AuditEntryEntity) auditEntryView {
+
+	view := auditEntryView{
+		Sequence:   entry.Sequence,
+		Action:     string(entry.Action),
+		Outcome:    string(entry.Outcome),
+		Detail:     entry.Detail,
+		OccurredAt: entry.OccurredAt.Format(time.RFC3339),
+		Hash:       entry.Hash,
+	}
+
+	if entry.Actor != nil {
+		view.Actor = entry.Actor.String()
+	}
+
+	if entry.Target != nil {
+		view.Target = entry.Target.String()
+	}
+
+	return view
+
+}
+
+func (v auditEntryView) print(w io.Writer) {
+	actor := v.Actor
+	if actor == "" {
+		actor = "-"
+	}
+	fmt.Fprintf(w, "%6d  %s  %-9s  %-34s  actor: %s", v.Sequence, v.OccurredAt, v.Outcome, v.Action, actor)
+	if v.Target != "" {
+		fmt.Fprintf(w, "  target: %s", v.Target)
+	}
+	if v.Detail != "" {
+		fmt.Fprintf(w, "  (%s)", v.Detail)
+	}
+	fmt.Fprintln(w)
+}
+
+type maintenanceReportView struct {
+	Escalated []string `json:"escalated"`
+	Expired   []string `json:"expired"`
+	Erased    []string `json:"erased"`
+}
+
+func newMaintenanceReportView(report bl.MaintenanceReport) maintenanceReportView {
+
+	view := maintenanceReportView{
+		Escalated: []string{},
+		Expired:   []string{},
+		Erased:    []string{},
+	}
+
+	for _, application := range report.Escalated {
+		view.Escalated = append(view.Escalated, application.ID.String())
+	}
+
+	for _, application := range report.Expired {
+		view.Expired = append(view.Expired, application.ID.String())
+	}
+
+	for _, deletion := range report.Erased {
+		view.Erased = append(view.Erased, deletion.MemberID.String())
+	}
+
+	return view
+
+}
+
+func (v maintenanceReportView) print(w io.Writer) {
+	fmt.Fprintf(w, "escalated applications: %d\n", len(v.Escalated))
+	for _, id := range v.Escalated {
+		fmt.Fprintf(w, "  %s\n", id)
+	}
+	fmt.Fprintf(w, "expired applications:   %d\n", len(v.Expired))
+	for _, id := range v.Expired {
+		fmt.Fprintf(w, "  %s\n", id)
+	}
+	fmt.Fprintf(w, "erased members:         %d\n", len(v.Erased))
+	for _, id := range v.Erased {
+		fmt.Fprintf(w, "  %s\n", id)
+	}
+}
+
+type statsPeriodView struct {
+	From          string `json:"from"`
+	Until         string `json:"until"`
+	SignUps       uint   `json:"sign_ups"`
+	Logins        uint   `json:"logins"`
+	ActiveMembers uint   `json:"active_members"`
+}
+
+type reviewerStatsView struct {
+	Reviewer     string  `json:"reviewer"`
+	Approved     uint    `json:"approved"`
+	Rejected     uint    `json:"rejected"`
+	ApprovalRate float64 `json:"approval_rate"`
+}
+
+type statsView struct {
+	Interval              string              `json:"interval"`
+	From                  string              `json:"from"`
+	Until                 string              `json:"until"`
+	Periods               []statsPeriodView   `json:"periods"`
+	SignedUp              uint                `json:"signed_up"`
+	VerifiedEmailAddress  uint                `json:"verified_email_address"`
+	Applied               uint                `json:"applied"`
+	Verified              uint                `json:"verified"`
+	EmailVerificationRate float64             `json:"email_verification_rate"`
+	ApplicationsByState   map[string]uint     `json:"applications_by_state"`
+	MedianReviewTime      string              `json:"median_review_time,omitempty"`
+	Reviewers             []reviewerStatsView `json:"reviewers"`
+}
+
+func newStatsView(stats bl.CommunityStats) statsView {
+
+	view := statsView{
+		Interval:              string(stats.Interval),
+		From:                  stats.From.Format(time.RFC3339),
+		Until:                 stats.Until.Format(time.RFC3339),
+		Periods:               []statsPeriodView{},
+		SignedUp:              stats.Funnel.SignedUp,
+		VerifiedEmailAddress:  stats.Funnel.VerifiedEmailAddress,
+		Applied:               stats.Funnel.Applied,
+		Verified:              stats.Funnel.Verified,
+		EmailVerificationRate: stats.EmailVerificationRate,
+		ApplicationsByState:   map[string]uint{},
+		Reviewers:             []reviewerStatsView{},
+	}
+
+	for _, period := range stats.Periods {
+		view.Periods = append(view.Periods, statsPeriodView{
+			From:          period.From.Format(time.RFC3339),
+			Until:         period.Until.Format(time.RFC3339),
+			SignUps:       period.SignUps,
+			Logins:        period.Logins,
+			ActiveMembers: period.ActiveMembers,
+		})
+	}
+
+	for state, count := range stats.ApplicationsByState {
+		view.ApplicationsByState[string(state)] = count
+	}
+
+	if stats.MedianReviewTime != nil {
+		view.MedianReviewTime = stats.MedianReviewTime.String()
+	}
+
+	for _, reviewer := range stats.Reviewers {
+		view.Reviewers = append(view.Reviewers, reviewerStatsView{
+			Reviewer:     reviewer.Reviewer.String(),
+			Approved:     reviewer.Approved,
+			Rejected:     reviewer.Rejected,
+			ApprovalRate: reviewer.ApprovalRate,
+		})
+	}
+
+	return view
+
+}
+
+func (v statsView) print(w io.Writer) {
+	fmt.Fprintf(w, "%s to %s\n\n", v.From, v.Until)
+	fmt.Fprintf(w, "%-25s  %8s  %8s  %8s\n", "period", "sign-ups", "logins", "active")
+	for _, period := range v.Periods {
+		fmt.Fprintf(w, "%-25s  %8d  %8d  %8d\n", period.From, period.SignUps, period.Logins, period.ActiveMembers)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "signed up:          %d\n", v.SignedUp)
+	fmt.Fprintf(w, "verified email:     %d (%.1f%%)\n", v.VerifiedEmailAddress, v.EmailVerificationRate*100)
+	fmt.Fprintf(w, "applied:            %d\n", v.Applied)
+	fmt.Fprintf(w, "verified:           %d\n", v.Verified)
+	fmt.Fprintln(w)
+	states := make([]string, 0, len(v.ApplicationsByState))
+	for state := range v.ApplicationsByState {
+		states = append(states, state)
+	}
+	sort.Strings(states)
+	for _, state := range states {
+		fmt.Fprintf(w, "%-20s %d\n", state+":", v.ApplicationsByState[state])
+	}
+	if v.MedianReviewTime != "" {
+		fmt.Fprintf(w, "median review time: %s\n", v.MedianReviewTime)
+	}
+	for _, reviewer := range v.Reviewers {
+		fmt.Fprintf(w, "reviewer %s  approved: %d  rejected: %d  approval rate: %.1f%%\n", reviewer.Reviewer, reviewer.Approved, reviewer.Rejected, reviewer.ApprovalRate*100)
+	}
+}
@@ -0,0 +1,16 @@
+// Command community-admin runs the standalone commands of the community-admin tool,
+// e.g. generate-signing-key to create the first access token signing key.
+//
+// Commands that work with members and applications need the storage of the host.
+// Hosts build their own binary for them, as described in package cli.
+package main
+
+import (
+	"os"
+
+	"github.com/214alphadev/community-bl/cli"
+)
+
+func main() {
+	os.Exit(cli.Run(nil, os.Args[1:], os.Stdout, os.Stderr))
+}
@@ -0,0 +1,230 @@
+package community_bl
+
+import (
+	"errors"
+	"github.com/satori/go.uuid"
+	"strings"
+	"time"
+)
+
+type commentService struct {
+	memberRepository             MemberRepository
+	applicationRepository        ApplicationRepository
+	applicationCommentRepository ApplicationCommentRepository
+	transitionRepository         ApplicationTransitionRepository
+	transport                    Transport
+}
+
+// RequestInformation asks the applicant a question and moves the application into the InformationRequested state
+func (s *commentService) RequestInformation(applicationID ApplicationID, question string, reviewerID MemberIdentifier) (ApplicationCommentEntity, error) {
+
+	reviewer, application, err := s.authorizeReviewer(applicationID, reviewerID)
+	if err != nil {
+		return ApplicationCommentEntity{}, err
+	}
+
+	comment, err := s.comment(application, question, reviewer.ID, false, true)
+	if err != nil {
+		return ApplicationCommentEntity{}, err
+	}
+
+	if err := transitionApplication(s.applicationRepository, s.transitionRepository, application, ApplicationStateInformationRequested, &reviewer.ID, question); err != nil {
+		return ApplicationCommentEntity{}, err
+	}
+
+	applicant, err := s.memberRepository.FetchByID(application.MemberID)
+	if err != nil {
+		return ApplicationCommentEntity{}, err
+	}
+
+	if applicant == nil {
+		return ApplicationCommentEntity{}, errors.New("MemberDoesNotExist")
+	}
+
+	if err := s.transport.SendApplicationCommentNotification(*applicant, *application, comment); err != nil {
+		return ApplicationCommentEntity{}, err
+	}
+
+	return comment, nil
+
+}
+
+// AddInternalNote adds a note that is only visible to reviewers
+func (s *commentService) AddInternalNote(applicationID ApplicationID, note string, reviewerID MemberIdentifier) (ApplicationCommentEntity, error) {
+
+	reviewer, application, err := s.authorizeReviewer(applicationID, reviewerID)
+	if err != nil {
+		return ApplicationCommentEntity{}, err
+	}
+
+	return s.comment(application, note, reviewer.ID, true, false)
+
+}
+
+// AnswerApplication adds a comment of the applicant. If information has been requested the
+// application moves back into the Pending state and the asking reviewers are notified.
+func (s *commentService) AnswerApplication(applicationID ApplicationID, answer string, memberID MemberIdentifier) (ApplicationCommentEntity, error) {
+
+	application, err := s.applicationRepository.FetchByID(applicationID)
+	if err != nil {
+		return ApplicationCommentEntity{}, err
+	}
+
+	if application == nil {
+		return ApplicationCommentEntity{}, errors.New("ApplicationDoesNotExist")
+	}
+
+	if application.MemberID != memberID {
+		return ApplicationCommentEntity{}, AuthorizationErrorNotAllowedToAccessApplication
+	}
+
+	if !application.State.open() {
+		return ApplicationCommentEntity{}, errors.New("ApplicationReviewed")
+	}
+
+	comments, err := s.applicationCommentRepository.FetchByApplication(application.ID)
+	if err != nil {
+		return ApplicationCommentEntity{}, err
+	}
+
+	comment, err := s.comment(application, answer, memberID, false, false)
+	if err != nil {
+		return ApplicationCommentEntity{}, err
+	}
+
+	if application.State != ApplicationStateInformationRequested {
+		return comment, nil
+	}
+
+	if err := transitionApplication(s.applicationRepository, s.transitionRepository, application, ApplicationStatePending, &memberID, ""); err != nil {
+		return ApplicationCommentEntity{}, err
+	}
+
+	notified := map[MemberIdentifier]bool{}
+	for _, c := range comments {
+
+		if !c.Question || notified[c.AuthorID] {
+			continue
+		}
+		notified[c.AuthorID] = true
+
+		reviewer, err := s.memberRepository.FetchByID(c.AuthorID)
+		if err != nil {
+			return ApplicationCommentEntity{}, err
+		}
+
+		// the reviewer might have been erased in the meantime
+		if reviewer == nil || reviewer.DeletedAt != nil {
+			continue
+		}
+
+		if err := s.transport.SendApplicationCommentNotification(*reviewer, *application, comment); err != nil {
+			return ApplicationCommentEntity{}, err
+		}
+
+	}
+
+	return comment, nil
+
+}
+
+// ApplicationComments returns the comment thread of the application. Applicants don't see internal notes.
+func (s *commentService) ApplicationComments(applicationID ApplicationID, requesterID MemberIdentifier) ([]ApplicationCommentEntity, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if requester == nil {
+		return nil, errors.New("RequesterNotFound")
+	}
+
+	application, err := s.applicationRepository.FetchByID(applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if application == nil {
+		return nil, errors.New("ApplicationDoesNotExist")
+	}
+
+	comments, err := s.applicationCommentRepository.FetchByApplication(application.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if requester.HasPermission(PermissionApplicationsRead) {
+		return comments, nil
+	}
+
+	if application.MemberID != requester.ID {
+		return nil, AuthorizationErrorNotAllowedToAccessApplication
+	}
+
+	visible := []ApplicationCommentEntity{}
+	for _, comment := range comments {
+		if !comment.Internal {
+			visible = append(visible, comment)
+		}
+	}
+
+	return visible, nil
+
+}
+
+func (s *commentService) comment(application *ApplicationEntity, text string, authorID MemberIdentifier, internal bool, question bool) (ApplicationCommentEntity, error) {
+
+	if strings.TrimSpace(text) == "" {
+		return ApplicationCommentEntity{}, errors.New("comment must not be empty")
+	}
+
+	comment := ApplicationCommentEntity{
+		ID:            uuid.NewV4(),
+		ApplicationID: application.ID,
+		AuthorID:      authorID,
+		Text:          text,
+		Internal:      internal,
+		Question:      question,
+		CreatedAt:     time.Now(),
+	}
+
+	if err := s.applicationCommentRepository.Save(comment); err != nil {
+		return ApplicationCommentEntity{}, err
+	}
+
+	return comment, nil
+
+}
+
+func (s *commentService) authorizeReviewer(applicationID ApplicationID, reviewerID MemberIdentifier) (*MemberEntity, *ApplicationEntity, error) {
+
+	reviewer, err := s.memberRepository.FetchByID(reviewerID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if reviewer == nil {
+		return nil, nil, errors.New("ReviewerDoesNotExist")
+	}
+
+	if !reviewer.HasPermission(PermissionApplicationsReview) {
+		return nil, nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	application, err := s.applicationRepository.FetchByID(applicationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if application == nil {
+		return nil, nil, errors.New("ApplicationDoesNotExist")
+	}
+
+	if !application.State.open() {
+		return nil, nil, errors.New("ApplicationReviewed")
+	}
+
+	return reviewer, application, nil
+
+}
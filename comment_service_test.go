@@ -0,0 +1,85 @@
+package community_bl
+
+import (
+	"testing"
+)
+
+func TestRequestInformation(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	applicant := c.signUp("applicant")
+	application := c.apply(applicant)
+
+	_, err := c.RequestInformation(application.ID, " ", reviewer.ID)
+	expectError(t, err, "comment must not be empty")
+
+	if _, err := c.RequestInformation(application.ID, "Who referred you?", reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.application(application.ID).State != ApplicationStateInformationRequested {
+		t.Fatal("expected the application to wait for the applicant")
+	}
+
+	if len(c.transport.commentRecipients) != 1 || c.transport.commentRecipients[0] != applicant.ID {
+		t.Fatalf("expected the applicant to be notified, got: %v", c.transport.commentRecipients)
+	}
+
+	if _, err := c.AnswerApplication(application.ID, "A friend", applicant.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.application(application.ID).State != ApplicationStatePending {
+		t.Fatal("expected the answer to move the application back into the pending state")
+	}
+
+	if len(c.transport.commentRecipients) != 2 || c.transport.commentRecipients[1] != reviewer.ID {
+		t.Fatalf("expected the asking reviewer to be notified, got: %v", c.transport.commentRecipients)
+	}
+
+}
+
+func TestInternalNotesAreHiddenFromApplicants(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	applicant := c.signUp("applicant")
+	other := c.signUp("other")
+	application := c.apply(applicant)
+
+	_, err := c.AddInternalNote(application.ID, "knows the founder", applicant.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	if _, err := c.AddInternalNote(application.ID, "knows the founder", reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.AnswerApplication(application.ID, "Anything else you need?", applicant.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	comments, err := c.ApplicationComments(application.ID, reviewer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(comments) != 2 || !comments[0].Internal {
+		t.Fatalf("expected the reviewer to see the note and the answer, got: %v", comments)
+	}
+
+	comments, err = c.ApplicationComments(application.ID, applicant.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(comments) != 1 || comments[0].Internal {
+		t.Fatalf("expected the applicant to only see the answer, got: %v", comments)
+	}
+
+	_, err = c.ApplicationComments(application.ID, other.ID)
+	expectError(t, err, "NotAllowedToAccessApplication")
+
+}
@@ -1,90 +1,675 @@
 package community_bl
 
 import (
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"github.com/satori/go.uuid"
 	vo "github.com/214alphadev/community-bl/value_objects"
 	"reflect"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// ApplicationsQuery filters applications. Applications are sorted by the time they have been created.
+// Time bounds are half-open: After bounds are inclusive and Before bounds exclusive, so [After, Before).
 type ApplicationsQuery struct {
-	Position *ApplicationID
-	Next     uint
-	State    ApplicationState
+	// Cursor continues a previous query. It's the NextCursor of the previous result.
+	Cursor string
+	Next   uint
+	// States matches applications in any of the states. Empty matches every state.
+	States []ApplicationState
+	Member *MemberIdentifier
+	// Reviewer matches applications the reviewer approved or rejected
+	Reviewer *MemberIdentifier
+	// AssignedTo only matches applications with a claim of the reviewer that is active at ClaimsActiveAt
+	AssignedTo *MemberIdentifier
+	// Unassigned only matches applications nobody claimed or whose claim isn't active at ClaimsActiveAt
+	Unassigned bool
+	// ClaimsActiveAt is the time claims are evaluated at. Claims that expired at that time
+	// are treated as released. It defaults to now.
+	ClaimsActiveAt *time.Time
+	// Escalated matches applications whose SLA escalation has (or hasn't) been sent
+	Escalated     *bool
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// TransitionedBefore matches applications that moved into their current state before the time
+	TransitionedBefore *time.Time
+	ApprovedAfter      *time.Time
+	ApprovedBefore     *time.Time
+	RejectedAfter      *time.Time
+	RejectedBefore     *time.Time
+	// Search matches the words of the ApplicationText.
+	// Repositories may use full text search.
+	Search         string
+	SortDescending bool
+}
+
+// ApplicationsCursor is the position after which a query continues.
+// It's handed out to callers encoded as an opaque string.
+type ApplicationsCursor struct {
+	CreatedAt time.Time
+	ID        ApplicationID
+}
+
+func (c ApplicationsCursor) encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%s", c.CreatedAt.UnixNano(), c.ID.String())))
+}
+
+func decodeApplicationsCursor(cursor string) (ApplicationsCursor, error) {
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return ApplicationsCursor{}, errors.New("InvalidCursor")
+	}
+
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 {
+		return ApplicationsCursor{}, errors.New("InvalidCursor")
+	}
+
+	createdAt, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return ApplicationsCursor{}, errors.New("InvalidCursor")
+	}
+
+	id, err := uuid.FromString(parts[1])
+	if err != nil {
+		return ApplicationsCursor{}, errors.New("InvalidCursor")
+	}
+
+	return ApplicationsCursor{
+		CreatedAt: time.Unix(0, createdAt),
+		ID:        id,
+	}, nil
+
+}
+
+type ApplicationsResult struct {
+	Applications []ApplicationEntity
+	// Total is the amount of applications matching the query, independent of the cursor
+	Total      uint
+	NextCursor string
+	HasMore    bool
+}
+
+type MembersQuerySort string
+
+var MembersQuerySortCreatedAt = MembersQuerySort("CreatedAt")
+var MembersQuerySortUsername = MembersQuerySort("Username")
+var MembersQuerySortProperName = MembersQuerySort("ProperName")
+
+func (s MembersQuerySort) Valid() bool {
+
+	switch s {
+	case MembersQuerySortCreatedAt:
+		return true
+	case MembersQuerySortUsername:
+		return true
+	case MembersQuerySortProperName:
+		return true
+	default:
+		return false
+	}
+
+}
+
+// MembersQuery filters members. Nil filters are ignored.
+// CreatedAfter is inclusive and CreatedBefore exclusive, so the range is [CreatedAfter, CreatedBefore).
+type MembersQuery struct {
+	Position             *MemberIdentifier
+	Next                 uint
+	Verified             *bool
+	VerifiedEmailAddress *bool
+	Role                 *Role
+	Banned               *bool
+	InvitedBy            *MemberIdentifier
+	CreatedAfter         *time.Time
+	CreatedBefore        *time.Time
+	// Applied matches members that submitted at least one application
+	Applied *bool
+	// Search matches the beginning of the username, first name or last name.
+	// Repositories may match fuzzy.
+	Search         string
+	Sort           MembersQuerySort
+	SortDescending bool
+	IncludeDeleted bool
+}
+
+// DirectoryEntry is the public view of a member that doesn't contain private data like the email address
+type DirectoryEntry struct {
+	ID          MemberIdentifier
+	Username    vo.Username
+	Metadata    MetadataEntity
+	Verified    bool
+	Roles       []Role
+	MemberSince time.Time
 }
 
 type CommunityInterface interface {
 
 	SignUp(username vo.Username, emailAddress vo.EmailAddress, metadata MetadataEntity) (MemberEntity, error)
 
+	SignUpWithInvitation(username vo.Username, emailAddress vo.EmailAddress, metadata MetadataEntity, invitationCode vo.InvitationCode) (MemberEntity, error)
+
+	CreateInvitation(options InvitationOptions, requester MemberIdentifier) (InvitationEntity, error)
+
+	RevokeInvitation(invitation uuid.UUID, requester MemberIdentifier) error
+
+	Invitations(member MemberIdentifier, requester MemberIdentifier) ([]InvitationEntity, error)
+
 	RequestLogin(emailAddress vo.EmailAddress) error
 
 	Login(emailAddress vo.EmailAddress, memberAccessPublicKey vo.MemberAccessPublicKey, confirmationCode vo.ConfirmationCode) (MemberAccessTokenEntity, error)
 
+	UpdateProfile(member MemberIdentifier, changes ProfileChanges, requester MemberIdentifier) (MemberEntity, error)
+
+	ChangeUsername(member MemberIdentifier, username vo.Username, requester MemberIdentifier) (MemberEntity, error)
+
+	UsernameHistory(member MemberIdentifier) ([]UsernameChangeEntity, error)
+
+	ResolveUsername(username vo.Username) (MemberEntity, error)
+
+	RequestEmailChange(member MemberIdentifier, newEmailAddress vo.EmailAddress) error
+
+	ConfirmEmailChange(member MemberIdentifier, newEmailAddress vo.EmailAddress, confirmationCode vo.ConfirmationCode) (MemberEntity, error)
+
+	DeleteMember(member MemberIdentifier, requester MemberIdentifier) (MemberDeletionEntity, error)
+
+	CancelMemberDeletion(member MemberIdentifier, requester MemberIdentifier) error
+
+	EraseDueMembers(now time.Time) ([]MemberDeletionEntity, error)
+
+	RunMaintenance(now time.Time) (MaintenanceReport, error)
+
+	ExportMemberData(member MemberIdentifier, requester MemberIdentifier) (MemberDataExport, error)
+
+	SuspendMember(member MemberIdentifier, reason string, until time.Time, requester MemberIdentifier) (SanctionEntity, error)
+
+	BanMember(member MemberIdentifier, reason string, requester MemberIdentifier) (SanctionEntity, error)
+
+	Unsuspend(member MemberIdentifier, reason string, requester MemberIdentifier) error
+
+	LiftBan(member MemberIdentifier, reason string, requester MemberIdentifier) error
+
+	Sanctions(member MemberIdentifier, requester MemberIdentifier) ([]SanctionEntity, error)
+
 	ApplyForVerification(applicationText string, member MemberIdentifier) (ApplicationEntity, error)
 
+	SubmitApplication(member MemberIdentifier, answers []ApplicationAnswer) (ApplicationEntity, error)
+
+	EditApplication(application ApplicationID, applicationText string, answers []ApplicationAnswer, member MemberIdentifier) (ApplicationEntity, error)
+
+	WithdrawApplication(application ApplicationID, member MemberIdentifier) error
+
+	ApplicationRevisions(application ApplicationID, requester MemberIdentifier) ([]ApplicationRevisionEntity, error)
+
+	PublishApplicationForm(questions []FormQuestion, requester MemberIdentifier) (ApplicationFormEntity, error)
+
+	ApplicationForm(version uint) (ApplicationFormEntity, error)
+
 	ApproveApplication(applicationID ApplicationID, reviewer MemberIdentifier) error
 
 	RejectApplication(applicationID ApplicationID, reason string, reviewer MemberIdentifier) error
 
-	Applications(query ApplicationsQuery, requester MemberIdentifier) ([]ApplicationEntity, error)
+	RequestInformation(application ApplicationID, question string, reviewer MemberIdentifier) (ApplicationCommentEntity, error)
+
+	AddInternalNote(application ApplicationID, note string, reviewer MemberIdentifier) (ApplicationCommentEntity, error)
+
+	AnswerApplication(application ApplicationID, answer string, member MemberIdentifier) (ApplicationCommentEntity, error)
+
+	ApplicationComments(application ApplicationID, requester MemberIdentifier) ([]ApplicationCommentEntity, error)
+
+	RejectApplicationWithCoolDown(application ApplicationID, reason string, coolDown time.Duration, reviewer MemberIdentifier) error
+
+	FileAppeal(application ApplicationID, statement string, member MemberIdentifier) (AppealEntity, error)
+
+	OverturnAppeal(appeal uuid.UUID, decision string, reviewer MemberIdentifier) error
+
+	UpholdAppeal(appeal uuid.UUID, decision string, reviewer MemberIdentifier) error
+
+	Appeals(state AppealState, requester MemberIdentifier) ([]AppealEntity, error)
+
+	ApplicationAppeal(application ApplicationID, requester MemberIdentifier) (AppealEntity, error)
+
+	RevokeVerification(member MemberIdentifier, reason string, reviewer MemberIdentifier) (VerificationRevocationEntity, error)
+
+	VerificationRevocations(member MemberIdentifier, requester MemberIdentifier) ([]VerificationRevocationEntity, error)
+
+	CastVote(application ApplicationID, decision ReviewDecision, comment string, reviewer MemberIdentifier) (ReviewVoteEntity, error)
+
+	Votes(application ApplicationID, requester MemberIdentifier) ([]ReviewVoteEntity, error)
+
+	Vouch(application ApplicationID, voucher MemberIdentifier) (VouchEntity, error)
+
+	RevokeVouch(application ApplicationID, voucher MemberIdentifier) error
+
+	Vouches(application ApplicationID, requester MemberIdentifier) ([]VouchEntity, error)
+
+	Applications(query ApplicationsQuery, requester MemberIdentifier) (ApplicationsResult, error)
+
+	ClaimApplication(application ApplicationID, reviewer MemberIdentifier) (ApplicationEntity, error)
+
+	UnclaimApplication(application ApplicationID, requester MemberIdentifier) error
+
+	ReviewQueue(reviewer MemberIdentifier) ([]ApplicationEntity, error)
 
 	Application(application ApplicationID, requester MemberIdentifier) (ApplicationEntity, error)
 
 	GetLastApplication(member MemberIdentifier, requester MemberIdentifier) (ApplicationEntity, error)
 
+	ApplicationHistory(member MemberIdentifier, requester MemberIdentifier) ([]ApplicationHistoryEntry, error)
+
 	GetMemberByAccessToken(accessToken string) (MemberEntity, error)
 
 	GetMember(id MemberIdentifier) (MemberEntity, error)
 
 	GetApplication(id ApplicationID) (ApplicationEntity, error)
 
-	Promote(emailAddress vo.EmailAddress) error
+	Members(query MembersQuery, requester MemberIdentifier) ([]MemberEntity, error)
+
+	Directory(query MembersQuery, requester MemberIdentifier) ([]DirectoryEntry, error)
+
+	GetMemberByEmailAddress(emailAddress vo.EmailAddress) (MemberEntity, error)
+
+	GetMemberByUsername(username vo.Username) (MemberEntity, error)
+
+	Promote(emailAddress vo.EmailAddress, requester MemberIdentifier) error
+
+	BootstrapOwner(emailAddress vo.EmailAddress) error
+
+	Demote(emailAddress vo.EmailAddress, requester MemberIdentifier) error
+
+	GrantRole(member MemberIdentifier, role Role, requester MemberIdentifier) error
+
+	RevokeRole(member MemberIdentifier, role Role, requester MemberIdentifier) error
+
+	RoleChanges(member MemberIdentifier, requester MemberIdentifier) ([]RoleChangeEntity, error)
+
+	AuditLog(query AuditLogQuery, requester MemberIdentifier) ([]AuditEntryEntity, error)
+
+	Stats(query StatsQuery, requester MemberIdentifier) (CommunityStats, error)
+
+	VerifyAuditLog(requester MemberIdentifier) error
+
+	RevokeAccessToken(member MemberIdentifier, requester MemberIdentifier) error
+
+	ResendConfirmationCode(emailAddress vo.EmailAddress, requester MemberIdentifier) error
+
+	InvalidateConfirmationCodes(emailAddress vo.EmailAddress, requester MemberIdentifier) error
 
 	OnApplicationApproved(cb func(member MemberEntity))
 
 	OnLogin(cb func(member MemberEntity))
 
+	OnSignUp(cb func(member MemberEntity))
+
+	OnApplicationSubmitted(cb func(application ApplicationEntity))
+
+	OnApplicationRejected(cb func(application ApplicationEntity))
+
+	OnRoleChanged(cb func(change RoleChangeEntity))
+
+	OnProfileChanged(cb func(member MemberEntity))
+
+	OnMemberDeleted(cb func(member MemberEntity))
+
+	OnMemberErased(cb func(member MemberEntity))
+
+	OnVerificationRevoked(cb func(member MemberEntity))
+
+	OnApplicationExpired(cb func(application ApplicationEntity))
+
+	OnAuditFailed(cb func(action AuditAction, err error))
+
+	OnAutoAssignFailed(cb func(application ApplicationEntity, err error))
+
 }
 
 type Community struct {
-	communityService *communityService
-	memberService    *memberService
+	communityService   *communityService
+	memberService      *memberService
+	erasureService     *erasureService
+	exportService      *exportService
+	moderationService  *moderationService
+	invitationService  *invitationService
+	vouchService       *vouchService
+	commentService     *commentService
+	formService        *formService
+	appealService      *appealService
+	auditService       *auditService
+	assignmentService  *assignmentService
+	maintenanceService *maintenanceService
+	statsService       *statsService
 }
 
 func (c *Community) SignUp(username vo.Username, emailAddress vo.EmailAddress, metadata MetadataEntity) (MemberEntity, error) {
 	return c.memberService.SignUp(username, emailAddress, metadata)
 }
 
+func (c *Community) SignUpWithInvitation(username vo.Username, emailAddress vo.EmailAddress, metadata MetadataEntity, invitationCode vo.InvitationCode) (MemberEntity, error) {
+
+	member, err := c.invitationService.SignUpWithInvitation(username, emailAddress, metadata, invitationCode)
+
+	// the invitation skipped the verification on behalf of the inviter
+	if err == nil && member.Verified {
+		c.auditService.record(AuditActionMemberVerified, member.InvitedBy, &member.ID, "invitation", nil)
+	}
+
+	return member, err
+
+}
+
+func (c *Community) CreateInvitation(options InvitationOptions, requester MemberIdentifier) (InvitationEntity, error) {
+	invitation, err := c.invitationService.CreateInvitation(options, requester)
+	return invitation, c.auditService.record(AuditActionInvitationCreated, &requester, &invitation.ID, "", err)
+}
+
+func (c *Community) RevokeInvitation(invitation uuid.UUID, requester MemberIdentifier) error {
+	err := c.invitationService.RevokeInvitation(invitation, requester)
+	return c.auditService.record(AuditActionInvitationRevoked, &requester, &invitation, "", err)
+}
+
+func (c *Community) Invitations(member MemberIdentifier, requester MemberIdentifier) ([]InvitationEntity, error) {
+	invitations, err := c.invitationService.Invitations(member, requester)
+	return invitations, c.auditService.recordDenied(AuditActionMembersRead, requester, &member, err)
+}
+
 func (c *Community) RequestLogin(emailAddress vo.EmailAddress) error {
 	return c.memberService.RequestLogin(emailAddress)
 }
 
 func (c *Community) Login(emailAddress vo.EmailAddress, memberAccessPublicKey vo.MemberAccessPublicKey, confirmationCode vo.ConfirmationCode) (MemberAccessTokenEntity, error) {
-	return c.memberService.Login(emailAddress, memberAccessPublicKey, confirmationCode)
+	accessToken, err := c.memberService.Login(emailAddress, memberAccessPublicKey, confirmationCode)
+	if err != nil {
+		return MemberAccessTokenEntity{}, c.auditService.record(AuditActionLogin, nil, c.auditService.memberByEmailAddress(emailAddress), "", err)
+	}
+
+	// the detail references the access token issued by the login
+	return accessToken, c.auditService.record(AuditActionLogin, &accessToken.Subject, &accessToken.Subject, accessToken.ID.String(), nil)
+}
+
+func (c *Community) UpdateProfile(member MemberIdentifier, changes ProfileChanges, requester MemberIdentifier) (MemberEntity, error) {
+	updated, err := c.memberService.UpdateProfile(member, changes, requester)
+	return updated, c.auditService.record(AuditActionProfileChanged, &requester, &member, "profile", err)
+}
+
+func (c *Community) ChangeUsername(member MemberIdentifier, username vo.Username, requester MemberIdentifier) (MemberEntity, error) {
+	updated, err := c.memberService.ChangeUsername(member, username, requester)
+	return updated, c.auditService.record(AuditActionProfileChanged, &requester, &member, "username", err)
+}
+
+func (c *Community) UsernameHistory(member MemberIdentifier) ([]UsernameChangeEntity, error) {
+	return c.memberService.UsernameHistory(member)
+}
+
+func (c *Community) ResolveUsername(username vo.Username) (MemberEntity, error) {
+	return c.memberService.ResolveUsername(username)
+}
+
+func (c *Community) RequestEmailChange(member MemberIdentifier, newEmailAddress vo.EmailAddress) error {
+	return c.memberService.RequestEmailChange(member, newEmailAddress)
+}
+
+func (c *Community) ConfirmEmailChange(member MemberIdentifier, newEmailAddress vo.EmailAddress, confirmationCode vo.ConfirmationCode) (MemberEntity, error) {
+	updated, err := c.memberService.ConfirmEmailChange(member, newEmailAddress, confirmationCode)
+	return updated, c.auditService.record(AuditActionProfileChanged, &member, &member, "email address", err)
+}
+
+func (c *Community) DeleteMember(member MemberIdentifier, requester MemberIdentifier) (MemberDeletionEntity, error) {
+	deletion, err := c.erasureService.DeleteMember(member, requester)
+	return deletion, c.auditService.record(AuditActionMemberDeleted, &requester, &member, "", err)
+}
+
+func (c *Community) CancelMemberDeletion(member MemberIdentifier, requester MemberIdentifier) error {
+	err := c.erasureService.CancelMemberDeletion(member, requester)
+	return c.auditService.record(AuditActionMemberDeletionCancelled, &requester, &member, "", err)
+}
+
+func (c *Community) EraseDueMembers(now time.Time) ([]MemberDeletionEntity, error) {
+	erased, err := c.erasureService.EraseDueMembers(now)
+	c.recordErasures(erased)
+	return erased, err
+}
+
+// recordErasures records the erasures done by the host without an acting member
+func (c *Community) recordErasures(erased []MemberDeletionEntity) {
+	for _, deletion := range erased {
+		c.auditService.record(AuditActionMemberErased, nil, &deletion.MemberID, "", nil)
+	}
+}
+
+func (c *Community) RunMaintenance(now time.Time) (MaintenanceReport, error) {
+
+	report, err := c.maintenanceService.RunMaintenance(now)
+
+	for _, expired := range report.Expired {
+		c.auditService.record(AuditActionApplicationExpired, nil, &expired.ID, "", nil)
+	}
+
+	c.recordErasures(report.Erased)
+
+	return report, err
+
+}
+
+func (c *Community) ExportMemberData(member MemberIdentifier, requester MemberIdentifier) (MemberDataExport, error) {
+	export, err := c.exportService.ExportMemberData(member, requester)
+	return export, c.auditService.record(AuditActionMemberExported, &requester, &member, "", err)
+}
+
+func (c *Community) SuspendMember(member MemberIdentifier, reason string, until time.Time, requester MemberIdentifier) (SanctionEntity, error) {
+	sanction, err := c.moderationService.SuspendMember(member, reason, until, requester)
+	return sanction, c.auditService.record(AuditActionMemberSuspended, &requester, &member, reason, err)
+}
+
+func (c *Community) BanMember(member MemberIdentifier, reason string, requester MemberIdentifier) (SanctionEntity, error) {
+	sanction, err := c.moderationService.BanMember(member, reason, requester)
+	return sanction, c.auditService.record(AuditActionMemberBanned, &requester, &member, reason, err)
+}
+
+func (c *Community) Unsuspend(member MemberIdentifier, reason string, requester MemberIdentifier) error {
+	err := c.moderationService.Unsuspend(member, reason, requester)
+	return c.auditService.record(AuditActionMemberUnsuspended, &requester, &member, reason, err)
+}
+
+func (c *Community) LiftBan(member MemberIdentifier, reason string, requester MemberIdentifier) error {
+	err := c.moderationService.LiftBan(member, reason, requester)
+	return c.auditService.record(AuditActionBanLifted, &requester, &member, reason, err)
+}
+
+func (c *Community) Sanctions(member MemberIdentifier, requester MemberIdentifier) ([]SanctionEntity, error) {
+	sanctions, err := c.moderationService.Sanctions(member, requester)
+	return sanctions, c.auditService.recordDenied(AuditActionMembersRead, requester, &member, err)
 }
 
 func (c *Community) ApplyForVerification(applicationText string, member MemberIdentifier) (ApplicationEntity, error) {
 	return c.communityService.ApplyForVerification(member, applicationText)
 }
 
+func (c *Community) SubmitApplication(member MemberIdentifier, answers []ApplicationAnswer) (ApplicationEntity, error) {
+	return c.communityService.SubmitApplication(member, answers)
+}
+
+func (c *Community) EditApplication(application ApplicationID, applicationText string, answers []ApplicationAnswer, member MemberIdentifier) (ApplicationEntity, error) {
+	return c.communityService.EditApplication(application, applicationText, answers, member)
+}
+
+func (c *Community) WithdrawApplication(application ApplicationID, member MemberIdentifier) error {
+	return c.communityService.WithdrawApplication(application, member)
+}
+
+func (c *Community) ApplicationRevisions(application ApplicationID, requester MemberIdentifier) ([]ApplicationRevisionEntity, error) {
+	revisions, err := c.communityService.ApplicationRevisions(application, requester)
+	return revisions, c.auditService.recordDenied(AuditActionApplicationsRead, requester, &application, err)
+}
+
+func (c *Community) PublishApplicationForm(questions []FormQuestion, requester MemberIdentifier) (ApplicationFormEntity, error) {
+	form, err := c.formService.PublishApplicationForm(questions, requester)
+	return form, c.auditService.record(AuditActionApplicationFormPublished, &requester, &form.ID, "", err)
+}
+
+func (c *Community) ApplicationForm(version uint) (ApplicationFormEntity, error) {
+	return c.formService.ApplicationForm(version)
+}
+
 func (c *Community) ApproveApplication(applicationID ApplicationID, reviewer MemberIdentifier) error {
-	return c.communityService.ApproveApplication(applicationID, reviewer)
+	state, err := c.communityService.ApproveApplication(applicationID, reviewer)
+	return c.recordVote(applicationID, ReviewDecisionApprove, state, reviewer, err)
 }
 
 func (c *Community) RejectApplication(applicationID ApplicationID, reason string, reviewer MemberIdentifier) error {
-	return c.communityService.RejectApplication(applicationID, reason, reviewer)
+	state, err := c.communityService.RejectApplication(applicationID, reason, reviewer)
+	return c.recordVote(applicationID, ReviewDecisionReject, state, reviewer, err)
+}
+
+// recordVote records a vote that decided the application as review and a vote that left it open as vote
+func (c *Community) recordVote(application ApplicationID, decision ReviewDecision, state ApplicationState, reviewer MemberIdentifier, err error) error {
+	action := AuditActionApplicationReviewed
+	if err == nil && state.open() {
+		action = AuditActionApplicationVoted
+	}
+	return c.auditService.record(action, &reviewer, &application, string(decision), err)
+}
+
+func (c *Community) RequestInformation(application ApplicationID, question string, reviewer MemberIdentifier) (ApplicationCommentEntity, error) {
+	comment, err := c.commentService.RequestInformation(application, question, reviewer)
+	return comment, c.auditService.record(AuditActionInformationRequested, &reviewer, &application, "", err)
+}
+
+func (c *Community) AddInternalNote(application ApplicationID, note string, reviewer MemberIdentifier) (ApplicationCommentEntity, error) {
+	return c.commentService.AddInternalNote(application, note, reviewer)
+}
+
+func (c *Community) AnswerApplication(application ApplicationID, answer string, member MemberIdentifier) (ApplicationCommentEntity, error) {
+	return c.commentService.AnswerApplication(application, answer, member)
+}
+
+func (c *Community) ApplicationComments(application ApplicationID, requester MemberIdentifier) ([]ApplicationCommentEntity, error) {
+	comments, err := c.commentService.ApplicationComments(application, requester)
+	return comments, c.auditService.recordDenied(AuditActionApplicationsRead, requester, &application, err)
+}
+
+func (c *Community) RevokeVerification(member MemberIdentifier, reason string, reviewer MemberIdentifier) (VerificationRevocationEntity, error) {
+	revocation, err := c.communityService.RevokeVerification(member, reason, reviewer)
+	return revocation, c.auditService.record(AuditActionVerificationRevoked, &reviewer, &member, reason, err)
+}
+
+func (c *Community) VerificationRevocations(member MemberIdentifier, requester MemberIdentifier) ([]VerificationRevocationEntity, error) {
+	revocations, err := c.communityService.VerificationRevocations(member, requester)
+	return revocations, c.auditService.recordDenied(AuditActionMembersRead, requester, &member, err)
+}
+
+func (c *Community) RejectApplicationWithCoolDown(application ApplicationID, reason string, coolDown time.Duration, reviewer MemberIdentifier) error {
+	state, err := c.communityService.RejectApplicationWithCoolDown(application, reason, coolDown, reviewer)
+	return c.recordVote(application, ReviewDecisionReject, state, reviewer, err)
+}
+
+func (c *Community) FileAppeal(application ApplicationID, statement string, member MemberIdentifier) (AppealEntity, error) {
+	return c.appealService.FileAppeal(application, statement, member)
+}
+
+func (c *Community) OverturnAppeal(appeal uuid.UUID, decision string, reviewer MemberIdentifier) error {
+	err := c.appealService.OverturnAppeal(appeal, decision, reviewer)
+	return c.auditService.record(AuditActionAppealDecided, &reviewer, &appeal, string(AppealStateOverturned), err)
+}
+
+func (c *Community) UpholdAppeal(appeal uuid.UUID, decision string, reviewer MemberIdentifier) error {
+	err := c.appealService.UpholdAppeal(appeal, decision, reviewer)
+	return c.auditService.record(AuditActionAppealDecided, &reviewer, &appeal, string(AppealStateUpheld), err)
+}
+
+func (c *Community) Appeals(state AppealState, requester MemberIdentifier) ([]AppealEntity, error) {
+	appeals, err := c.appealService.Appeals(state, requester)
+	return appeals, c.auditService.recordDenied(AuditActionApplicationsRead, requester, nil, err)
+}
+
+func (c *Community) ApplicationAppeal(application ApplicationID, requester MemberIdentifier) (AppealEntity, error) {
+	appeal, err := c.appealService.ApplicationAppeal(application, requester)
+	return appeal, c.auditService.recordDenied(AuditActionApplicationsRead, requester, &application, err)
+}
+
+func (c *Community) CastVote(application ApplicationID, decision ReviewDecision, comment string, reviewer MemberIdentifier) (ReviewVoteEntity, error) {
+	vote, state, err := c.communityService.CastVote(application, decision, comment, reviewer)
+	return vote, c.recordVote(application, decision, state, reviewer, err)
+}
+
+func (c *Community) Votes(application ApplicationID, requester MemberIdentifier) ([]ReviewVoteEntity, error) {
+	votes, err := c.communityService.Votes(application, requester)
+	return votes, c.auditService.recordDenied(AuditActionApplicationsRead, requester, &application, err)
+}
+
+func (c *Community) Vouch(application ApplicationID, voucher MemberIdentifier) (VouchEntity, error) {
+
+	vouch, approved, err := c.vouchService.Vouch(application, voucher)
+
+	// the vouch completed the required vouches and approved the application
+	if approved {
+		c.auditService.record(AuditActionApplicationApproved, &voucher, &application, "vouches", nil)
+	}
+
+	return vouch, err
+
+}
+
+func (c *Community) RevokeVouch(application ApplicationID, voucher MemberIdentifier) error {
+	return c.vouchService.RevokeVouch(application, voucher)
+}
+
+func (c *Community) Vouches(application ApplicationID, requester MemberIdentifier) ([]VouchEntity, error) {
+	vouches, err := c.vouchService.Vouches(application, requester)
+	return vouches, c.auditService.recordDenied(AuditActionApplicationsRead, requester, &application, err)
 }
 
-func (c *Community) Applications(query ApplicationsQuery, requester MemberIdentifier) ([]ApplicationEntity, error) {
-	return c.communityService.Applications(query, requester)
+func (c *Community) Applications(query ApplicationsQuery, requester MemberIdentifier) (ApplicationsResult, error) {
+	result, err := c.communityService.Applications(query, requester)
+	return result, c.auditService.recordDenied(AuditActionApplicationsRead, requester, nil, err)
+}
+
+func (c *Community) ClaimApplication(application ApplicationID, reviewer MemberIdentifier) (ApplicationEntity, error) {
+	claimed, err := c.assignmentService.ClaimApplication(application, reviewer)
+	return claimed, c.auditService.record(AuditActionApplicationClaimed, &reviewer, &application, "", err)
+}
+
+func (c *Community) UnclaimApplication(application ApplicationID, requester MemberIdentifier) error {
+	err := c.assignmentService.UnclaimApplication(application, requester)
+	return c.auditService.record(AuditActionApplicationUnclaimed, &requester, &application, "", err)
+}
+
+func (c *Community) ReviewQueue(reviewer MemberIdentifier) ([]ApplicationEntity, error) {
+	queue, err := c.assignmentService.ReviewQueue(reviewer)
+	return queue, c.auditService.recordDenied(AuditActionApplicationsRead, reviewer, nil, err)
 }
 
 func (c *Community) Application(application ApplicationID, requester MemberIdentifier) (ApplicationEntity, error) {
-	return c.communityService.Application(application, requester)
+	fetchedApplication, err := c.communityService.Application(application, requester)
+	return fetchedApplication, c.auditService.recordDenied(AuditActionApplicationsRead, requester, &application, err)
 }
 
 func (c *Community) GetLastApplication(member MemberIdentifier, requester MemberIdentifier) (ApplicationEntity, error) {
-	return c.communityService.GetLastApplication(member, requester)
+	application, err := c.communityService.GetLastApplication(member, requester)
+	return application, c.auditService.recordDenied(AuditActionApplicationsRead, requester, &member, err)
+}
+
+func (c *Community) ApplicationHistory(member MemberIdentifier, requester MemberIdentifier) ([]ApplicationHistoryEntry, error) {
+	history, err := c.communityService.ApplicationHistory(member, requester)
+	return history, c.auditService.recordDenied(AuditActionApplicationsRead, requester, &member, err)
+}
+
+func (c *Community) AuditLog(query AuditLogQuery, requester MemberIdentifier) ([]AuditEntryEntity, error) {
+	entries, err := c.auditService.AuditLog(query, requester)
+	return entries, c.auditService.recordDenied(AuditActionAuditLogRead, requester, nil, err)
+}
+
+func (c *Community) VerifyAuditLog(requester MemberIdentifier) error {
+	err := c.auditService.VerifyAuditLog(requester)
+	return c.auditService.recordDenied(AuditActionAuditLogRead, requester, nil, err)
+}
+
+func (c *Community) Stats(query StatsQuery, requester MemberIdentifier) (CommunityStats, error) {
+	stats, err := c.statsService.Stats(query, requester)
+	return stats, c.auditService.recordDenied(AuditActionStatsRead, requester, nil, err)
 }
 
 func (c *Community) GetMemberByAccessToken(accessToken string) (MemberEntity, error) {
@@ -99,48 +684,321 @@ func (c *Community) GetApplication(id ApplicationID) (ApplicationEntity, error)
 	return c.communityService.GetApplicationByID(id)
 }
 
-func (c Community) Promote(emailAddress vo.EmailAddress) error {
-	return c.communityService.Promote(emailAddress)
+func (c *Community) Members(query MembersQuery, requester MemberIdentifier) ([]MemberEntity, error) {
+	members, err := c.memberService.Members(query, requester)
+	return members, c.auditService.recordDenied(AuditActionMembersRead, requester, nil, err)
+}
+
+func (c *Community) Directory(query MembersQuery, requester MemberIdentifier) ([]DirectoryEntry, error) {
+	return c.memberService.Directory(query, requester)
+}
+
+func (c *Community) GetMemberByEmailAddress(emailAddress vo.EmailAddress) (MemberEntity, error) {
+	return c.memberService.GetMemberByEmailAddress(emailAddress)
+}
+
+func (c *Community) GetMemberByUsername(username vo.Username) (MemberEntity, error) {
+	return c.memberService.GetMemberByUsername(username)
+}
+
+func (c *Community) Promote(emailAddress vo.EmailAddress, requester MemberIdentifier) error {
+	err := c.communityService.Promote(emailAddress, requester)
+	return c.auditService.record(AuditActionRoleGranted, &requester, c.auditService.memberByEmailAddress(emailAddress), string(RoleAdmin), err)
+}
+
+func (c *Community) BootstrapOwner(emailAddress vo.EmailAddress) error {
+	err := c.communityService.BootstrapOwner(emailAddress)
+	return c.auditService.record(AuditActionRoleGranted, nil, c.auditService.memberByEmailAddress(emailAddress), string(RoleOwner), err)
+}
+
+func (c *Community) Demote(emailAddress vo.EmailAddress, requester MemberIdentifier) error {
+	target := c.auditService.memberByEmailAddress(emailAddress)
+	err := c.communityService.Demote(emailAddress, requester)
+	return c.auditService.record(AuditActionRoleRevoked, &requester, target, string(RoleAdmin), err)
+}
+
+func (c *Community) GrantRole(member MemberIdentifier, role Role, requester MemberIdentifier) error {
+	err := c.communityService.GrantRole(member, role, requester)
+	return c.auditService.record(AuditActionRoleGranted, &requester, &member, string(role), err)
+}
+
+func (c *Community) RevokeRole(member MemberIdentifier, role Role, requester MemberIdentifier) error {
+	err := c.communityService.RevokeRole(member, role, requester)
+	return c.auditService.record(AuditActionRoleRevoked, &requester, &member, string(role), err)
+}
+
+func (c *Community) RoleChanges(member MemberIdentifier, requester MemberIdentifier) ([]RoleChangeEntity, error) {
+	changes, err := c.communityService.RoleChanges(member, requester)
+	return changes, c.auditService.recordDenied(AuditActionMembersRead, requester, &member, err)
+}
+
+func (c *Community) RevokeAccessToken(member MemberIdentifier, requester MemberIdentifier) error {
+	err := c.memberService.RevokeAccessToken(member, requester)
+	return c.auditService.record(AuditActionAccessTokenRevoked, &requester, &member, "", err)
+}
+
+func (c *Community) ResendConfirmationCode(emailAddress vo.EmailAddress, requester MemberIdentifier) error {
+	err := c.memberService.ResendConfirmationCode(emailAddress, requester)
+	return c.auditService.record(AuditActionConfirmationCodeResent, &requester, c.auditService.memberByEmailAddress(emailAddress), "", err)
+}
+
+func (c *Community) InvalidateConfirmationCodes(emailAddress vo.EmailAddress, requester MemberIdentifier) error {
+	err := c.memberService.InvalidateConfirmationCodes(emailAddress, requester)
+	return c.auditService.record(AuditActionConfirmationCodesInvalidated, &requester, c.auditService.memberByEmailAddress(emailAddress), "", err)
 }
 
 func (c *Community) OnApplicationApproved(cb func(member MemberEntity)) {
 	c.communityService.OnApplicationApproved(cb)
 }
 
+func (c *Community) OnVerificationRevoked(cb func(member MemberEntity)) {
+	c.communityService.OnVerificationRevoked(cb)
+}
+
+func (c *Community) OnAuditFailed(cb func(action AuditAction, err error)) {
+	c.auditService.OnAuditFailed(cb)
+}
+
+func (c *Community) OnAutoAssignFailed(cb func(application ApplicationEntity, err error)) {
+	c.assignmentService.OnAutoAssignFailed(cb)
+}
+
 func (c *Community) OnLogin(cb func(member MemberEntity)) {
 	c.memberService.OnLogin(cb)
 }
 
+func (c *Community) OnRoleChanged(cb func(change RoleChangeEntity)) {
+	c.communityService.OnRoleChanged(cb)
+}
+
+func (c *Community) OnProfileChanged(cb func(member MemberEntity)) {
+	c.memberService.OnProfileChanged(cb)
+}
+
+func (c *Community) OnMemberDeleted(cb func(member MemberEntity)) {
+	c.erasureService.OnMemberDeleted(cb)
+}
+
+func (c *Community) OnMemberErased(cb func(member MemberEntity)) {
+	c.erasureService.OnMemberErased(cb)
+}
+
+func (c *Community) OnSignUp(cb func(member MemberEntity)) {
+	c.memberService.OnSignUp(cb)
+}
+
+func (c *Community) OnApplicationSubmitted(cb func(application ApplicationEntity)) {
+	c.communityService.OnApplicationSubmitted(cb)
+}
+
+func (c *Community) OnApplicationRejected(cb func(application ApplicationEntity)) {
+	c.communityService.OnApplicationRejected(cb)
+}
+
+func (c *Community) OnApplicationExpired(cb func(application ApplicationEntity)) {
+	c.communityService.OnApplicationExpired(cb)
+}
+
 type Dependencies struct {
-	MemberRepository                MemberRepository
-	ApplicationRepository           ApplicationRepository
-	ConfirmationCodeRepository      ConfirmationCodeRepository
-	Transport                       Transport
-	MemberAccessPublicKeyRepository MemberAccessPublicKeyRepository
-	AccessTokenSigningKey           vo.AccessTokenSigningKey
-	AccessTokenRepository           AccessTokenRepository
+	MemberRepository                 MemberRepository
+	ApplicationRepository            ApplicationRepository
+	ConfirmationCodeRepository       ConfirmationCodeRepository
+	Transport                        Transport
+	MemberAccessPublicKeyRepository  MemberAccessPublicKeyRepository
+	AccessTokenSigningKey            vo.AccessTokenSigningKey
+	AccessTokenRepository            AccessTokenRepository
+	RoleChangeRepository             RoleChangeRepository
+	UsernameChangeRepository         UsernameChangeRepository
+	UsernamePolicy                   UsernamePolicy
+	MemberDeletionRepository         MemberDeletionRepository
+	DeletionPolicy                   DeletionPolicy
+	LoginRepository                  LoginRepository
+	SanctionRepository               SanctionRepository
+	InvitationRepository             InvitationRepository
+	InvitationPolicy                 InvitationPolicy
+	VouchRepository                  VouchRepository
+	VouchingPolicy                   VouchingPolicy
+	ReviewVoteRepository             ReviewVoteRepository
+	ReviewPolicy                     ReviewPolicy
+	ApplicationCommentRepository     ApplicationCommentRepository
+	ApplicationFormRepository        ApplicationFormRepository
+	ApplicationRevisionRepository    ApplicationRevisionRepository
+	ReapplicationPolicy              ReapplicationPolicy
+	AppealRepository                 AppealRepository
+	VerificationRevocationRepository VerificationRevocationRepository
+	ApplicationTransitionRepository  ApplicationTransitionRepository
+	AuditLog                         AuditLogRepository
+	AssignmentPolicy                 AssignmentPolicy
+	AssignmentCursorRepository       AssignmentCursorRepository
+	SLAPolicy                        SLAPolicy
 }
 
+// NewCommunity returns an error if one of the required repositories or the transport is missing.
+// The assignment cursor repository is only required if applications are assigned by round robin.
 func NewCommunity(dependencies Dependencies) (*Community, error) {
 
 	if reflect.DeepEqual(dependencies.AccessTokenSigningKey, vo.AccessTokenSigningKey{}) {
 		return nil, errors.New("invalid access token signing key")
 	}
 
+	required := []struct {
+		name       string
+		dependency interface{}
+	}{
+		{"member repository", dependencies.MemberRepository},
+		{"application repository", dependencies.ApplicationRepository},
+		{"confirmation code repository", dependencies.ConfirmationCodeRepository},
+		{"transport", dependencies.Transport},
+		{"member access public key repository", dependencies.MemberAccessPublicKeyRepository},
+		{"access token repository", dependencies.AccessTokenRepository},
+		{"role change repository", dependencies.RoleChangeRepository},
+		{"username change repository", dependencies.UsernameChangeRepository},
+		{"member deletion repository", dependencies.MemberDeletionRepository},
+		{"login repository", dependencies.LoginRepository},
+		{"sanction repository", dependencies.SanctionRepository},
+		{"invitation repository", dependencies.InvitationRepository},
+		{"vouch repository", dependencies.VouchRepository},
+		{"review vote repository", dependencies.ReviewVoteRepository},
+		{"application comment repository", dependencies.ApplicationCommentRepository},
+		{"application form repository", dependencies.ApplicationFormRepository},
+		{"application revision repository", dependencies.ApplicationRevisionRepository},
+		{"appeal repository", dependencies.AppealRepository},
+		{"verification revocation repository", dependencies.VerificationRevocationRepository},
+		{"application transition repository", dependencies.ApplicationTransitionRepository},
+		{"audit log", dependencies.AuditLog},
+	}
+
+	for _, r := range required {
+		if r.dependency == nil {
+			return nil, fmt.Errorf("missing %s", r.name)
+		}
+	}
+
+	if dependencies.AssignmentPolicy.AutoAssign == AssignmentStrategyRoundRobin && dependencies.AssignmentCursorRepository == nil {
+		return nil, errors.New("missing assignment cursor repository")
+	}
+
+	memberService := &memberService{
+		memberRepository:                dependencies.MemberRepository,
+		confirmationCodeRepository:      dependencies.ConfirmationCodeRepository,
+		transport:                       dependencies.Transport,
+		memberAccessPublicKeyRepository: dependencies.MemberAccessPublicKeyRepository,
+		usernameChangeRepository:        dependencies.UsernameChangeRepository,
+		loginRepository:                 dependencies.LoginRepository,
+		sanctionRepository:              dependencies.SanctionRepository,
+		usernamePolicy:                  dependencies.UsernamePolicy,
+		invitationPolicy:                dependencies.InvitationPolicy,
+		accessTokenService: &accessTokenService{
+			signingKey:            dependencies.AccessTokenSigningKey,
+			accessTokenRepository: dependencies.AccessTokenRepository,
+		},
+	}
+
+	assignmentService := &assignmentService{
+		memberRepository:           dependencies.MemberRepository,
+		applicationRepository:      dependencies.ApplicationRepository,
+		assignmentCursorRepository: dependencies.AssignmentCursorRepository,
+		assignmentPolicy:           dependencies.AssignmentPolicy,
+	}
+
+	communityService := &communityService{
+		memberRepository:                 dependencies.MemberRepository,
+		applicationRepository:            dependencies.ApplicationRepository,
+		roleChangeRepository:             dependencies.RoleChangeRepository,
+		reviewVoteRepository:             dependencies.ReviewVoteRepository,
+		reviewPolicy:                     dependencies.ReviewPolicy,
+		applicationFormRepository:        dependencies.ApplicationFormRepository,
+		applicationRevisionRepository:    dependencies.ApplicationRevisionRepository,
+		reapplicationPolicy:              dependencies.ReapplicationPolicy,
+		appealRepository:                 dependencies.AppealRepository,
+		verificationRevocationRepository: dependencies.VerificationRevocationRepository,
+		applicationTransitionRepository:  dependencies.ApplicationTransitionRepository,
+		assignmentService:                assignmentService,
+	}
+
+	erasureService := &erasureService{
+		memberRepository:         dependencies.MemberRepository,
+		applicationRepository:    dependencies.ApplicationRepository,
+		usernameChangeRepository: dependencies.UsernameChangeRepository,
+		memberDeletionRepository: dependencies.MemberDeletionRepository,
+		commentRepository:        dependencies.ApplicationCommentRepository,
+		revisionRepository:       dependencies.ApplicationRevisionRepository,
+		transitionRepository:     dependencies.ApplicationTransitionRepository,
+		appealRepository:         dependencies.AppealRepository,
+		sanctionRepository:       dependencies.SanctionRepository,
+		invitationRepository:     dependencies.InvitationRepository,
+		memberService:            memberService,
+		deletionPolicy:           dependencies.DeletionPolicy,
+	}
+
 	return &Community{
-		communityService: &communityService{
+		communityService: communityService,
+		memberService:    memberService,
+		erasureService:   erasureService,
+		exportService: &exportService{
+			memberRepository:           dependencies.MemberRepository,
+			applicationRepository:      dependencies.ApplicationRepository,
+			loginRepository:            dependencies.LoginRepository,
+			confirmationCodeRepository: dependencies.ConfirmationCodeRepository,
+			usernameChangeRepository:   dependencies.UsernameChangeRepository,
+			commentRepository:          dependencies.ApplicationCommentRepository,
+			revisionRepository:         dependencies.ApplicationRevisionRepository,
+			appealRepository:           dependencies.AppealRepository,
+		},
+		moderationService: &moderationService{
+			memberRepository:   dependencies.MemberRepository,
+			sanctionRepository: dependencies.SanctionRepository,
+			memberService:      memberService,
+		},
+		invitationService: &invitationService{
+			memberRepository:     dependencies.MemberRepository,
+			invitationRepository: dependencies.InvitationRepository,
+			memberService:        memberService,
+			communityService:     communityService,
+			invitationPolicy:     dependencies.InvitationPolicy,
+		},
+		vouchService: &vouchService{
+			memberRepository:      dependencies.MemberRepository,
+			applicationRepository: dependencies.ApplicationRepository,
+			vouchRepository:       dependencies.VouchRepository,
+			communityService:      communityService,
+			vouchingPolicy:        dependencies.VouchingPolicy,
+		},
+		commentService: &commentService{
+			memberRepository:             dependencies.MemberRepository,
+			applicationRepository:        dependencies.ApplicationRepository,
+			applicationCommentRepository: dependencies.ApplicationCommentRepository,
+			transitionRepository:         dependencies.ApplicationTransitionRepository,
+			transport:                    dependencies.Transport,
+		},
+		formService: &formService{
+			memberRepository:          dependencies.MemberRepository,
+			applicationFormRepository: dependencies.ApplicationFormRepository,
+		},
+		appealService: &appealService{
+			memberRepository:      dependencies.MemberRepository,
+			applicationRepository: dependencies.ApplicationRepository,
+			appealRepository:      dependencies.AppealRepository,
+			communityService:      communityService,
+		},
+		assignmentService: assignmentService,
+		maintenanceService: &maintenanceService{
+			memberRepository:      dependencies.MemberRepository,
+			applicationRepository: dependencies.ApplicationRepository,
+			transport:             dependencies.Transport,
+			slaPolicy:             dependencies.SLAPolicy,
+			communityService:      communityService,
+			assignmentService:     assignmentService,
+			erasureService:        erasureService,
+		},
+		statsService: &statsService{
 			memberRepository:      dependencies.MemberRepository,
 			applicationRepository: dependencies.ApplicationRepository,
+			loginRepository:       dependencies.LoginRepository,
 		},
-		memberService: &memberService{
-			memberRepository:                dependencies.MemberRepository,
-			confirmationCodeRepository:      dependencies.ConfirmationCodeRepository,
-			transport:                       dependencies.Transport,
-			memberAccessPublicKeyRepository: dependencies.MemberAccessPublicKeyRepository,
-			accessTokenService: &accessTokenService{
-				signingKey:            dependencies.AccessTokenSigningKey,
-				accessTokenRepository: dependencies.AccessTokenRepository,
-			},
+		auditService: &auditService{
+			auditLogRepository: dependencies.AuditLog,
+			memberRepository:   dependencies.MemberRepository,
 		},
 	}, nil
 
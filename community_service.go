@@ -5,13 +5,59 @@ import (
 	"fmt"
 	"github.com/satori/go.uuid"
 	vo "github.com/214alphadev/community-bl/value_objects"
+	"sort"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
+// ReapplicationPolicy limits how often rejected members can apply again
+type ReapplicationPolicy struct {
+	// CoolDown is the time a rejected member has to wait before applying again.
+	// Reviewers can override it per rejection.
+	CoolDown time.Duration
+	// MaxAttempts is the amount of rejections after which a member is rejected permanently. Zero means unlimited.
+	MaxAttempts uint
+}
+
+type ApplyForVerificationCoolDownError struct {
+	ReapplyAt int64
+}
+
+func (e ApplyForVerificationCoolDownError) Error() string {
+	return fmt.Sprintf("please retry to apply for verification at: %d", e.ReapplyAt)
+}
+
+var ApplyForVerificationErrorPermanentlyRejected = errors.New("member has been rejected permanently")
+
+// ReviewPolicy configures how reviewers decide applications
+type ReviewPolicy struct {
+	// Quorum is the amount of votes required before an application is decided by majority.
+	// Zero and one let a single reviewer decide. Appeals are always decided by a single reviewer.
+	Quorum uint
+	// VetoRoles are the roles whose reject vote rejects an application regardless of the other votes
+	VetoRoles []Role
+}
+
 type communityService struct {
-	memberRepository      MemberRepository
-	applicationRepository ApplicationRepository
-	onApplicationApproved []func(member MemberEntity)
+	memberRepository                 MemberRepository
+	applicationRepository            ApplicationRepository
+	roleChangeRepository             RoleChangeRepository
+	reviewVoteRepository             ReviewVoteRepository
+	reviewPolicy                     ReviewPolicy
+	applicationFormRepository        ApplicationFormRepository
+	applicationRevisionRepository    ApplicationRevisionRepository
+	reapplicationPolicy              ReapplicationPolicy
+	appealRepository                 AppealRepository
+	verificationRevocationRepository VerificationRevocationRepository
+	applicationTransitionRepository  ApplicationTransitionRepository
+	assignmentService                *assignmentService
+	onApplicationApproved            []func(member MemberEntity)
+	onApplicationSubmitted           []func(application ApplicationEntity)
+	onApplicationRejected            []func(application ApplicationEntity)
+	onRoleChanged                    []func(change RoleChangeEntity)
+	onVerificationRevoked            []func(member MemberEntity)
+	onApplicationExpired             []func(application ApplicationEntity)
 }
 
 func (s communityService) GetLastApplication(memberID MemberIdentifier, requesterID MemberIdentifier) (ApplicationEntity, error) {
@@ -38,11 +84,11 @@ func (s communityService) GetLastApplication(memberID MemberIdentifier, requeste
 		return *application, nil
 	}
 
-	if requester.Admin {
+	if requester.HasPermission(PermissionApplicationsRead) {
 		return *application, nil
 	}
 
-	return ApplicationEntity{}, errors.New("NotAllowedToAccessApplication")
+	return ApplicationEntity{}, AuthorizationErrorNotAllowedToAccessApplication
 
 }
 
@@ -57,8 +103,8 @@ func (s communityService) Application(applicationID ApplicationID, requester Mem
 		return ApplicationEntity{}, errors.New("MemberDoesNotExist")
 	}
 
-	if !member.Admin {
-		return ApplicationEntity{}, errors.New("InsufficientPermissions")
+	if !member.HasPermission(PermissionApplicationsRead) {
+		return ApplicationEntity{}, AuthorizationErrorInsufficientPermissions
 	}
 
 	application, err := s.applicationRepository.FetchByID(applicationID)
@@ -74,204 +120,1120 @@ func (s communityService) Application(applicationID ApplicationID, requester Mem
 
 }
 
-func (s *communityService) Applications(query ApplicationsQuery, requester MemberIdentifier) ([]ApplicationEntity, error) {
+// MaxApplicationsQueryNext is the maximum amount of applications a single query returns
+const MaxApplicationsQueryNext = 100
+
+func (s *communityService) Applications(query ApplicationsQuery, requester MemberIdentifier) (ApplicationsResult, error) {
 
 	member, err := s.memberRepository.FetchByID(requester)
 	if err != nil {
-		return nil, err
+		return ApplicationsResult{}, err
 	}
 
 	if member == nil {
-		return nil, errors.New("MemberDoesNotExist")
+		return ApplicationsResult{}, errors.New("MemberDoesNotExist")
+	}
+
+	if !member.HasPermission(PermissionApplicationsRead) {
+		return ApplicationsResult{}, AuthorizationErrorInsufficientPermissions
+	}
+
+	for _, state := range query.States {
+		if !state.Valid() {
+			return ApplicationsResult{}, fmt.Errorf("application state: '%s' is invalid", state)
+		}
+	}
+
+	if query.Next == 0 || query.Next > MaxApplicationsQueryNext {
+		query.Next = MaxApplicationsQueryNext
+	}
+
+	var after *ApplicationsCursor
+	if query.Cursor != "" {
+		cursor, err := decodeApplicationsCursor(query.Cursor)
+		if err != nil {
+			return ApplicationsResult{}, err
+		}
+		after = &cursor
+	}
+
+	if query.ClaimsActiveAt == nil {
+		now := time.Now()
+		query.ClaimsActiveAt = &now
+	}
+
+	// one more than asked for tells if there are more applications
+	applications, err := s.applicationRepository.FetchByQuery(query, after, query.Next+1)
+	if err != nil {
+		return ApplicationsResult{}, err
+	}
+
+	total, err := s.applicationRepository.CountByQuery(query)
+	if err != nil {
+		return ApplicationsResult{}, err
+	}
+
+	result := ApplicationsResult{
+		Applications: applications,
+		Total:        total,
 	}
 
-	if !member.Admin {
-		return nil, errors.New("InsufficientPermissions")
+	if uint(len(applications)) > query.Next {
+		result.Applications = applications[:query.Next]
+		result.HasMore = true
 	}
 
-	return s.applicationRepository.FetchByQuery(query)
+	if len(result.Applications) > 0 {
+		last := result.Applications[len(result.Applications)-1]
+		result.NextCursor = ApplicationsCursor{CreatedAt: last.CreatedAt, ID: last.ID}.encode()
+	}
+
+	return result, nil
 
 }
 
+// MaxApplicationTextLength is the maximum amount of characters of a free form application
+const MaxApplicationTextLength = 10000
+
+// ApplyForVerification submits a free form application. Once an application form has been published
+// applications have to be submitted with SubmitApplication.
 func (s *communityService) ApplyForVerification(memberID MemberIdentifier, applicationText string) (ApplicationEntity, error) {
 
-	fetchedApplication, err := s.applicationRepository.FetchLast(memberID)
+	form, err := s.applicationFormRepository.FetchActive()
 	if err != nil {
 		return ApplicationEntity{}, err
 	}
 
-	var apply = func() (ApplicationEntity, error) {
+	if form != nil {
+		return ApplicationEntity{}, errors.New("ApplicationFormRequired")
+	}
 
-		application := ApplicationEntity{
-			ID:              uuid.NewV4(),
-			MemberID:        memberID,
-			ApplicationText: applicationText,
-			State:           ApplicationStatePending,
-			CreatedAt:       time.Now(),
-		}
+	if err := validateApplicationText(applicationText); err != nil {
+		return ApplicationEntity{}, err
+	}
 
-		if err := s.applicationRepository.Save(application); err != nil {
-			return ApplicationEntity{}, nil
-		}
+	return s.apply(ApplicationEntity{
+		MemberID:        memberID,
+		ApplicationText: applicationText,
+	})
 
-		return application, nil
+}
+
+func validateApplicationText(applicationText string) error {
 
+	if strings.TrimSpace(applicationText) == "" {
+		return errors.New("application text must not be empty")
 	}
 
-	switch fetchedApplication {
-	case nil:
-		return apply()
-	default:
+	if utf8.RuneCountInString(applicationText) > MaxApplicationTextLength {
+		return fmt.Errorf("application text must not be longer than %d characters", MaxApplicationTextLength)
+	}
+
+	return nil
+
+}
+
+// SubmitApplication submits the answers to the active application form
+func (s *communityService) SubmitApplication(memberID MemberIdentifier, answers []ApplicationAnswer) (ApplicationEntity, error) {
+
+	form, err := s.applicationFormRepository.FetchActive()
+	if err != nil {
+		return ApplicationEntity{}, err
+	}
+
+	if form == nil {
+		return ApplicationEntity{}, errors.New("ApplicationFormDoesNotExist")
+	}
+
+	if err := form.validate(answers); err != nil {
+		return ApplicationEntity{}, err
+	}
+
+	return s.apply(ApplicationEntity{
+		MemberID:    memberID,
+		FormVersion: form.Version,
+		Answers:     answers,
+	})
+
+}
+
+// apply saves the application if the member is allowed to apply
+func (s *communityService) apply(application ApplicationEntity) (ApplicationEntity, error) {
+
+	fetchedApplication, err := s.applicationRepository.FetchLast(application.MemberID)
+	if err != nil {
+		return ApplicationEntity{}, err
+	}
+
+	if fetchedApplication != nil {
 		switch fetchedApplication.State {
-		case ApplicationStatePending:
+		case ApplicationStatePending, ApplicationStateInformationRequested:
 			return ApplicationEntity{}, errors.New("PendingApplication")
 		case ApplicationStateApproved:
-			return ApplicationEntity{}, errors.New("AlreadyVerified")
+			if err := s.canReapplyAfterRevocation(application.MemberID); err != nil {
+				return ApplicationEntity{}, err
+			}
 		case ApplicationStateRejected:
-			return apply()
+			appeal, err := s.appealRepository.FetchByApplication(fetchedApplication.ID)
+			if err != nil {
+				return ApplicationEntity{}, err
+			}
+			if appeal != nil && appeal.State == AppealStatePending {
+				return ApplicationEntity{}, errors.New("PendingAppeal")
+			}
+			if fetchedApplication.PermanentlyRejected {
+				return ApplicationEntity{}, ApplyForVerificationErrorPermanentlyRejected
+			}
+			if fetchedApplication.ReapplyAfter != nil && time.Now().Before(*fetchedApplication.ReapplyAfter) {
+				return ApplicationEntity{}, ApplyForVerificationCoolDownError{
+					ReapplyAt: fetchedApplication.ReapplyAfter.Unix(),
+				}
+			}
+		case ApplicationStateWithdrawn, ApplicationStateExpired:
 		default:
 			return ApplicationEntity{}, fmt.Errorf("application state: '%s' is invalid", fetchedApplication.State)
 		}
 	}
 
+	application.ID = uuid.NewV4()
+	application.CreatedAt = time.Now()
+
+	if err := s.transition(&application, ApplicationStatePending, &application.MemberID, ""); err != nil {
+		return ApplicationEntity{}, err
+	}
+
+	// the assignment works on a copy, so that a failed assignment doesn't leave a claim that hasn't been saved
+	assigned := application
+	if err := s.assignmentService.autoAssign(&assigned); err != nil {
+		s.assignmentService.autoAssignFailed(application, err)
+	} else {
+		application = assigned
+	}
+
+	for _, onSubmitted := range s.onApplicationSubmitted {
+		onSubmitted(application)
+	}
+
+	return application, nil
+
 }
 
-func (s *communityService) ApproveApplication(applicationID ApplicationID, reviewerID MemberIdentifier) error {
+// canReapplyAfterRevocation makes sure a member whose approved application is the last one
+// lost the verification and waited for the cool down of the reapplication policy
+func (s *communityService) canReapplyAfterRevocation(memberID MemberIdentifier) error {
 
-	reviewer, err := s.memberRepository.FetchByID(reviewerID)
+	member, err := s.memberRepository.FetchByID(memberID)
 	if err != nil {
 		return err
 	}
 
-	if reviewer == nil {
-		return fmt.Errorf("member that is supposed to reivew the application doesn't exist (member id: %s)", reviewerID.String())
+	if member == nil {
+		return errors.New("MemberDoesNotExist")
 	}
 
-	if !reviewer.Admin {
-		return errors.New("InsufficientPermissions")
+	if member.Verified {
+		return errors.New("AlreadyVerified")
 	}
 
-	application, err := s.applicationRepository.FetchByID(applicationID)
+	revocation, err := s.verificationRevocationRepository.FetchLastByMember(member.ID)
 	if err != nil {
+		return err
+	}
+
+	if revocation == nil {
 		return nil
 	}
 
-	if application == nil {
-		return errors.New("ApplicationDoesNotExist")
+	reapplyAt := revocation.RevokedAt.Add(s.reapplicationPolicy.CoolDown)
+	if time.Now().Before(reapplyAt) {
+		return ApplyForVerificationCoolDownError{
+			ReapplyAt: reapplyAt.Unix(),
+		}
 	}
 
-	member, err := s.memberRepository.FetchByID(application.MemberID)
+	return nil
+
+}
+
+// RevokeVerification unverifies the member. The approved application stays untouched and the
+// member can apply again once the cool down of the reapplication policy passed.
+func (s *communityService) RevokeVerification(memberID MemberIdentifier, reason string, reviewerID MemberIdentifier) (VerificationRevocationEntity, error) {
+
+	if strings.TrimSpace(reason) == "" {
+		return VerificationRevocationEntity{}, errors.New("a reason is required")
+	}
+
+	reviewer, err := s.memberRepository.FetchByID(reviewerID)
 	if err != nil {
-		return err
+		return VerificationRevocationEntity{}, err
 	}
 
-	if application.State != ApplicationStatePending {
-		return errors.New("AlreadyReviewed")
+	if reviewer == nil {
+		return VerificationRevocationEntity{}, errors.New("ReviewerDoesNotExist")
 	}
 
-	application.ApprovedBy = &reviewer.ID
-	now := time.Now()
-	application.ApprovedAt = &now
-	application.State = ApplicationStateApproved
+	if !reviewer.HasPermission(PermissionApplicationsReview) {
+		return VerificationRevocationEntity{}, AuthorizationErrorInsufficientPermissions
+	}
 
-	if err := s.applicationRepository.Save(*application); err != nil {
-		return err
+	member, err := s.memberRepository.FetchByID(memberID)
+	if err != nil {
+		return VerificationRevocationEntity{}, err
 	}
 
-	member.Verified = true
+	if member == nil {
+		return VerificationRevocationEntity{}, errors.New("MemberDoesNotExist")
+	}
+
+	// like moderation, reviewers can't act on themselves or members that out rank them
+	if member.ID == reviewer.ID || member.rank() >= reviewer.rank() {
+		return VerificationRevocationEntity{}, AuthorizationErrorInsufficientPermissions
+	}
+
+	if !member.Verified {
+		return VerificationRevocationEntity{}, errors.New("MemberNotVerified")
+	}
+
+	revocation := VerificationRevocationEntity{
+		ID:        uuid.NewV4(),
+		MemberID:  member.ID,
+		Reason:    reason,
+		RevokedBy: reviewer.ID,
+		RevokedAt: time.Now(),
+	}
+
+	if err := s.verificationRevocationRepository.Save(revocation); err != nil {
+		return VerificationRevocationEntity{}, err
+	}
+
+	member.Verified = false
 
 	if err := s.memberRepository.Save(*member); err != nil {
-		return err
+		return VerificationRevocationEntity{}, err
 	}
 
-	for _, onApproved := range s.onApplicationApproved {
-		onApproved(*member)
+	for _, onRevoked := range s.onVerificationRevoked {
+		onRevoked(*member)
 	}
 
-	return nil
+	return revocation, nil
 
 }
 
-func (s *communityService) RejectApplication(applicationID ApplicationID, reason string, reviewerID MemberIdentifier) error {
+func (s *communityService) VerificationRevocations(memberID MemberIdentifier, requesterID MemberIdentifier) ([]VerificationRevocationEntity, error) {
 
-	reviewer, err := s.memberRepository.FetchByID(reviewerID)
+	requester, err := s.memberRepository.FetchByID(requesterID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if reviewer == nil {
-		return errors.New("ReviewerDoesNotExist")
+	if requester == nil {
+		return nil, errors.New("RequesterNotFound")
 	}
 
-	if !reviewer.Admin {
-		return errors.New("InsufficientPermissions")
+	if requester.ID != memberID && !requester.HasPermission(PermissionApplicationsRead) {
+		return nil, AuthorizationErrorInsufficientPermissions
 	}
 
-	application, err := s.applicationRepository.FetchByID(applicationID)
+	return s.verificationRevocationRepository.FetchByMember(memberID)
+
+}
+
+// EditApplication replaces the content of an open application. Free form applications are edited with
+// the application text, form applications with answers to the form version they have been submitted with.
+// The previous content is kept as revision.
+func (s *communityService) EditApplication(applicationID ApplicationID, applicationText string, answers []ApplicationAnswer, memberID MemberIdentifier) (ApplicationEntity, error) {
+
+	application, err := s.ownApplication(applicationID, memberID)
+	if err != nil {
+		return ApplicationEntity{}, err
+	}
+
+	switch application.FormVersion {
+	case 0:
+		if len(answers) > 0 {
+			return ApplicationEntity{}, errors.New("free form applications can't be edited with answers")
+		}
+		if err := validateApplicationText(applicationText); err != nil {
+			return ApplicationEntity{}, err
+		}
+	default:
+		if applicationText != "" {
+			return ApplicationEntity{}, errors.New("form applications can't be edited with an application text")
+		}
+		form, err := s.applicationFormRepository.FetchByVersion(application.FormVersion)
+		if err != nil {
+			return ApplicationEntity{}, err
+		}
+		if form == nil {
+			return ApplicationEntity{}, fmt.Errorf("couldn't find application form version %d", application.FormVersion)
+		}
+		if err := form.validate(answers); err != nil {
+			return ApplicationEntity{}, err
+		}
+	}
+
+	revision := ApplicationRevisionEntity{
+		ID:              uuid.NewV4(),
+		ApplicationID:   application.ID,
+		ApplicationText: application.ApplicationText,
+		FormVersion:     application.FormVersion,
+		Answers:         application.Answers,
+		RevisedAt:       time.Now(),
+	}
+
+	if err := s.applicationRevisionRepository.Save(revision); err != nil {
+		return ApplicationEntity{}, err
+	}
+
+	application.ApplicationText = applicationText
+	application.Answers = answers
+
+	if err := s.applicationRepository.Save(*application); err != nil {
+		return ApplicationEntity{}, err
+	}
+
+	return *application, nil
+
+}
+
+// WithdrawApplication withdraws an open application. The member can apply again afterwards.
+func (s *communityService) WithdrawApplication(applicationID ApplicationID, memberID MemberIdentifier) error {
+
+	application, err := s.ownApplication(applicationID, memberID)
 	if err != nil {
 		return err
 	}
 
+	now := time.Now()
+	application.WithdrawnAt = &now
+
+	return s.transition(application, ApplicationStateWithdrawn, &memberID, "")
+
+}
+
+// ownApplication fetches an open application of the member
+func (s *communityService) ownApplication(applicationID ApplicationID, memberID MemberIdentifier) (*ApplicationEntity, error) {
+
+	application, err := s.applicationRepository.FetchByID(applicationID)
+	if err != nil {
+		return nil, err
+	}
+
 	if application == nil {
-		return errors.New("ApplicationDoesNotExist")
+		return nil, errors.New("ApplicationDoesNotExist")
 	}
 
-	if application.State != ApplicationStatePending {
-		return errors.New("ApplicationReviewed")
+	if application.MemberID != memberID {
+		return nil, AuthorizationErrorNotAllowedToAccessApplication
 	}
 
-	application.RejectionReason = reason
-	now := time.Now()
-	application.RejectedAt = &now
-	application.State = ApplicationStateRejected
-	application.RejectedBy = &reviewer.ID
+	if !application.State.open() {
+		return nil, errors.New("ApplicationReviewed")
+	}
 
-	return s.applicationRepository.Save(*application)
+	return application, nil
 
 }
 
-func (s communityService) Promote(emailAddress vo.EmailAddress) error {
+// ApplicationRevisions returns the previous contents of the application
+func (s *communityService) ApplicationRevisions(applicationID ApplicationID, requesterID MemberIdentifier) ([]ApplicationRevisionEntity, error) {
 
-	member, err := s.memberRepository.FetchByEmailAddress(emailAddress)
+	requester, err := s.memberRepository.FetchByID(requesterID)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if member == nil {
-		return errors.New("couldn't find member by email address")
+
+	if requester == nil {
+		return nil, errors.New("RequesterNotFound")
 	}
 
-	alreadyVerified := member.Verified
+	application, err := s.applicationRepository.FetchByID(applicationID)
+	if err != nil {
+		return nil, err
+	}
 
-	member.Verified = true
-	member.Admin = true
+	if application == nil {
+		return nil, errors.New("ApplicationDoesNotExist")
+	}
 
-	if err := s.memberRepository.Save(*member); err != nil {
-		return err
+	if application.MemberID != requester.ID && !requester.HasPermission(PermissionApplicationsRead) {
+		return nil, AuthorizationErrorNotAllowedToAccessApplication
 	}
 
-	if !alreadyVerified {
-		for _, onApproved := range s.onApplicationApproved {
-			onApproved(*member)
+	return s.applicationRevisionRepository.FetchByApplication(application.ID)
+
+}
+
+// ApplicationHistoryEntry is an application of the member together with its state changes
+type ApplicationHistoryEntry struct {
+	Application ApplicationEntity
+	Transitions []ApplicationTransitionEntity
+}
+
+// ApplicationHistory returns all applications of the member, oldest first, together with their transition log
+func (s *communityService) ApplicationHistory(memberID MemberIdentifier, requesterID MemberIdentifier) ([]ApplicationHistoryEntry, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if requester == nil {
+		return nil, errors.New("RequesterNotFound")
+	}
+
+	if requester.ID != memberID && !requester.HasPermission(PermissionApplicationsRead) {
+		return nil, AuthorizationErrorNotAllowedToAccessApplication
+	}
+
+	applications, err := s.applicationRepository.FetchByMember(memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(applications, func(i, j int) bool {
+		return applications[i].CreatedAt.Before(applications[j].CreatedAt)
+	})
+
+	history := []ApplicationHistoryEntry{}
+	for _, application := range applications {
+
+		transitions, err := s.applicationTransitionRepository.FetchByApplication(application.ID)
+		if err != nil {
+			return nil, err
 		}
+
+		history = append(history, ApplicationHistoryEntry{
+			Application: application,
+			Transitions: transitions,
+		})
+
 	}
 
-	return nil
+	return history, nil
 
 }
 
-func (s *communityService) GetApplicationByID(id ApplicationID) (ApplicationEntity, error) {
+// transition moves the application into the given state, saves it and appends the change to the transition log
+func (s *communityService) transition(application *ApplicationEntity, to ApplicationState, actor *MemberIdentifier, reason string) error {
+	return transitionApplication(s.applicationRepository, s.applicationTransitionRepository, application, to, actor, reason)
+}
 
-	application, err := s.applicationRepository.FetchByID(id)
+func transitionApplication(applicationRepository ApplicationRepository, transitionRepository ApplicationTransitionRepository, application *ApplicationEntity, to ApplicationState, actor *MemberIdentifier, reason string) error {
 
-	switch err {
-	case nil:
-		return *application, nil
-	default:
-		return ApplicationEntity{}, err
+	from := application.State
+	now := time.Now()
+	application.State = to
+	application.TransitionedAt = now
+
+	if err := applicationRepository.Save(*application); err != nil {
+		return err
 	}
 
+	return transitionRepository.Save(ApplicationTransitionEntity{
+		ID:             uuid.NewV4(),
+		ApplicationID:  application.ID,
+		From:           from,
+		To:             to,
+		Actor:          actor,
+		Reason:         reason,
+		TransitionedAt: now,
+	})
+
 }
 
-func (s *communityService) OnApplicationApproved(cb func(member MemberEntity)) {
-	s.onApplicationApproved = append(s.onApplicationApproved, cb)
+// ApproveApplication casts an approve vote. With the default review policy the vote decides the application.
+// It returns the state of the application after the vote, which stays open until the votes decide it.
+func (s *communityService) ApproveApplication(applicationID ApplicationID, reviewerID MemberIdentifier) (ApplicationState, error) {
+	_, state, err := s.CastVote(applicationID, ReviewDecisionApprove, "", reviewerID)
+	return state, err
+}
+
+// RejectApplication casts a reject vote with the reason as comment. With the default review policy the vote decides the application.
+func (s *communityService) RejectApplication(applicationID ApplicationID, reason string, reviewerID MemberIdentifier) (ApplicationState, error) {
+	_, state, err := s.CastVote(applicationID, ReviewDecisionReject, reason, reviewerID)
+	return state, err
+}
+
+// RejectApplicationWithCoolDown casts a reject vote that overrides the cool down of the reapplication policy
+func (s *communityService) RejectApplicationWithCoolDown(applicationID ApplicationID, reason string, coolDown time.Duration, reviewerID MemberIdentifier) (ApplicationState, error) {
+
+	if coolDown < 0 {
+		return "", errors.New("cool down must not be negative")
+	}
+
+	_, state, err := s.castVote(applicationID, ReviewDecisionReject, reason, &coolDown, reviewerID)
+	return state, err
+
+}
+
+// CastVote records the vote of the reviewer and resolves the application if the votes decide it.
+// Reviewers can change their vote as long as the application is open.
+// The returned state is the state of the application after the vote.
+func (s *communityService) CastVote(applicationID ApplicationID, decision ReviewDecision, comment string, reviewerID MemberIdentifier) (ReviewVoteEntity, ApplicationState, error) {
+	return s.castVote(applicationID, decision, comment, nil, reviewerID)
+}
+
+func (s *communityService) castVote(applicationID ApplicationID, decision ReviewDecision, comment string, reapplyCoolDown *time.Duration, reviewerID MemberIdentifier) (ReviewVoteEntity, ApplicationState, error) {
+
+	if !decision.Valid() {
+		return ReviewVoteEntity{}, "", fmt.Errorf("review decision: '%s' is invalid", decision)
+	}
+
+	reviewer, err := s.memberRepository.FetchByID(reviewerID)
+	if err != nil {
+		return ReviewVoteEntity{}, "", err
+	}
+
+	if reviewer == nil {
+		return ReviewVoteEntity{}, "", errors.New("ReviewerDoesNotExist")
+	}
+
+	if !reviewer.HasPermission(PermissionApplicationsReview) {
+		return ReviewVoteEntity{}, "", AuthorizationErrorInsufficientPermissions
+	}
+
+	application, err := s.applicationRepository.FetchByID(applicationID)
+	if err != nil {
+		return ReviewVoteEntity{}, "", err
+	}
+
+	if application == nil {
+		return ReviewVoteEntity{}, "", errors.New("ApplicationDoesNotExist")
+	}
+
+	// reviewers can't vote on their own application
+	if application.MemberID == reviewer.ID {
+		return ReviewVoteEntity{}, "", AuthorizationErrorInsufficientPermissions
+	}
+
+	if !application.State.open() {
+		return ReviewVoteEntity{}, "", errors.New("ApplicationReviewed")
+	}
+
+	votes, err := s.reviewVoteRepository.FetchByApplication(application.ID)
+	if err != nil {
+		return ReviewVoteEntity{}, "", err
+	}
+
+	vote := ReviewVoteEntity{
+		ID:              uuid.NewV4(),
+		ApplicationID:   application.ID,
+		ReviewerID:      reviewer.ID,
+		Decision:        decision,
+		Comment:         comment,
+		ReapplyCoolDown: reapplyCoolDown,
+		CastAt:          time.Now(),
+	}
+
+	others := []ReviewVoteEntity{}
+	for _, v := range votes {
+		if v.ReviewerID == reviewer.ID {
+			vote.ID = v.ID
+			continue
+		}
+		others = append(others, v)
+	}
+
+	if err := s.reviewVoteRepository.Save(vote); err != nil {
+		return ReviewVoteEntity{}, "", err
+	}
+
+	if err := s.resolve(application, append(others, vote), vote, *reviewer); err != nil {
+		return ReviewVoteEntity{}, "", err
+	}
+
+	return vote, application.State, nil
+
+}
+
+// resolve approves or rejects the application if the votes decide it.
+// A reject vote of a reviewer with a veto role rejects the application right away.
+// Otherwise the application is decided by majority once the quorum is reached. Ties keep the application pending.
+func (s *communityService) resolve(application *ApplicationEntity, votes []ReviewVoteEntity, vote ReviewVoteEntity, reviewer MemberEntity) error {
+
+	if vote.Decision == ReviewDecisionReject {
+		for _, role := range s.reviewPolicy.VetoRoles {
+			if reviewer.HasRole(role) {
+				return s.reject(application, vote.Comment, []MemberIdentifier{reviewer.ID}, vote.ReapplyCoolDown, reviewer.ID)
+			}
+		}
+	}
+
+	quorum := s.reviewPolicy.Quorum
+	if quorum == 0 {
+		quorum = 1
+	}
+
+	if uint(len(votes)) < quorum {
+		return nil
+	}
+
+	approvedBy := []MemberIdentifier{}
+	rejectedBy := []MemberIdentifier{}
+	reasons := []string{}
+	// the longest cool down any rejecting reviewer asked for
+	var reapplyCoolDown *time.Duration
+
+	for _, v := range votes {
+		switch v.Decision {
+		case ReviewDecisionApprove:
+			approvedBy = append(approvedBy, v.ReviewerID)
+		case ReviewDecisionReject:
+			rejectedBy = append(rejectedBy, v.ReviewerID)
+			if v.Comment != "" {
+				reasons = append(reasons, v.Comment)
+			}
+			if v.ReapplyCoolDown != nil && (reapplyCoolDown == nil || *v.ReapplyCoolDown > *reapplyCoolDown) {
+				reapplyCoolDown = v.ReapplyCoolDown
+			}
+		}
+	}
+
+	switch {
+	case len(approvedBy) > len(rejectedBy):
+		return s.approve(application, approvedBy, reviewer.ID, vote.Comment)
+	case len(rejectedBy) > len(approvedBy):
+		return s.reject(application, strings.Join(reasons, "\n"), rejectedBy, reapplyCoolDown, reviewer.ID)
+	default:
+		return nil
+	}
+
+}
+
+// approve approves the application and verifies the member. approvedBy is empty
+// if the application has been approved by the vouches of other members.
+// The actor is the member whose action approved the application.
+func (s *communityService) approve(application *ApplicationEntity, approvedBy []MemberIdentifier, actor MemberIdentifier, reason string) error {
+
+	verified, err := s.verify(application, approvedBy, actor, reason)
+	if err != nil {
+		return err
+	}
+
+	s.approved(verified)
+
+	return nil
+
+}
+
+// verify does the writes of approve without reporting the approval, so that callers
+// can finish their own writes first. It returns the member if it hasn't been verified before.
+func (s *communityService) verify(application *ApplicationEntity, approvedBy []MemberIdentifier, actor MemberIdentifier, reason string) (*MemberEntity, error) {
+
+	member, err := s.memberRepository.FetchByID(application.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	if member == nil {
+		return nil, errors.New("MemberDoesNotExist")
+	}
+
+	application.ApprovedBy = approvedBy
+	now := time.Now()
+	application.ApprovedAt = &now
+
+	if err := s.transition(application, ApplicationStateApproved, &actor, reason); err != nil {
+		return nil, err
+	}
+
+	if member.Verified {
+		return nil, nil
+	}
+
+	member.Verified = true
+
+	if err := s.memberRepository.Save(*member); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+
+}
+
+// approved reports the verification of the member, if verify verified it
+func (s *communityService) approved(member *MemberEntity) {
+
+	if member == nil {
+		return
+	}
+
+	for _, onApproved := range s.onApplicationApproved {
+		onApproved(*member)
+	}
+
+}
+
+// expire moves the open application into the terminal Expired state. The member can apply again right away.
+func (s *communityService) expire(application *ApplicationEntity, reason string, now time.Time) error {
+
+	application.ExpiredAt = &now
+
+	if err := s.transition(application, ApplicationStateExpired, nil, reason); err != nil {
+		return err
+	}
+
+	for _, onExpired := range s.onApplicationExpired {
+		onExpired(*application)
+	}
+
+	return nil
+
+}
+
+// reject rejects the application. The member can reapply after the cool down of the reapplication policy
+// or the given cool down. Once the member used up all attempts the rejection is permanent.
+func (s *communityService) reject(application *ApplicationEntity, reason string, rejectedBy []MemberIdentifier, reapplyCoolDown *time.Duration, actor MemberIdentifier) error {
+
+	application.RejectionReason = reason
+	now := time.Now()
+	application.RejectedAt = &now
+	application.RejectedBy = rejectedBy
+
+	coolDown := s.reapplicationPolicy.CoolDown
+	if reapplyCoolDown != nil {
+		coolDown = *reapplyCoolDown
+	}
+
+	if coolDown > 0 {
+		reapplyAfter := now.Add(coolDown)
+		application.ReapplyAfter = &reapplyAfter
+	}
+
+	if s.reapplicationPolicy.MaxAttempts > 0 {
+
+		applications, err := s.applicationRepository.FetchByMember(application.MemberID)
+		if err != nil {
+			return err
+		}
+
+		rejections := uint(1)
+		for _, a := range applications {
+			if a.ID != application.ID && a.State == ApplicationStateRejected {
+				rejections++
+			}
+		}
+
+		if rejections >= s.reapplicationPolicy.MaxAttempts {
+			application.PermanentlyRejected = true
+			application.ReapplyAfter = nil
+		}
+
+	}
+
+	if err := s.transition(application, ApplicationStateRejected, &actor, reason); err != nil {
+		return err
+	}
+
+	for _, onRejected := range s.onApplicationRejected {
+		onRejected(*application)
+	}
+
+	return nil
+
+}
+
+func (s *communityService) Votes(applicationID ApplicationID, requesterID MemberIdentifier) ([]ReviewVoteEntity, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if requester == nil {
+		return nil, errors.New("RequesterNotFound")
+	}
+
+	if !requester.HasPermission(PermissionApplicationsRead) {
+		return nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	return s.reviewVoteRepository.FetchByApplication(applicationID)
+
+}
+
+// Promote makes the member an admin. Like every role, admin can only be granted by members that out rank it.
+func (s *communityService) Promote(emailAddress vo.EmailAddress, requesterID MemberIdentifier) error {
+
+	member, err := s.memberRepository.FetchByEmailAddress(emailAddress)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return errors.New("couldn't find member by email address")
+	}
+
+	member, requester, err := s.authorizeRoleChange(member.ID, RoleAdmin, requesterID)
+	if err != nil {
+		return err
+	}
+
+	if member.HasRole(RoleAdmin) {
+		return errors.New("RoleAlreadyGranted")
+	}
+
+	return s.verifyWithRole(member, RoleAdmin, &requester.ID)
+
+}
+
+// BootstrapOwner makes the member the first owner of the community. Nobody authorizes the call, so it's meant
+// for the host application, e.g. through the bootstrap-owner command of community-admin, and must not be exposed
+// to members. It fails once the community has an owner. Deleted owners count, so that deleting the last owner
+// doesn't allow bootstrapping the community again.
+func (s *communityService) BootstrapOwner(emailAddress vo.EmailAddress) error {
+
+	owner := RoleOwner
+	owners, err := s.memberRepository.FetchByQuery(MembersQuery{
+		Next:           1,
+		Role:           &owner,
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(owners) > 0 {
+		return errors.New("CommunityHasOwner")
+	}
+
+	member, err := s.memberRepository.FetchByEmailAddress(emailAddress)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return errors.New("couldn't find member by email address")
+	}
+
+	if member.DeletedAt != nil {
+		return MemberErrorDeleted
+	}
+
+	return s.verifyWithRole(member, RoleOwner, nil)
+
+}
+
+// verifyWithRole grants the role to the member and verifies them if they aren't verified yet
+func (s *communityService) verifyWithRole(member *MemberEntity, role Role, changedBy *MemberIdentifier) error {
+
+	alreadyVerified := member.Verified
+
+	member.Verified = true
+
+	if !member.HasRole(role) {
+		if err := s.changeRole(member, role, true, changedBy); err != nil {
+			return err
+		}
+	}
+
+	if err := s.memberRepository.Save(*member); err != nil {
+		return err
+	}
+
+	if !alreadyVerified {
+		for _, onApproved := range s.onApplicationApproved {
+			onApproved(*member)
+		}
+	}
+
+	return nil
+
+}
+
+// Demote revokes the admin role of the member
+func (s *communityService) Demote(emailAddress vo.EmailAddress, requesterID MemberIdentifier) error {
+
+	member, err := s.memberRepository.FetchByEmailAddress(emailAddress)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return errors.New("couldn't find member by email address")
+	}
+
+	member, requester, err := s.authorizeRoleChange(member.ID, RoleAdmin, requesterID)
+	if err != nil {
+		return err
+	}
+
+	if !member.HasRole(RoleAdmin) {
+		return errors.New("RoleNotGranted")
+	}
+
+	if err := s.changeRole(member, RoleAdmin, false, &requester.ID); err != nil {
+		return err
+	}
+
+	return s.memberRepository.Save(*member)
+
+}
+
+func (s *communityService) GrantRole(memberID MemberIdentifier, role Role, requesterID MemberIdentifier) error {
+
+	member, requester, err := s.authorizeRoleChange(memberID, role, requesterID)
+	if err != nil {
+		return err
+	}
+
+	if member.HasRole(role) {
+		return errors.New("RoleAlreadyGranted")
+	}
+
+	if err := s.changeRole(member, role, true, &requester.ID); err != nil {
+		return err
+	}
+
+	return s.memberRepository.Save(*member)
+
+}
+
+func (s *communityService) RevokeRole(memberID MemberIdentifier, role Role, requesterID MemberIdentifier) error {
+
+	member, requester, err := s.authorizeRoleChange(memberID, role, requesterID)
+	if err != nil {
+		return err
+	}
+
+	if !member.HasRole(role) {
+		return errors.New("RoleNotGranted")
+	}
+
+	if err := s.changeRole(member, role, false, &requester.ID); err != nil {
+		return err
+	}
+
+	return s.memberRepository.Save(*member)
+
+}
+
+// authorizeRoleChange makes sure that the requester is allowed to change the role of the member.
+// Members can only manage roles below their own rank, of themselves and of members they out rank.
+// Since nobody out ranks owners, the owner role can't be revoked and owners can only be added.
+func (s *communityService) authorizeRoleChange(memberID MemberIdentifier, role Role, requesterID MemberIdentifier) (*MemberEntity, *MemberEntity, error) {
+
+	if !role.Valid() {
+		return nil, nil, fmt.Errorf("role: '%s' is invalid", role)
+	}
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if requester == nil {
+		return nil, nil, errors.New("RequesterNotFound")
+	}
+
+	if !requester.HasPermission(PermissionMembersPromote) {
+		return nil, nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	member, err := s.memberRepository.FetchByID(memberID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if member == nil {
+		return nil, nil, errors.New("MemberDoesNotExist")
+	}
+
+	if role.rank() >= requester.rank() || (member.ID != requester.ID && member.rank() >= requester.rank()) {
+		return nil, nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	return member, requester, nil
+
+}
+
+// changeRole grants or revokes the role and records the change. The caller is responsible for saving the member.
+func (s *communityService) changeRole(member *MemberEntity, role Role, granted bool, changedBy *MemberIdentifier) error {
+
+	roles := []Role{}
+	for _, r := range member.Roles {
+		if r != role {
+			roles = append(roles, r)
+		}
+	}
+	if granted {
+		roles = append(roles, role)
+	}
+
+	change := RoleChangeEntity{
+		ID:        uuid.NewV4(),
+		MemberID:  member.ID,
+		Role:      role,
+		Granted:   granted,
+		ChangedBy: changedBy,
+		ChangedAt: time.Now(),
+	}
+
+	if err := s.roleChangeRepository.Save(change); err != nil {
+		return err
+	}
+
+	member.Roles = roles
+
+	for _, onRoleChanged := range s.onRoleChanged {
+		onRoleChanged(change)
+	}
+
+	return nil
+
+}
+
+func (s *communityService) RoleChanges(memberID MemberIdentifier, requesterID MemberIdentifier) ([]RoleChangeEntity, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if requester == nil {
+		return nil, errors.New("RequesterNotFound")
+	}
+
+	if requester.ID != memberID && !requester.HasPermission(PermissionMembersPromote) {
+		return nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	return s.roleChangeRepository.FetchByMember(memberID)
+
+}
+
+func (s *communityService) GetApplicationByID(id ApplicationID) (ApplicationEntity, error) {
+
+	application, err := s.applicationRepository.FetchByID(id)
+
+	switch err {
+	case nil:
+		return *application, nil
+	default:
+		return ApplicationEntity{}, err
+	}
+
+}
+
+func (s *communityService) OnApplicationApproved(cb func(member MemberEntity)) {
+	s.onApplicationApproved = append(s.onApplicationApproved, cb)
+}
+
+func (s *communityService) OnApplicationSubmitted(cb func(application ApplicationEntity)) {
+	s.onApplicationSubmitted = append(s.onApplicationSubmitted, cb)
+}
+
+func (s *communityService) OnApplicationRejected(cb func(application ApplicationEntity)) {
+	s.onApplicationRejected = append(s.onApplicationRejected, cb)
+}
+
+func (s *communityService) OnApplicationExpired(cb func(application ApplicationEntity)) {
+	s.onApplicationExpired = append(s.onApplicationExpired, cb)
+}
+
+func (s *communityService) OnVerificationRevoked(cb func(member MemberEntity)) {
+	s.onVerificationRevoked = append(s.onVerificationRevoked, cb)
+}
+
+func (s *communityService) OnRoleChanged(cb func(change RoleChangeEntity)) {
+	s.onRoleChanged = append(s.onRoleChanged, cb)
 }
@@ -0,0 +1,549 @@
+package community_bl
+
+import (
+	"testing"
+	"time"
+)
+
+func withReviewPolicy(policy ReviewPolicy) func(dependencies *Dependencies) {
+	return func(dependencies *Dependencies) {
+		dependencies.ReviewPolicy = policy
+	}
+}
+
+func withReapplicationPolicy(policy ReapplicationPolicy) func(dependencies *Dependencies) {
+	return func(dependencies *Dependencies) {
+		dependencies.ReapplicationPolicy = policy
+	}
+}
+
+// auditActions returns the actions recorded in the audit log for the target
+func (c *testCommunity) auditActions(target ApplicationID) []AuditAction {
+
+	actions := []AuditAction{}
+	for _, entry := range c.auditLog.entries {
+		if entry.Target != nil && *entry.Target == target {
+			actions = append(actions, entry.Action)
+		}
+	}
+
+	return actions
+
+}
+
+func TestSingleReviewerDecidesWithoutQuorum(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	applicant := c.signUp("applicant")
+	application := c.apply(applicant)
+
+	if err := c.ApproveApplication(application.ID, reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if state := c.application(application.ID).State; state != ApplicationStateApproved {
+		t.Fatalf("expected the application to be approved, got: %s", state)
+	}
+
+	if !c.member(applicant.ID).Verified {
+		t.Fatal("expected the applicant to be verified")
+	}
+
+	expectError(t, c.RejectApplication(application.ID, "spam", reviewer.ID), "ApplicationReviewed")
+
+	if actions := c.auditActions(application.ID); len(actions) == 0 || actions[0] != AuditActionApplicationReviewed {
+		t.Fatalf("expected the deciding vote to be recorded as review, got: %v", actions)
+	}
+
+}
+
+func TestQuorumIsDecidedByMajority(t *testing.T) {
+
+	c := newTestCommunity(t, withReviewPolicy(ReviewPolicy{Quorum: 3}))
+
+	first := c.signUp("first", RoleReviewer)
+	second := c.signUp("second", RoleReviewer)
+	third := c.signUp("third", RoleReviewer)
+	applicant := c.signUp("applicant")
+	application := c.apply(applicant)
+
+	if err := c.RejectApplication(application.ID, "spam", first.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.ApproveApplication(application.ID, second.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if state := c.application(application.ID).State; state != ApplicationStatePending {
+		t.Fatalf("expected the application to be pending until the quorum is reached, got: %s", state)
+	}
+
+	if err := c.ApproveApplication(application.ID, third.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	decided := c.application(application.ID)
+	if decided.State != ApplicationStateApproved {
+		t.Fatalf("expected the majority to approve the application, got: %s", decided.State)
+	}
+
+	if len(decided.ApprovedBy) != 2 {
+		t.Fatalf("expected both approving reviewers to be recorded, got: %v", decided.ApprovedBy)
+	}
+
+	expected := []AuditAction{AuditActionApplicationVoted, AuditActionApplicationVoted, AuditActionApplicationReviewed}
+	actions := c.auditActions(application.ID)
+	if len(actions) < len(expected) {
+		t.Fatalf("expected actions %v, got: %v", expected, actions)
+	}
+	for i, action := range expected {
+		if actions[i] != action {
+			t.Fatalf("expected actions %v, got: %v", expected, actions)
+		}
+	}
+
+}
+
+func TestQuorumTieStaysPending(t *testing.T) {
+
+	c := newTestCommunity(t, withReviewPolicy(ReviewPolicy{Quorum: 2}))
+
+	first := c.signUp("first", RoleReviewer)
+	second := c.signUp("second", RoleReviewer)
+	third := c.signUp("third", RoleReviewer)
+	applicant := c.signUp("applicant")
+	application := c.apply(applicant)
+
+	if err := c.ApproveApplication(application.ID, first.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.RejectApplication(application.ID, "spam", second.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if state := c.application(application.ID).State; state != ApplicationStatePending {
+		t.Fatalf("expected a tie to keep the application pending, got: %s", state)
+	}
+
+	// changing a vote replaces it instead of adding another one
+	if err := c.RejectApplication(application.ID, "spam", first.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	decided := c.application(application.ID)
+	if decided.State != ApplicationStateRejected || decided.RejectionReason != "spam\nspam" {
+		t.Fatalf("expected the application to be rejected, got: %s %q", decided.State, decided.RejectionReason)
+	}
+
+	expectError(t, c.ApproveApplication(application.ID, third.ID), "ApplicationReviewed")
+
+}
+
+func TestVetoRoleRejectsRegardlessOfQuorum(t *testing.T) {
+
+	c := newTestCommunity(t, withReviewPolicy(ReviewPolicy{Quorum: 3, VetoRoles: []Role{RoleAdmin}}))
+
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	admin := c.signUp("admin", RoleAdmin)
+	applicant := c.signUp("applicant")
+	application := c.apply(applicant)
+
+	if err := c.ApproveApplication(application.ID, reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// approving votes of veto roles count like any other vote
+	if err := c.ApproveApplication(application.ID, admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if state := c.application(application.ID).State; state != ApplicationStatePending {
+		t.Fatalf("expected the application to be pending, got: %s", state)
+	}
+
+	if err := c.RejectApplication(application.ID, "fake profile", admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	decided := c.application(application.ID)
+	if decided.State != ApplicationStateRejected {
+		t.Fatalf("expected the veto to reject the application, got: %s", decided.State)
+	}
+
+	if len(decided.RejectedBy) != 1 || decided.RejectedBy[0] != admin.ID {
+		t.Fatalf("expected the vetoing admin to be recorded, got: %v", decided.RejectedBy)
+	}
+
+}
+
+func TestMembersWithoutReviewPermissionCantVote(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	moderator := c.signUp("moderator", RoleModerator)
+	applicant := c.signUp("applicant")
+	application := c.apply(applicant)
+
+	expectError(t, c.ApproveApplication(application.ID, moderator.ID), "InsufficientPermissions")
+
+	if actions := c.auditActions(application.ID); len(actions) == 0 || actions[len(actions)-1] != AuditActionApplicationReviewed {
+		t.Fatalf("expected the denied review to be recorded, got: %v", actions)
+	}
+
+}
+
+func TestReviewersCantVoteOnTheirOwnApplication(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	applicant := c.signUp("applicant", RoleReviewer)
+	application := c.apply(applicant)
+
+	_, err := c.CastVote(application.ID, ReviewDecisionApprove, "", applicant.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	expectError(t, c.ApproveApplication(application.ID, applicant.ID), "InsufficientPermissions")
+
+	if c.application(application.ID).State != ApplicationStatePending {
+		t.Fatal("expected the application to stay pending")
+	}
+
+}
+
+func TestWithdrawApplication(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	applicant := c.signUp("applicant")
+	other := c.signUp("other")
+	application := c.apply(applicant)
+
+	expectError(t, c.WithdrawApplication(application.ID, other.ID), AuthorizationErrorNotAllowedToAccessApplication.Error())
+
+	if err := c.WithdrawApplication(application.ID, applicant.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	withdrawn := c.application(application.ID)
+	if withdrawn.State != ApplicationStateWithdrawn || withdrawn.WithdrawnAt == nil {
+		t.Fatalf("expected the application to be withdrawn, got: %s", withdrawn.State)
+	}
+
+	expectError(t, c.WithdrawApplication(application.ID, applicant.ID), "ApplicationReviewed")
+	expectError(t, c.ApproveApplication(application.ID, reviewer.ID), "ApplicationReviewed")
+
+	if _, err := c.ApplyForVerification("I would like to join again", applicant.ID); err != nil {
+		t.Fatalf("expected the member to be able to apply again, got: %s", err.Error())
+	}
+
+}
+
+func TestEditApplication(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	applicant := c.signUp("applicant")
+	other := c.signUp("other")
+	application := c.apply(applicant)
+
+	_, err := c.EditApplication(application.ID, "I would like to join, really", nil, other.ID)
+	expectError(t, err, AuthorizationErrorNotAllowedToAccessApplication.Error())
+
+	_, err = c.EditApplication(application.ID, " ", nil, applicant.ID)
+	expectError(t, err, "application text must not be empty")
+
+	_, err = c.EditApplication(application.ID, "", []ApplicationAnswer{{QuestionID: "motivation", Value: "answer"}}, applicant.ID)
+	expectError(t, err, "free form applications can't be edited with answers")
+
+	edited, err := c.EditApplication(application.ID, "I would like to join, really", nil, applicant.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if edited.ApplicationText != "I would like to join, really" || c.application(application.ID).ApplicationText != edited.ApplicationText {
+		t.Fatalf("expected the application text to be replaced, got: %s", c.application(application.ID).ApplicationText)
+	}
+
+	revisions, err := c.ApplicationRevisions(application.ID, reviewer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(revisions) != 1 || revisions[0].ApplicationText != application.ApplicationText {
+		t.Fatalf("expected the previous text to be kept as revision, got: %+v", revisions)
+	}
+
+	_, err = c.ApplicationRevisions(application.ID, other.ID)
+	expectError(t, err, AuthorizationErrorNotAllowedToAccessApplication.Error())
+
+	if err := c.ApproveApplication(application.ID, reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.EditApplication(application.ID, "too late", nil, applicant.ID)
+	expectError(t, err, "ApplicationReviewed")
+
+}
+
+func TestReapplicationCoolDown(t *testing.T) {
+
+	c := newTestCommunity(t, withReapplicationPolicy(ReapplicationPolicy{CoolDown: time.Hour}))
+
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	applicant := c.signUp("applicant")
+	application := c.apply(applicant)
+
+	if err := c.RejectApplication(application.ID, "incomplete", reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := c.ApplyForVerification("I would like to join again", applicant.ID)
+	coolDownErr, ok := err.(ApplyForVerificationCoolDownError)
+	if !ok {
+		t.Fatalf("expected a cool down error, got: %v", err)
+	}
+
+	if reapplyAt := time.Unix(coolDownErr.ReapplyAt, 0); reapplyAt.Before(time.Now().Add(59 * time.Minute)) {
+		t.Fatalf("expected to be able to reapply in an hour, got: %s", reapplyAt)
+	}
+
+	// the reviewer can waive the cool down of the policy
+	second := c.signUp("second")
+	application = c.apply(second)
+
+	expectError(t, c.RejectApplicationWithCoolDown(application.ID, "incomplete", -time.Second, reviewer.ID), "cool down must not be negative")
+
+	if err := c.RejectApplicationWithCoolDown(application.ID, "incomplete", 0, reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.ApplyForVerification("I would like to join again", second.ID); err != nil {
+		t.Fatalf("expected to be able to reapply right away, got: %s", err.Error())
+	}
+
+}
+
+func TestReapplicationAttemptsAreLimited(t *testing.T) {
+
+	c := newTestCommunity(t, withReapplicationPolicy(ReapplicationPolicy{MaxAttempts: 2}))
+
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	applicant := c.signUp("applicant")
+
+	first := c.apply(applicant)
+	if err := c.RejectApplication(first.ID, "incomplete", reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.application(first.ID).PermanentlyRejected {
+		t.Fatal("expected the first rejection not to be permanent")
+	}
+
+	second := c.apply(applicant)
+	if err := c.RejectApplication(second.ID, "incomplete", reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if !c.application(second.ID).PermanentlyRejected {
+		t.Fatal("expected the second rejection to be permanent")
+	}
+
+	_, err := c.ApplyForVerification("I would like to join again", applicant.ID)
+	if err != ApplyForVerificationErrorPermanentlyRejected {
+		t.Fatalf("expected the member to be rejected permanently, got: %v", err)
+	}
+
+}
+
+func TestRevokeVerification(t *testing.T) {
+
+	c := newTestCommunity(t, withReapplicationPolicy(ReapplicationPolicy{CoolDown: time.Hour}))
+
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	applicant := c.signUp("applicant")
+	application := c.apply(applicant)
+
+	if err := c.ApproveApplication(application.ID, reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	revoked := []MemberIdentifier{}
+	c.OnVerificationRevoked(func(member MemberEntity) {
+		revoked = append(revoked, member.ID)
+	})
+
+	_, err := c.RevokeVerification(applicant.ID, " ", reviewer.ID)
+	expectError(t, err, "a reason is required")
+
+	_, err = c.RevokeVerification(reviewer.ID, "abuse", reviewer.ID)
+	expectError(t, err, AuthorizationErrorInsufficientPermissions.Error())
+
+	revocation, err := c.RevokeVerification(applicant.ID, "abuse", reviewer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if revocation.RevokedBy != reviewer.ID || revocation.Reason != "abuse" {
+		t.Fatalf("expected the reviewer and the reason to be recorded, got: %+v", revocation)
+	}
+
+	if c.member(applicant.ID).Verified {
+		t.Fatal("expected the member not to be verified anymore")
+	}
+
+	if len(revoked) != 1 || revoked[0] != applicant.ID {
+		t.Fatalf("expected the revocation to be announced, got: %v", revoked)
+	}
+
+	if state := c.application(application.ID).State; state != ApplicationStateApproved {
+		t.Fatalf("expected the approved application to stay untouched, got: %s", state)
+	}
+
+	_, err = c.RevokeVerification(applicant.ID, "abuse", reviewer.ID)
+	expectError(t, err, "MemberNotVerified")
+
+	// the member can apply again once the cool down of the reapplication policy passed
+	if _, err := c.ApplyForVerification("I would like to join again", applicant.ID); err == nil {
+		t.Fatal("expected the member to wait for the cool down")
+	} else if _, ok := err.(ApplyForVerificationCoolDownError); !ok {
+		t.Fatalf("expected a cool down error, got: %s", err.Error())
+	}
+
+	revocations, err := c.VerificationRevocations(applicant.ID, applicant.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(revocations) != 1 || revocations[0].ID != revocation.ID {
+		t.Fatalf("expected the member to see the revocation, got: %+v", revocations)
+	}
+
+}
+
+func TestApplicationHistory(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	applicant := c.signUp("applicant")
+	other := c.signUp("other")
+
+	rejected := c.apply(applicant)
+	if err := c.RejectApplication(rejected.ID, "incomplete", reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	withdrawn := c.apply(applicant)
+	if err := c.WithdrawApplication(withdrawn.ID, applicant.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := c.ApplicationHistory(applicant.ID, other.ID)
+	expectError(t, err, AuthorizationErrorNotAllowedToAccessApplication.Error())
+
+	history, err := c.ApplicationHistory(applicant.ID, reviewer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(history) != 2 || history[0].Application.ID != rejected.ID || history[1].Application.ID != withdrawn.ID {
+		t.Fatalf("expected both applications oldest first, got: %+v", history)
+	}
+
+	transitions := history[0].Transitions
+	if len(transitions) != 2 {
+		t.Fatalf("expected the submission and the rejection to be logged, got: %+v", transitions)
+	}
+
+	if submission := transitions[0]; submission.From != "" || submission.To != ApplicationStatePending || submission.Actor == nil || *submission.Actor != applicant.ID {
+		t.Fatalf("unexpected submission: %+v", submission)
+	}
+
+	if rejection := transitions[1]; rejection.From != ApplicationStatePending || rejection.To != ApplicationStateRejected || rejection.Actor == nil || *rejection.Actor != reviewer.ID || rejection.Reason != "incomplete" {
+		t.Fatalf("unexpected rejection: %+v", rejection)
+	}
+
+	if transitions := history[1].Transitions; len(transitions) != 2 || transitions[1].To != ApplicationStateWithdrawn {
+		t.Fatalf("expected the withdrawal to be logged, got: %+v", transitions)
+	}
+
+	own, err := c.ApplicationHistory(applicant.ID, applicant.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(own) != 2 {
+		t.Fatalf("expected the member to see the own history, got: %+v", own)
+	}
+
+}
+
+func TestApplicationsQuery(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	first := c.signUp("first")
+	second := c.signUp("second")
+	third := c.signUp("third")
+
+	c.apply(first)
+	rejected := c.apply(second)
+	c.apply(third)
+
+	if err := c.RejectApplication(rejected.ID, "incomplete", reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := c.Applications(ApplicationsQuery{}, first.ID)
+	expectError(t, err, AuthorizationErrorInsufficientPermissions.Error())
+
+	_, err = c.Applications(ApplicationsQuery{States: []ApplicationState{"Unknown"}}, reviewer.ID)
+	expectError(t, err, "application state: 'Unknown' is invalid")
+
+	_, err = c.Applications(ApplicationsQuery{Cursor: "invalid"}, reviewer.ID)
+	expectError(t, err, "InvalidCursor")
+
+	page, err := c.Applications(ApplicationsQuery{Next: 1, States: []ApplicationState{ApplicationStatePending}}, reviewer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(page.Applications) != 1 || page.Total != 2 || !page.HasMore || page.NextCursor == "" {
+		t.Fatalf("expected the first of two pending applications, got: %+v", page)
+	}
+
+	next, err := c.Applications(ApplicationsQuery{Next: 1, States: []ApplicationState{ApplicationStatePending}, Cursor: page.NextCursor}, reviewer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(next.Applications) != 1 || next.HasMore || next.Applications[0].ID == page.Applications[0].ID {
+		t.Fatalf("expected the second pending application, got: %+v", next)
+	}
+
+	own, err := c.Applications(ApplicationsQuery{Member: &second.ID}, reviewer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if own.Total != 1 || own.Applications[0].ID != rejected.ID {
+		t.Fatalf("expected the application of the member, got: %+v", own)
+	}
+
+	both, err := c.Applications(ApplicationsQuery{States: []ApplicationState{ApplicationStatePending, ApplicationStateRejected}}, reviewer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if both.Total != 3 || len(both.Applications) != 3 {
+		t.Fatalf("expected applications in any of the states, got: %+v", both)
+	}
+
+}
@@ -0,0 +1,53 @@
+package community_bl
+
+import (
+	"testing"
+)
+
+func TestNewCommunityRequiresDependencies(t *testing.T) {
+
+	var complete Dependencies
+	newTestCommunity(t, func(dependencies *Dependencies) {
+		complete = *dependencies
+	})
+
+	cases := []struct {
+		missing string
+		remove  func(dependencies *Dependencies)
+	}{
+		{"member repository", func(d *Dependencies) { d.MemberRepository = nil }},
+		{"application repository", func(d *Dependencies) { d.ApplicationRepository = nil }},
+		{"confirmation code repository", func(d *Dependencies) { d.ConfirmationCodeRepository = nil }},
+		{"transport", func(d *Dependencies) { d.Transport = nil }},
+		{"member access public key repository", func(d *Dependencies) { d.MemberAccessPublicKeyRepository = nil }},
+		{"access token repository", func(d *Dependencies) { d.AccessTokenRepository = nil }},
+		{"role change repository", func(d *Dependencies) { d.RoleChangeRepository = nil }},
+		{"username change repository", func(d *Dependencies) { d.UsernameChangeRepository = nil }},
+		{"member deletion repository", func(d *Dependencies) { d.MemberDeletionRepository = nil }},
+		{"login repository", func(d *Dependencies) { d.LoginRepository = nil }},
+		{"sanction repository", func(d *Dependencies) { d.SanctionRepository = nil }},
+		{"invitation repository", func(d *Dependencies) { d.InvitationRepository = nil }},
+		{"vouch repository", func(d *Dependencies) { d.VouchRepository = nil }},
+		{"review vote repository", func(d *Dependencies) { d.ReviewVoteRepository = nil }},
+		{"application comment repository", func(d *Dependencies) { d.ApplicationCommentRepository = nil }},
+		{"application form repository", func(d *Dependencies) { d.ApplicationFormRepository = nil }},
+		{"application revision repository", func(d *Dependencies) { d.ApplicationRevisionRepository = nil }},
+		{"appeal repository", func(d *Dependencies) { d.AppealRepository = nil }},
+		{"verification revocation repository", func(d *Dependencies) { d.VerificationRevocationRepository = nil }},
+		{"application transition repository", func(d *Dependencies) { d.ApplicationTransitionRepository = nil }},
+		{"audit log", func(d *Dependencies) { d.AuditLog = nil }},
+	}
+
+	for _, c := range cases {
+
+		dependencies := complete
+		c.remove(&dependencies)
+
+		_, err := NewCommunity(dependencies)
+		if err == nil || err.Error() != "missing "+c.missing {
+			t.Errorf("expected the %s to be required, got: %v", c.missing, err)
+		}
+
+	}
+
+}
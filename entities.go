@@ -10,13 +10,34 @@ type ApplicationEntity struct {
 	ID       ApplicationID
 	MemberID MemberIdentifier
 	ApplicationText string
+	// FormVersion is the version of the application form the Answers belong to.
+	// It's zero for free form applications that only have an ApplicationText.
+	FormVersion uint
+	Answers     []ApplicationAnswer
 	State ApplicationState
 	RejectionReason string
 	CreatedAt       time.Time
 	RejectedAt      *time.Time
 	ApprovedAt      *time.Time
-	RejectedBy      *MemberIdentifier
-	ApprovedBy      *MemberIdentifier
+	WithdrawnAt     *time.Time
+	// ReapplyAfter is the time after which a rejected member can apply again
+	ReapplyAfter *time.Time
+	// PermanentlyRejected is set once the member used up all attempts to apply
+	PermanentlyRejected bool
+	// RejectedBy and ApprovedBy contain every reviewer whose vote decided the application.
+	// ApprovedBy is empty if the application has been approved by vouches.
+	RejectedBy []MemberIdentifier
+	ApprovedBy []MemberIdentifier
+	// AssignedTo is the reviewer that claimed the application. The claim is treated
+	// as released once ClaimExpiresAt passed and cleared by the maintenance job.
+	AssignedTo     *MemberIdentifier
+	AssignedAt     *time.Time
+	ClaimExpiresAt *time.Time
+	// EscalatedAt is the time the managers have been notified about the waiting application
+	EscalatedAt *time.Time
+	ExpiredAt   *time.Time
+	// TransitionedAt is the time the application moved into its current state
+	TransitionedAt time.Time
 }
 
 type MemberEntity struct {
@@ -28,8 +49,54 @@ type MemberEntity struct {
 	Metadata              MetadataEntity
 	MemberAccessPublicKey *vo.MemberAccessPublicKey
 	AccessTokenID         *uuid.UUID
-	Admin                 bool
+	Roles                 []Role
 	Verified bool
+	DeletedAt             *time.Time
+	Erased                bool
+	SuspendedUntil        *time.Time
+	Banned                bool
+	InvitedBy             *MemberIdentifier
+	InvitationID          *uuid.UUID
+}
+
+func (m MemberEntity) HasRole(role Role) bool {
+
+	for _, r := range m.Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+
+}
+
+func (m MemberEntity) HasPermission(permission Permission) bool {
+
+	for _, role := range m.Roles {
+		for _, p := range role.Permissions() {
+			if p == permission {
+				return true
+			}
+		}
+	}
+
+	return false
+
+}
+
+// rank returns the rank of the highest role the member has
+func (m MemberEntity) rank() int {
+
+	rank := 0
+	for _, role := range m.Roles {
+		if role.rank() > rank {
+			rank = role.rank()
+		}
+	}
+
+	return rank
+
 }
 
 type MetadataEntity struct {
@@ -37,11 +104,180 @@ type MetadataEntity struct {
 	ProfileImage *vo.Base64String
 }
 
+// ProfileChanges describes a partial update of the member's metadata. Nil fields are left untouched.
+type ProfileChanges struct {
+	ProperName         *vo.ProperName
+	ProfileImage       *vo.Base64String
+	RemoveProfileImage bool
+}
+
+// MemberDeletionEntity records the deletion of a member. The member is erased
+// after the grace period unless the deletion is cancelled.
+type MemberDeletionEntity struct {
+	ID          uuid.UUID
+	MemberID    MemberIdentifier
+	RequestedBy MemberIdentifier
+	RequestedAt time.Time
+	EraseAt     time.Time
+	CancelledAt *time.Time
+	CancelledBy *MemberIdentifier
+	ErasedAt    *time.Time
+}
+
+type SanctionEntity struct {
+	ID           uuid.UUID
+	MemberID     MemberIdentifier
+	EmailAddress vo.EmailAddress
+	Type         SanctionType
+	Reason       string
+	IssuedBy     MemberIdentifier
+	IssuedAt     time.Time
+	// ExpiresAt is nil for bans
+	ExpiresAt  *time.Time
+	LiftedAt   *time.Time
+	LiftedBy   *MemberIdentifier
+	LiftReason string
+}
+
+func (s SanctionEntity) Active(now time.Time) bool {
+
+	if s.LiftedAt != nil {
+		return false
+	}
+
+	return s.ExpiresAt == nil || now.Before(*s.ExpiresAt)
+
+}
+
+// ApplicationRevisionEntity holds the content an application had before it has been edited
+type ApplicationRevisionEntity struct {
+	ID              uuid.UUID
+	ApplicationID   ApplicationID
+	ApplicationText string
+	FormVersion     uint
+	Answers         []ApplicationAnswer
+	// RevisedAt is the time the content has been replaced
+	RevisedAt time.Time
+}
+
+// ApplicationTransitionEntity is an entry of the append only log of the state changes of an application
+type ApplicationTransitionEntity struct {
+	ID            uuid.UUID
+	ApplicationID ApplicationID
+	// From is empty for the submission of the application
+	From ApplicationState
+	To   ApplicationState
+	// Actor is nil if the application has been moved by the community itself
+	Actor          *MemberIdentifier
+	Reason         string
+	TransitionedAt time.Time
+}
+
+type VerificationRevocationEntity struct {
+	ID        uuid.UUID
+	MemberID  MemberIdentifier
+	Reason    string
+	RevokedBy MemberIdentifier
+	RevokedAt time.Time
+}
+
+type AppealEntity struct {
+	ID            uuid.UUID
+	ApplicationID ApplicationID
+	MemberID      MemberIdentifier
+	Statement     string
+	State         AppealState
+	CreatedAt     time.Time
+	DecidedBy     *MemberIdentifier
+	DecidedAt     *time.Time
+	// Decision explains why the appeal has been upheld or overturned
+	Decision string
+}
+
+type ApplicationCommentEntity struct {
+	ID            uuid.UUID
+	ApplicationID ApplicationID
+	AuthorID      MemberIdentifier
+	Text          string
+	// Internal comments are notes only visible to reviewers
+	Internal bool
+	// Question is set if a reviewer requested information from the applicant with the comment
+	Question  bool
+	CreatedAt time.Time
+}
+
+type ReviewVoteEntity struct {
+	ID            uuid.UUID
+	ApplicationID ApplicationID
+	ReviewerID    MemberIdentifier
+	Decision      ReviewDecision
+	Comment       string
+	// ReapplyCoolDown overrides the cool down of the reapplication policy if the vote rejects the application
+	ReapplyCoolDown *time.Duration
+	CastAt          time.Time
+}
+
+type VouchEntity struct {
+	ID            uuid.UUID
+	ApplicationID ApplicationID
+	VoucherID     MemberIdentifier
+	VouchedAt     time.Time
+	RevokedAt     *time.Time
+}
+
+type InvitationEntity struct {
+	ID        uuid.UUID
+	Code      vo.InvitationCode
+	CreatedBy MemberIdentifier
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+	// MaxUses limits how often the invitation can be used. Zero means unlimited.
+	MaxUses uint
+	Uses    uint
+	// EmailAddress binds the invitation to an email address if set
+	EmailAddress *vo.EmailAddress
+	// SkipVerification verifies invited members on sign up
+	SkipVerification bool
+	RevokedAt        *time.Time
+}
+
+func (i InvitationEntity) Usable(now time.Time) bool {
+
+	if i.RevokedAt != nil {
+		return false
+	}
+
+	if i.ExpiresAt != nil && !now.Before(*i.ExpiresAt) {
+		return false
+	}
+
+	return i.MaxUses == 0 || i.Uses < i.MaxUses
+
+}
+
+type UsernameChangeEntity struct {
+	ID          uuid.UUID
+	MemberID    MemberIdentifier
+	OldUsername vo.Username
+	NewUsername vo.Username
+	ChangedAt   time.Time
+}
+
+type LoginEntity struct {
+	ID                    uuid.UUID
+	MemberID              MemberIdentifier
+	AccessTokenID         uuid.UUID
+	MemberAccessPublicKey vo.MemberAccessPublicKey
+	LoggedInAt            time.Time
+}
+
 type MemberAccessTokenEntity struct {
-	ExpiresAt         int64
-	ID                uuid.UUID
-	IssuedAt          int64
-	Subject           MemberIdentifier
+	ExpiresAt int64
+	ID        uuid.UUID
+	IssuedAt  int64
+	Subject   MemberIdentifier
+	// RevokedAt is set once the access token has been revoked. Revoked access tokens can't be used anymore.
+	RevokedAt         *time.Time
 	signedAccessToken string
 }
 
@@ -56,6 +292,7 @@ type ConfirmationCode struct {
 	ConfirmationCode vo.ConfirmationCode
 	IssuedAt         int64
 	Used             bool
+	Purpose          ConfirmationCodePurpose
 }
 
 func (cc *ConfirmationCode) Expired() bool {
@@ -0,0 +1,343 @@
+package community_bl
+
+import (
+	"errors"
+	"fmt"
+	"github.com/satori/go.uuid"
+	vo "github.com/214alphadev/community-bl/value_objects"
+	"strings"
+	"time"
+)
+
+// DeletionPolicy configures the deletion of members
+type DeletionPolicy struct {
+	// GracePeriod is the time after which a deleted member is erased.
+	// During the grace period the deletion can be cancelled.
+	GracePeriod time.Duration
+}
+
+type erasureService struct {
+	memberRepository         MemberRepository
+	applicationRepository    ApplicationRepository
+	usernameChangeRepository UsernameChangeRepository
+	memberDeletionRepository MemberDeletionRepository
+	commentRepository        ApplicationCommentRepository
+	revisionRepository       ApplicationRevisionRepository
+	transitionRepository     ApplicationTransitionRepository
+	appealRepository         AppealRepository
+	sanctionRepository       SanctionRepository
+	invitationRepository     InvitationRepository
+	memberService            *memberService
+	deletionPolicy           DeletionPolicy
+	onMemberDeleted          []func(member MemberEntity)
+	onMemberErased           []func(member MemberEntity)
+}
+
+var MemberErrorDeleted = errors.New("MemberDeleted")
+
+func (s *erasureService) DeleteMember(memberID MemberIdentifier, requesterID MemberIdentifier) (MemberDeletionEntity, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return MemberDeletionEntity{}, err
+	}
+
+	if requester == nil {
+		return MemberDeletionEntity{}, errors.New("RequesterNotFound")
+	}
+
+	if requester.ID != memberID && !requester.HasPermission(PermissionMembersDelete) {
+		return MemberDeletionEntity{}, AuthorizationErrorInsufficientPermissions
+	}
+
+	member, err := s.memberRepository.FetchByID(memberID)
+	if err != nil {
+		return MemberDeletionEntity{}, err
+	}
+
+	if member == nil {
+		return MemberDeletionEntity{}, errors.New("MemberDoesNotExist")
+	}
+
+	// members can only be deleted by members that out rank them
+	if requester.ID != member.ID && member.rank() >= requester.rank() {
+		return MemberDeletionEntity{}, AuthorizationErrorInsufficientPermissions
+	}
+
+	if member.DeletedAt != nil {
+		return MemberDeletionEntity{}, errors.New("MemberAlreadyDeleted")
+	}
+
+	now := time.Now()
+
+	deletion := MemberDeletionEntity{
+		ID:          uuid.NewV4(),
+		MemberID:    member.ID,
+		RequestedBy: requester.ID,
+		RequestedAt: now,
+		EraseAt:     now.Add(s.deletionPolicy.GracePeriod),
+	}
+	if err := s.memberDeletionRepository.Save(deletion); err != nil {
+		return MemberDeletionEntity{}, err
+	}
+
+	if err := s.memberService.revokeAccessTokens(member.ID); err != nil {
+		return MemberDeletionEntity{}, err
+	}
+
+	member.DeletedAt = &now
+	member.AccessTokenID = nil
+	if err := s.memberRepository.Save(*member); err != nil {
+		return MemberDeletionEntity{}, err
+	}
+
+	if err := s.memberService.invalidateConfirmationCodes(member.EmailAddress); err != nil {
+		return MemberDeletionEntity{}, err
+	}
+
+	for _, onDeleted := range s.onMemberDeleted {
+		onDeleted(*member)
+	}
+
+	return deletion, nil
+
+}
+
+// CancelMemberDeletion restores a deleted member during the grace period
+func (s *erasureService) CancelMemberDeletion(memberID MemberIdentifier, requesterID MemberIdentifier) error {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return err
+	}
+
+	if requester == nil {
+		return errors.New("RequesterNotFound")
+	}
+
+	if !requester.HasPermission(PermissionMembersDelete) {
+		return AuthorizationErrorInsufficientPermissions
+	}
+
+	deletion, err := s.memberDeletionRepository.FetchPending(memberID)
+	if err != nil {
+		return err
+	}
+
+	if deletion == nil {
+		return errors.New("NoPendingDeletion")
+	}
+
+	member, err := s.memberRepository.FetchByID(memberID)
+	if err != nil {
+		return err
+	}
+
+	if member == nil {
+		return errors.New("MemberDoesNotExist")
+	}
+
+	// deletions can only be cancelled by members that out rank the deleted member
+	if member.rank() >= requester.rank() {
+		return AuthorizationErrorInsufficientPermissions
+	}
+
+	now := time.Now()
+	deletion.CancelledAt = &now
+	deletion.CancelledBy = &requester.ID
+	if err := s.memberDeletionRepository.Save(*deletion); err != nil {
+		return err
+	}
+
+	member.DeletedAt = nil
+
+	return s.memberRepository.Save(*member)
+
+}
+
+// EraseDueMembers erases all members whose grace period ended before the given time.
+// It's meant to be called periodically by the host.
+func (s *erasureService) EraseDueMembers(now time.Time) ([]MemberDeletionEntity, error) {
+
+	deletions, err := s.memberDeletionRepository.FetchDue(now)
+	if err != nil {
+		return nil, err
+	}
+
+	erased := []MemberDeletionEntity{}
+
+	for _, deletion := range deletions {
+
+		if err := s.erase(deletion.MemberID); err != nil {
+			return erased, err
+		}
+
+		erasedAt := time.Now()
+		deletion.ErasedAt = &erasedAt
+		if err := s.memberDeletionRepository.Save(deletion); err != nil {
+			return erased, err
+		}
+
+		erased = append(erased, deletion)
+
+	}
+
+	return erased, nil
+
+}
+
+// erase pseudonymizes the personal data of the member. The member access public key stays
+// in the MemberAccessPublicKeyRepository so that it can't be used again.
+func (s *erasureService) erase(memberID MemberIdentifier) error {
+
+	member, err := s.memberRepository.FetchByID(memberID)
+	if err != nil {
+		return err
+	}
+
+	if member == nil {
+		return fmt.Errorf("couldn't find member to erase (member id: %s)", memberID.String())
+	}
+
+	pseudonym := strings.Replace(member.ID.String(), "-", "", -1)
+
+	emailAddress, err := vo.NewEmailAddress(fmt.Sprintf("erased-%s@erased.invalid", pseudonym))
+	if err != nil {
+		return err
+	}
+
+	username, err := vo.NewUsername(fmt.Sprintf("erased_%s", pseudonym))
+	if err != nil {
+		return err
+	}
+
+	properName, err := vo.NewProperName("Erased", "Member")
+	if err != nil {
+		return err
+	}
+
+	if err := s.memberService.invalidateConfirmationCodes(member.EmailAddress); err != nil {
+		return err
+	}
+
+	applications, err := s.applicationRepository.FetchByMember(member.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, application := range applications {
+		application.ApplicationText = ""
+		application.Answers = nil
+		application.RejectionReason = ""
+		if err := s.applicationRepository.Save(application); err != nil {
+			return err
+		}
+		if err := s.commentRepository.DeleteByApplication(application.ID); err != nil {
+			return err
+		}
+		if err := s.revisionRepository.DeleteByApplication(application.ID); err != nil {
+			return err
+		}
+		if err := s.transitionRepository.ClearReasons(application.ID); err != nil {
+			return err
+		}
+		appeal, err := s.appealRepository.FetchByApplication(application.ID)
+		if err != nil {
+			return err
+		}
+		if appeal != nil {
+			appeal.Statement = ""
+			if err := s.appealRepository.Save(*appeal); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := s.usernameChangeRepository.DeleteByMember(member.ID); err != nil {
+		return err
+	}
+
+	if err := s.eraseSanctions(*member, emailAddress); err != nil {
+		return err
+	}
+
+	if err := s.eraseInvitation(*member, emailAddress); err != nil {
+		return err
+	}
+
+	member.EmailAddress = emailAddress
+	member.Username = username
+	member.Metadata = MetadataEntity{
+		ProperName: properName,
+	}
+	member.MemberAccessPublicKey = nil
+	member.AccessTokenID = nil
+	member.Roles = nil
+	member.Erased = true
+
+	if err := s.memberRepository.Save(*member); err != nil {
+		return err
+	}
+
+	for _, onErased := range s.onMemberErased {
+		onErased(*member)
+	}
+
+	return nil
+
+}
+
+// eraseSanctions removes the reasons of the sanctions of the member. The email address of a ban that
+// hasn't been lifted is kept, since it's needed to keep the address from signing up again.
+func (s *erasureService) eraseSanctions(member MemberEntity, pseudonym vo.EmailAddress) error {
+
+	sanctions, err := s.sanctionRepository.FetchByMember(member.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, sanction := range sanctions {
+		sanction.Reason = ""
+		sanction.LiftReason = ""
+		if sanction.Type != SanctionTypeBan || sanction.LiftedAt != nil {
+			sanction.EmailAddress = pseudonym
+		}
+		if err := s.sanctionRepository.Save(sanction); err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
+
+// eraseInvitation replaces the email address the invitation of the member has been bound to.
+// The pseudonym keeps the invitation bound, so that it can't be used by someone else.
+func (s *erasureService) eraseInvitation(member MemberEntity, pseudonym vo.EmailAddress) error {
+
+	if member.InvitationID == nil {
+		return nil
+	}
+
+	invitation, err := s.invitationRepository.FetchByID(*member.InvitationID)
+	if err != nil {
+		return err
+	}
+
+	if invitation == nil || invitation.EmailAddress == nil || *invitation.EmailAddress != member.EmailAddress {
+		return nil
+	}
+
+	invitation.EmailAddress = &pseudonym
+
+	return s.invitationRepository.Save(*invitation)
+
+}
+
+func (s *erasureService) OnMemberDeleted(cb func(member MemberEntity)) {
+	s.onMemberDeleted = append(s.onMemberDeleted, cb)
+}
+
+func (s *erasureService) OnMemberErased(cb func(member MemberEntity)) {
+	s.onMemberErased = append(s.onMemberErased, cb)
+}
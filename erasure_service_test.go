@@ -0,0 +1,184 @@
+package community_bl
+
+import (
+	"testing"
+	"time"
+)
+
+func withDeletionPolicy(policy DeletionPolicy) func(dependencies *Dependencies) {
+	return func(dependencies *Dependencies) {
+		dependencies.DeletionPolicy = policy
+	}
+}
+
+func TestDeleteMember(t *testing.T) {
+
+	c := newTestCommunity(t, withDeletionPolicy(DeletionPolicy{GracePeriod: time.Hour}))
+
+	admin := c.signUp("admin", RoleAdmin)
+	peer := c.signUp("peer", RoleAdmin)
+	member := c.signUp("member")
+	other := c.signUp("other")
+	accessToken := c.login(member)
+
+	deleted := []MemberIdentifier{}
+	c.OnMemberDeleted(func(member MemberEntity) {
+		deleted = append(deleted, member.ID)
+	})
+
+	_, err := c.DeleteMember(member.ID, other.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	_, err = c.DeleteMember(peer.ID, admin.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	deletion, err := c.DeleteMember(member.ID, member.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !deletion.EraseAt.After(time.Now().Add(59 * time.Minute)) {
+		t.Fatalf("expected the erasure after the grace period, got: %v", deletion.EraseAt)
+	}
+
+	if c.member(member.ID).DeletedAt == nil {
+		t.Fatal("expected the member to be marked as deleted")
+	}
+
+	if c.accessTokens.accessTokens[accessToken.ID].RevokedAt == nil {
+		t.Fatal("expected the access tokens of the member to be revoked")
+	}
+
+	if len(deleted) != 1 || deleted[0] != member.ID {
+		t.Fatalf("expected the deletion to be reported, got: %v", deleted)
+	}
+
+	_, err = c.DeleteMember(member.ID, admin.ID)
+	expectError(t, err, "MemberAlreadyDeleted")
+
+	if err := c.RequestLogin(member.EmailAddress); err == nil {
+		t.Fatal("expected the deleted member not to be able to log in")
+	}
+
+}
+
+func TestCancelMemberDeletion(t *testing.T) {
+
+	c := newTestCommunity(t, withDeletionPolicy(DeletionPolicy{GracePeriod: time.Hour}))
+
+	owner := c.signUp("owner", RoleOwner)
+	admin := c.signUp("admin", RoleAdmin)
+	peer := c.signUp("peer", RoleAdmin)
+	member := c.signUp("member")
+
+	expectError(t, c.CancelMemberDeletion(member.ID, admin.ID), "NoPendingDeletion")
+
+	if _, err := c.DeleteMember(peer.ID, peer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.DeleteMember(member.ID, member.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// deletions can only be cancelled by members that out rank the deleted member
+	expectError(t, c.CancelMemberDeletion(peer.ID, admin.ID), "InsufficientPermissions")
+	expectError(t, c.CancelMemberDeletion(member.ID, member.ID), "InsufficientPermissions")
+
+	if err := c.CancelMemberDeletion(peer.ID, owner.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.CancelMemberDeletion(member.ID, admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.member(member.ID).DeletedAt != nil || c.member(peer.ID).DeletedAt != nil {
+		t.Fatal("expected the members to be restored")
+	}
+
+	// cancelled deletions are not erased
+	erased, err := c.EraseDueMembers(time.Now().Add(2 * time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(erased) != 0 {
+		t.Fatalf("expected no erasures, got: %v", erased)
+	}
+
+}
+
+func TestEraseDueMembers(t *testing.T) {
+
+	c := newTestCommunity(t, withDeletionPolicy(DeletionPolicy{GracePeriod: time.Hour}))
+
+	admin := c.signUp("admin", RoleAdmin)
+	member := c.signUp("member", RoleReviewer)
+	banned := c.signUp("banned")
+	application := c.apply(member)
+
+	if _, err := c.BanMember(banned.ID, "spam", admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, m := range []MemberEntity{member, banned} {
+		if _, err := c.DeleteMember(m.ID, admin.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	erasedMembers := []MemberIdentifier{}
+	c.OnMemberErased(func(member MemberEntity) {
+		erasedMembers = append(erasedMembers, member.ID)
+	})
+
+	// nothing is due during the grace period
+	erased, err := c.EraseDueMembers(time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(erased) != 0 {
+		t.Fatalf("expected no erasures during the grace period, got: %v", erased)
+	}
+
+	erased, err = c.EraseDueMembers(time.Now().Add(2 * time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(erased) != 2 || len(erasedMembers) != 2 {
+		t.Fatalf("expected both members to be erased, got: %v", erased)
+	}
+
+	pseudonymized := c.member(member.ID)
+	if !pseudonymized.Erased || pseudonymized.EmailAddress == member.EmailAddress || pseudonymized.Username == member.Username || len(pseudonymized.Roles) != 0 {
+		t.Fatalf("expected the personal data to be erased, got: %s %s", pseudonymized.Username.String(), pseudonymized.EmailAddress.String())
+	}
+
+	if c.application(application.ID).ApplicationText != "" {
+		t.Fatal("expected the application text to be erased")
+	}
+
+	// the address of a ban is kept, so it can't sign up again
+	sanctions, err := c.Sanctions(banned.ID, admin.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sanctions) != 1 || sanctions[0].EmailAddress != banned.EmailAddress || sanctions[0].Reason != "" {
+		t.Fatalf("expected the ban to keep the address without the reason, got: %v", sanctions)
+	}
+
+	// erasures happen once
+	erased, err = c.EraseDueMembers(time.Now().Add(2 * time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(erased) != 0 {
+		t.Fatalf("expected erased members not to be erased again, got: %v", erased)
+	}
+
+}
@@ -0,0 +1,369 @@
+package community_bl
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+// MemberDataExport contains all personal data the community stores about a member
+type MemberDataExport struct {
+	ExportedAt          time.Time                  `json:"exported_at"`
+	Member              MemberExport               `json:"member"`
+	Applications        []ApplicationExport        `json:"applications"`
+	ApplicationComments []ApplicationCommentExport `json:"application_comments"`
+	Logins              []LoginExport              `json:"logins"`
+	ConfirmationCodes   []ConfirmationCodeExport   `json:"confirmation_codes"`
+	UsernameChanges     []UsernameChangeExport     `json:"username_changes"`
+	profileImage        []byte
+}
+
+type MemberExport struct {
+	ID                   string     `json:"id"`
+	CreatedAt            time.Time  `json:"created_at"`
+	Username             string     `json:"username"`
+	EmailAddress         string     `json:"email_address"`
+	VerifiedEmailAddress bool       `json:"verified_email_address"`
+	FirstName            string     `json:"first_name"`
+	LastName             string     `json:"last_name"`
+	ProfileImage         string     `json:"profile_image,omitempty"`
+	Verified             bool       `json:"verified"`
+	Roles                []string   `json:"roles"`
+	DeletedAt            *time.Time `json:"deleted_at,omitempty"`
+}
+
+type ApplicationExport struct {
+	ID              string                      `json:"id"`
+	ApplicationText string                      `json:"application_text,omitempty"`
+	FormVersion     uint                        `json:"form_version,omitempty"`
+	Answers         []ApplicationAnswerExport   `json:"answers,omitempty"`
+	State           string                      `json:"state"`
+	RejectionReason string                      `json:"rejection_reason,omitempty"`
+	CreatedAt       time.Time                   `json:"created_at"`
+	ApprovedAt      *time.Time                  `json:"approved_at,omitempty"`
+	RejectedAt      *time.Time                  `json:"rejected_at,omitempty"`
+	WithdrawnAt     *time.Time                  `json:"withdrawn_at,omitempty"`
+	Revisions       []ApplicationRevisionExport `json:"revisions,omitempty"`
+	Appeal          *AppealExport               `json:"appeal,omitempty"`
+}
+
+type AppealExport struct {
+	Statement string     `json:"statement"`
+	State     string     `json:"state"`
+	CreatedAt time.Time  `json:"created_at"`
+	DecidedAt *time.Time `json:"decided_at,omitempty"`
+	Decision  string     `json:"decision,omitempty"`
+}
+
+type ApplicationRevisionExport struct {
+	ApplicationText string                    `json:"application_text,omitempty"`
+	Answers         []ApplicationAnswerExport `json:"answers,omitempty"`
+	RevisedAt       time.Time                 `json:"revised_at"`
+}
+
+type ApplicationAnswerExport struct {
+	QuestionID string `json:"question_id"`
+	Value      string `json:"value,omitempty"`
+	File       string `json:"file,omitempty"`
+}
+
+type ApplicationCommentExport struct {
+	ApplicationID string    `json:"application_id"`
+	AuthorID      string    `json:"author_id"`
+	Text          string    `json:"text"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type LoginExport struct {
+	LoggedInAt            time.Time `json:"logged_in_at"`
+	AccessTokenID         string    `json:"access_token_id"`
+	MemberAccessPublicKey string    `json:"member_access_public_key"`
+}
+
+// ConfirmationCodeExport never contains the confirmation code itself
+type ConfirmationCodeExport struct {
+	EmailAddress string    `json:"email_address"`
+	Purpose      string    `json:"purpose"`
+	IssuedAt     time.Time `json:"issued_at"`
+	Used         bool      `json:"used"`
+}
+
+type UsernameChangeExport struct {
+	OldUsername string    `json:"old_username"`
+	NewUsername string    `json:"new_username"`
+	ChangedAt   time.Time `json:"changed_at"`
+}
+
+func (e MemberDataExport) JSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// WriteZip writes a zip archive with the json export and the decoded profile image
+func (e MemberDataExport) WriteZip(w io.Writer) error {
+
+	archive := zip.NewWriter(w)
+
+	data, err := e.JSON()
+	if err != nil {
+		return err
+	}
+
+	file, err := archive.Create("data.json")
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		return err
+	}
+
+	if len(e.profileImage) > 0 {
+		file, err := archive.Create("profile_image" + imageExtension(e.profileImage))
+		if err != nil {
+			return err
+		}
+		if _, err := file.Write(e.profileImage); err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+
+}
+
+func (e MemberDataExport) Zip() ([]byte, error) {
+
+	buf := &bytes.Buffer{}
+	if err := e.WriteZip(buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+
+}
+
+func imageExtension(image []byte) string {
+
+	switch http.DetectContentType(image) {
+	case "image/png":
+		return ".png"
+	case "image/jpeg":
+		return ".jpg"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	default:
+		return ".bin"
+	}
+
+}
+
+type exportService struct {
+	memberRepository           MemberRepository
+	applicationRepository      ApplicationRepository
+	loginRepository            LoginRepository
+	confirmationCodeRepository ConfirmationCodeRepository
+	usernameChangeRepository   UsernameChangeRepository
+	commentRepository          ApplicationCommentRepository
+	revisionRepository         ApplicationRevisionRepository
+	appealRepository           AppealRepository
+}
+
+func (s *exportService) ExportMemberData(memberID MemberIdentifier, requesterID MemberIdentifier) (MemberDataExport, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return MemberDataExport{}, err
+	}
+
+	if requester == nil {
+		return MemberDataExport{}, errors.New("RequesterNotFound")
+	}
+
+	if requester.ID != memberID && !requester.HasPermission(PermissionMembersExport) {
+		return MemberDataExport{}, AuthorizationErrorInsufficientPermissions
+	}
+
+	member, err := s.memberRepository.FetchByID(memberID)
+	if err != nil {
+		return MemberDataExport{}, err
+	}
+
+	if member == nil {
+		return MemberDataExport{}, errors.New("MemberDoesNotExist")
+	}
+
+	// the data of members can only be exported by members that out rank them
+	if requester.ID != member.ID && member.rank() >= requester.rank() {
+		return MemberDataExport{}, AuthorizationErrorInsufficientPermissions
+	}
+
+	export := MemberDataExport{
+		ExportedAt:          time.Now(),
+		Member:              memberExport(*member),
+		Applications:        []ApplicationExport{},
+		ApplicationComments: []ApplicationCommentExport{},
+		Logins:              []LoginExport{},
+		ConfirmationCodes:   []ConfirmationCodeExport{},
+		UsernameChanges:     []UsernameChangeExport{},
+	}
+
+	if member.Metadata.ProfileImage != nil {
+		export.profileImage = member.Metadata.ProfileImage.Bytes()
+	}
+
+	applications, err := s.applicationRepository.FetchByMember(member.ID)
+	if err != nil {
+		return MemberDataExport{}, err
+	}
+	for _, application := range applications {
+		applicationExport := ApplicationExport{
+			ID:              application.ID.String(),
+			ApplicationText: application.ApplicationText,
+			FormVersion:     application.FormVersion,
+			State:           string(application.State),
+			RejectionReason: application.RejectionReason,
+			CreatedAt:       application.CreatedAt,
+			ApprovedAt:      application.ApprovedAt,
+			RejectedAt:      application.RejectedAt,
+			WithdrawnAt:     application.WithdrawnAt,
+			Answers:         answersExport(application.Answers),
+		}
+		revisions, err := s.revisionRepository.FetchByApplication(application.ID)
+		if err != nil {
+			return MemberDataExport{}, err
+		}
+		for _, revision := range revisions {
+			applicationExport.Revisions = append(applicationExport.Revisions, ApplicationRevisionExport{
+				ApplicationText: revision.ApplicationText,
+				Answers:         answersExport(revision.Answers),
+				RevisedAt:       revision.RevisedAt,
+			})
+		}
+		appeal, err := s.appealRepository.FetchByApplication(application.ID)
+		if err != nil {
+			return MemberDataExport{}, err
+		}
+		if appeal != nil {
+			applicationExport.Appeal = &AppealExport{
+				Statement: appeal.Statement,
+				State:     string(appeal.State),
+				CreatedAt: appeal.CreatedAt,
+				DecidedAt: appeal.DecidedAt,
+				Decision:  appeal.Decision,
+			}
+		}
+		export.Applications = append(export.Applications, applicationExport)
+		comments, err := s.commentRepository.FetchByApplication(application.ID)
+		if err != nil {
+			return MemberDataExport{}, err
+		}
+		for _, comment := range comments {
+			// internal notes of the reviewers are not part of the export
+			if comment.Internal {
+				continue
+			}
+			export.ApplicationComments = append(export.ApplicationComments, ApplicationCommentExport{
+				ApplicationID: comment.ApplicationID.String(),
+				AuthorID:      comment.AuthorID.String(),
+				Text:          comment.Text,
+				CreatedAt:     comment.CreatedAt,
+			})
+		}
+	}
+
+	logins, err := s.loginRepository.FetchByMember(member.ID)
+	if err != nil {
+		return MemberDataExport{}, err
+	}
+	for _, login := range logins {
+		export.Logins = append(export.Logins, LoginExport{
+			LoggedInAt:            login.LoggedInAt,
+			AccessTokenID:         login.AccessTokenID.String(),
+			MemberAccessPublicKey: base64.StdEncoding.EncodeToString(login.MemberAccessPublicKey.Key()),
+		})
+	}
+
+	confirmationCodes, err := s.confirmationCodeRepository.FetchByMember(member.ID)
+	if err != nil {
+		return MemberDataExport{}, err
+	}
+	for _, confirmationCode := range confirmationCodes {
+		purpose := string(confirmationCode.Purpose)
+		if confirmationCode.Purpose == ConfirmationCodePurposeLogin {
+			purpose = "Login"
+		}
+		export.ConfirmationCodes = append(export.ConfirmationCodes, ConfirmationCodeExport{
+			EmailAddress: confirmationCode.EmailAddress.String(),
+			Purpose:      purpose,
+			IssuedAt:     time.Unix(confirmationCode.IssuedAt, 0),
+			Used:         confirmationCode.Used,
+		})
+	}
+
+	usernameChanges, err := s.usernameChangeRepository.FetchByMember(member.ID)
+	if err != nil {
+		return MemberDataExport{}, err
+	}
+	for _, change := range usernameChanges {
+		export.UsernameChanges = append(export.UsernameChanges, UsernameChangeExport{
+			OldUsername: change.OldUsername.String(),
+			NewUsername: change.NewUsername.String(),
+			ChangedAt:   change.ChangedAt,
+		})
+	}
+
+	return export, nil
+
+}
+
+func answersExport(answers []ApplicationAnswer) []ApplicationAnswerExport {
+
+	var export []ApplicationAnswerExport
+
+	for _, answer := range answers {
+		answerExport := ApplicationAnswerExport{
+			QuestionID: answer.QuestionID,
+			Value:      answer.Value,
+		}
+		if answer.File != nil {
+			answerExport.File = answer.File.String()
+		}
+		export = append(export, answerExport)
+	}
+
+	return export
+
+}
+
+func memberExport(member MemberEntity) MemberExport {
+
+	export := MemberExport{
+		ID:                   member.ID.String(),
+		CreatedAt:            member.CreatedAt,
+		Username:             member.Username.String(),
+		EmailAddress:         member.EmailAddress.String(),
+		VerifiedEmailAddress: member.VerifiedEmailAddress,
+		FirstName:            member.Metadata.ProperName.FirstName(),
+		LastName:             member.Metadata.ProperName.LastName(),
+		Verified:             member.Verified,
+		Roles:                []string{},
+		DeletedAt:            member.DeletedAt,
+	}
+
+	if member.Metadata.ProfileImage != nil {
+		export.ProfileImage = member.Metadata.ProfileImage.String()
+	}
+
+	for _, role := range member.Roles {
+		export.Roles = append(export.Roles, string(role))
+	}
+
+	return export
+
+}
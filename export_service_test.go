@@ -0,0 +1,92 @@
+package community_bl
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+)
+
+func TestExportMemberData(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	member := c.signUp("member")
+	c.login(member)
+	application := c.apply(member)
+
+	if _, err := c.RequestInformation(application.ID, "Who invited you?", reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.AddInternalNote(application.ID, "Looks fine", reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	export, err := c.ExportMemberData(member.ID, member.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if export.Member.ID != member.ID.String() || export.Member.EmailAddress != member.EmailAddress.String() {
+		t.Fatalf("expected the member to be exported, got: %v", export.Member)
+	}
+
+	if len(export.Applications) != 1 || export.Applications[0].ApplicationText != application.ApplicationText {
+		t.Fatalf("expected the application to be exported, got: %v", export.Applications)
+	}
+
+	// internal notes of the reviewers are left out
+	if len(export.ApplicationComments) != 1 || export.ApplicationComments[0].Text != "Who invited you?" {
+		t.Fatalf("expected only the question to be exported, got: %v", export.ApplicationComments)
+	}
+
+	if len(export.Logins) != 1 || len(export.ConfirmationCodes) != 1 || export.ConfirmationCodes[0].Purpose != "Login" {
+		t.Fatalf("expected the login to be exported, got: %v %v", export.Logins, export.ConfirmationCodes)
+	}
+
+	archive, err := export.Zip()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(reader.File) != 1 || reader.File[0].Name != "data.json" {
+		t.Fatalf("expected the archive to contain the json export, got: %v", reader.File)
+	}
+
+}
+
+func TestExportMemberDataIsLimitedByRank(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	owner := c.signUp("owner", RoleOwner)
+	admin := c.signUp("admin", RoleAdmin)
+	peer := c.signUp("peer", RoleAdmin)
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	member := c.signUp("member")
+
+	_, err := c.ExportMemberData(member.ID, reviewer.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	// the data of members can only be exported by members that out rank them
+	_, err = c.ExportMemberData(peer.ID, admin.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	_, err = c.ExportMemberData(owner.ID, admin.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	if _, err := c.ExportMemberData(member.ID, admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.ExportMemberData(admin.ID, owner.ID); err != nil {
+		t.Fatal(err)
+	}
+
+}
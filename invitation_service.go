@@ -0,0 +1,200 @@
+package community_bl
+
+import (
+	"errors"
+	"github.com/satori/go.uuid"
+	vo "github.com/214alphadev/community-bl/value_objects"
+	"reflect"
+	"time"
+)
+
+// InvitationPolicy configures who can invite and if invitations are required
+type InvitationPolicy struct {
+	// InviteOnly requires an invitation to sign up
+	InviteOnly bool
+	// VerifiedMembersCanInvite allows all verified members to invite. Otherwise
+	// only members with the members:invite permission can invite.
+	VerifiedMembersCanInvite bool
+}
+
+type InvitationOptions struct {
+	// MaxUses limits how often the invitation can be used. Zero means unlimited.
+	MaxUses      uint
+	ExpiresAt    *time.Time
+	EmailAddress *vo.EmailAddress
+	// SkipVerification verifies invited members on sign up. Only members that
+	// are allowed to review applications can create such invitations.
+	SkipVerification bool
+}
+
+var SignUpErrorInvitationInvalid = errors.New("invitation is invalid")
+var SignUpErrorInvitationEmailAddressMismatch = errors.New("invitation has been issued for another email address")
+
+type invitationService struct {
+	memberRepository     MemberRepository
+	invitationRepository InvitationRepository
+	memberService        *memberService
+	communityService     *communityService
+	invitationPolicy     InvitationPolicy
+}
+
+func (s *invitationService) CreateInvitation(options InvitationOptions, requesterID MemberIdentifier) (InvitationEntity, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return InvitationEntity{}, err
+	}
+
+	if requester == nil {
+		return InvitationEntity{}, errors.New("RequesterNotFound")
+	}
+
+	canInvite := requester.HasPermission(PermissionMembersInvite) || (s.invitationPolicy.VerifiedMembersCanInvite && requester.Verified)
+	if !canInvite {
+		return InvitationEntity{}, AuthorizationErrorInsufficientPermissions
+	}
+
+	if options.SkipVerification && !requester.HasPermission(PermissionApplicationsReview) {
+		return InvitationEntity{}, AuthorizationErrorInsufficientPermissions
+	}
+
+	if options.ExpiresAt != nil && !options.ExpiresAt.After(time.Now()) {
+		return InvitationEntity{}, errors.New("invitation must expire in the future")
+	}
+
+	if options.EmailAddress != nil && reflect.DeepEqual(*options.EmailAddress, vo.EmailAddress{}) {
+		return InvitationEntity{}, errors.New("email address value object was not correct initialized")
+	}
+
+	code, err := vo.InvitationCodeFactory()
+	if err != nil {
+		return InvitationEntity{}, err
+	}
+
+	invitation := InvitationEntity{
+		ID:               uuid.NewV4(),
+		Code:             code,
+		CreatedBy:        requester.ID,
+		CreatedAt:        time.Now(),
+		ExpiresAt:        options.ExpiresAt,
+		MaxUses:          options.MaxUses,
+		EmailAddress:     options.EmailAddress,
+		SkipVerification: options.SkipVerification,
+	}
+
+	if err := s.invitationRepository.Save(invitation); err != nil {
+		return InvitationEntity{}, err
+	}
+
+	return invitation, nil
+
+}
+
+func (s *invitationService) RevokeInvitation(invitationID uuid.UUID, requesterID MemberIdentifier) error {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return err
+	}
+
+	if requester == nil {
+		return errors.New("RequesterNotFound")
+	}
+
+	invitation, err := s.invitationRepository.FetchByID(invitationID)
+	if err != nil {
+		return err
+	}
+
+	if invitation == nil {
+		return errors.New("InvitationDoesNotExist")
+	}
+
+	if invitation.CreatedBy != requester.ID && !requester.HasPermission(PermissionMembersPromote) {
+		return AuthorizationErrorInsufficientPermissions
+	}
+
+	if invitation.RevokedAt != nil {
+		return errors.New("InvitationAlreadyRevoked")
+	}
+
+	now := time.Now()
+	invitation.RevokedAt = &now
+
+	return s.invitationRepository.Save(*invitation)
+
+}
+
+func (s *invitationService) Invitations(memberID MemberIdentifier, requesterID MemberIdentifier) ([]InvitationEntity, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if requester == nil {
+		return nil, errors.New("RequesterNotFound")
+	}
+
+	if requester.ID != memberID && !requester.HasPermission(PermissionMembersRead) {
+		return nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	return s.invitationRepository.FetchByCreator(memberID)
+
+}
+
+func (s *invitationService) SignUpWithInvitation(username vo.Username, emailAddress vo.EmailAddress, metadata MetadataEntity, code vo.InvitationCode) (MemberEntity, error) {
+
+	if reflect.DeepEqual(code, vo.InvitationCode{}) {
+		return MemberEntity{}, errors.New("invitation code value object was not correct initialized")
+	}
+
+	invitation, err := s.invitationRepository.FetchByCode(code)
+	if err != nil {
+		return MemberEntity{}, err
+	}
+
+	if invitation == nil || !invitation.Usable(time.Now()) {
+		return MemberEntity{}, SignUpErrorInvitationInvalid
+	}
+
+	if invitation.EmailAddress != nil && *invitation.EmailAddress != emailAddress {
+		return MemberEntity{}, SignUpErrorInvitationEmailAddressMismatch
+	}
+
+	inviter, err := s.memberRepository.FetchByID(invitation.CreatedBy)
+	if err != nil {
+		return MemberEntity{}, err
+	}
+
+	if inviter == nil || inviter.DeletedAt != nil || standing(*inviter) != nil {
+		return MemberEntity{}, SignUpErrorInvitationInvalid
+	}
+
+	// the inviter might have lost the permission to review applications since the invitation has been created
+	skipVerification := invitation.SkipVerification && inviter.HasPermission(PermissionApplicationsReview)
+
+	member, err := s.memberService.signUp(username, emailAddress, metadata, func(member *MemberEntity) {
+		member.InvitedBy = &inviter.ID
+		member.InvitationID = &invitation.ID
+		member.Verified = skipVerification
+	})
+	if err != nil {
+		return MemberEntity{}, err
+	}
+
+	invitation.Uses++
+	if err := s.invitationRepository.Save(*invitation); err != nil {
+		return MemberEntity{}, err
+	}
+
+	if skipVerification {
+		for _, onApproved := range s.communityService.onApplicationApproved {
+			onApproved(member)
+		}
+	}
+
+	return member, nil
+
+}
@@ -0,0 +1,186 @@
+package community_bl
+
+import (
+	"testing"
+	"time"
+
+	vo "github.com/214alphadev/community-bl/value_objects"
+	"github.com/satori/go.uuid"
+)
+
+func withInvitationPolicy(policy InvitationPolicy) func(dependencies *Dependencies) {
+	return func(dependencies *Dependencies) {
+		dependencies.InvitationPolicy = policy
+	}
+}
+
+// signUpWithInvitation signs up a member with the given username using the invitation
+func (c *testCommunity) signUpWithInvitation(username string, invitation InvitationEntity) (MemberEntity, error) {
+
+	c.t.Helper()
+
+	u, err := vo.NewUsername(username)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	emailAddress, err := vo.NewEmailAddress(username + "@example.com")
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	properName, err := vo.NewProperName("First", "Last")
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	return c.SignUpWithInvitation(u, emailAddress, MetadataEntity{ProperName: properName}, invitation.Code)
+
+}
+
+func TestSignUpWithInvitation(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	reviewer := c.signUp("reviewer", RoleReviewer)
+
+	emailAddress, err := vo.NewEmailAddress("invited@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invitation, err := c.CreateInvitation(InvitationOptions{MaxUses: 1, EmailAddress: &emailAddress, SkipVerification: true}, reviewer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.signUpWithInvitation("other", invitation)
+	if err != SignUpErrorInvitationEmailAddressMismatch {
+		t.Fatalf("expected the invitation to be bound to the email address, got: %v", err)
+	}
+
+	invited, err := c.signUpWithInvitation("invited", invitation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !invited.Verified || invited.InvitedBy == nil || *invited.InvitedBy != reviewer.ID {
+		t.Fatalf("expected the invited member to be verified and invited by the reviewer, got: %v", invited)
+	}
+
+	invitations, err := c.Invitations(reviewer.ID, reviewer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(invitations) != 1 || invitations[0].Uses != 1 {
+		t.Fatalf("expected the invitation to be used once, got: %v", invitations)
+	}
+
+	invitation.EmailAddress = nil
+	_, err = c.signUpWithInvitation("third", invitation)
+	if err != SignUpErrorInvitationInvalid {
+		t.Fatalf("expected the used up invitation to be invalid, got: %v", err)
+	}
+
+}
+
+func TestInviteOnly(t *testing.T) {
+
+	c := newTestCommunity(t, withInvitationPolicy(InvitationPolicy{InviteOnly: true}))
+
+	username, err := vo.NewUsername("member")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	emailAddress, err := vo.NewEmailAddress("member@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	properName, err := vo.NewProperName("First", "Last")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.SignUp(username, emailAddress, MetadataEntity{ProperName: properName})
+	if err != SignUpErrorInvitationRequired {
+		t.Fatalf("expected the sign up to require an invitation, got: %v", err)
+	}
+
+	// the first members of invite only communities are created by the host application
+	admin := MemberEntity{ID: uuid.NewV4(), Roles: []Role{RoleAdmin}, Verified: true, CreatedAt: time.Now()}
+	if err := c.members.Save(admin); err != nil {
+		t.Fatal(err)
+	}
+
+	invitation, err := c.CreateInvitation(InvitationOptions{}, admin.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invited, err := c.signUpWithInvitation("member", invitation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if invited.Verified {
+		t.Fatal("expected the invited member to apply for verification")
+	}
+
+}
+
+func TestCreateInvitationIsLimitedByPermissions(t *testing.T) {
+
+	c := newTestCommunity(t, withInvitationPolicy(InvitationPolicy{VerifiedMembersCanInvite: true}))
+
+	moderator := c.signUp("moderator", RoleModerator)
+	unverified := c.signUp("unverified")
+
+	_, err := c.CreateInvitation(InvitationOptions{}, unverified.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	// only members that can review applications can skip the verification
+	_, err = c.CreateInvitation(InvitationOptions{SkipVerification: true}, moderator.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	expired := time.Now().Add(-time.Minute)
+	_, err = c.CreateInvitation(InvitationOptions{ExpiresAt: &expired}, moderator.ID)
+	expectError(t, err, "invitation must expire in the future")
+
+	if _, err := c.CreateInvitation(InvitationOptions{}, moderator.ID); err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+func TestRevokeInvitation(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	other := c.signUp("other", RoleReviewer)
+
+	invitation, err := c.CreateInvitation(InvitationOptions{}, reviewer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = c.RevokeInvitation(invitation.ID, other.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	if err := c.RevokeInvitation(invitation.ID, reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	err = c.RevokeInvitation(invitation.ID, admin.ID)
+	expectError(t, err, "InvitationAlreadyRevoked")
+
+	_, err = c.signUpWithInvitation("invited", invitation)
+	if err != SignUpErrorInvitationInvalid {
+		t.Fatalf("expected the revoked invitation to be invalid, got: %v", err)
+	}
+
+}
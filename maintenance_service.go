@@ -0,0 +1,191 @@
+package community_bl
+
+import (
+	"time"
+)
+
+// SLAPolicy controls how long applications may wait for a decision
+type SLAPolicy struct {
+	// EscalateAfter is the time a pending application may wait before the members that
+	// manage applications are notified. Zero disables escalations.
+	EscalateAfter time.Duration
+	// ExpireAfter is the time an open application may stay in its state before it expires.
+	// Answering a request for information resets it. Zero disables expiry.
+	ExpireAfter time.Duration
+	// ExpiryReason is recorded as the reason of the transition into the Expired state
+	ExpiryReason string
+}
+
+// DefaultExpiryReason is used if the SLAPolicy doesn't define an ExpiryReason
+const DefaultExpiryReason = "The application hasn't been decided in time"
+
+// MaintenanceReport lists what a maintenance run changed
+type MaintenanceReport struct {
+	Escalated []ApplicationEntity
+	Expired   []ApplicationEntity
+	Erased    []MemberDeletionEntity
+}
+
+type maintenanceService struct {
+	memberRepository      MemberRepository
+	applicationRepository ApplicationRepository
+	transport             Transport
+	slaPolicy             SLAPolicy
+	communityService      *communityService
+	assignmentService     *assignmentService
+	erasureService        *erasureService
+}
+
+// RunMaintenance runs the periodic jobs as of now. It's meant to be called periodically by the host.
+// The report contains the changes made before an error occurred.
+func (s *maintenanceService) RunMaintenance(now time.Time) (MaintenanceReport, error) {
+
+	report := MaintenanceReport{
+		Escalated: []ApplicationEntity{},
+		Expired:   []ApplicationEntity{},
+		Erased:    []MemberDeletionEntity{},
+	}
+
+	if err := s.assignmentService.releaseExpiredClaims(now); err != nil {
+		return report, err
+	}
+
+	expired, err := s.expireStaleApplications(now)
+	report.Expired = expired
+	if err != nil {
+		return report, err
+	}
+
+	escalated, err := s.escalateWaitingApplications(now)
+	report.Escalated = escalated
+	if err != nil {
+		return report, err
+	}
+
+	erased, err := s.erasureService.EraseDueMembers(now)
+	report.Erased = erased
+	if err != nil {
+		return report, err
+	}
+
+	return report, nil
+
+}
+
+// expireStaleApplications moves the open applications that haven't changed their state for more than ExpireAfter into the Expired state
+func (s *maintenanceService) expireStaleApplications(now time.Time) ([]ApplicationEntity, error) {
+
+	expired := []ApplicationEntity{}
+
+	if s.slaPolicy.ExpireAfter <= 0 {
+		return expired, nil
+	}
+
+	reason := s.slaPolicy.ExpiryReason
+	if reason == "" {
+		reason = DefaultExpiryReason
+	}
+
+	deadline := now.Add(-s.slaPolicy.ExpireAfter)
+
+	applications, err := s.fetchAll(ApplicationsQuery{
+		States:             []ApplicationState{ApplicationStatePending, ApplicationStateInformationRequested},
+		TransitionedBefore: &deadline,
+	})
+	if err != nil {
+		return expired, err
+	}
+
+	for _, application := range applications {
+
+		if err := s.communityService.expire(&application, reason, now); err != nil {
+			return expired, err
+		}
+
+		expired = append(expired, application)
+
+	}
+
+	return expired, nil
+
+}
+
+// escalateWaitingApplications notifies the members that manage applications about every pending
+// application submitted more than EscalateAfter ago. Each application is escalated once.
+func (s *maintenanceService) escalateWaitingApplications(now time.Time) ([]ApplicationEntity, error) {
+
+	escalated := []ApplicationEntity{}
+
+	if s.slaPolicy.EscalateAfter <= 0 {
+		return escalated, nil
+	}
+
+	deadline := now.Add(-s.slaPolicy.EscalateAfter)
+	notEscalated := false
+
+	applications, err := s.fetchAll(ApplicationsQuery{
+		States:        []ApplicationState{ApplicationStatePending},
+		CreatedBefore: &deadline,
+		Escalated:     &notEscalated,
+	})
+	if err != nil {
+		return escalated, err
+	}
+
+	if len(applications) == 0 {
+		return escalated, nil
+	}
+
+	managers, err := membersWithPermission(s.memberRepository, PermissionApplicationsManage)
+	if err != nil {
+		return escalated, err
+	}
+
+	for _, application := range applications {
+
+		for _, manager := range managers {
+			if err := s.transport.SendApplicationEscalationNotification(manager, application); err != nil {
+				return escalated, err
+			}
+		}
+
+		escalatedAt := now
+		application.EscalatedAt = &escalatedAt
+		if err := s.applicationRepository.Save(application); err != nil {
+			return escalated, err
+		}
+
+		escalated = append(escalated, application)
+
+	}
+
+	return escalated, nil
+
+}
+
+func (s *maintenanceService) fetchAll(query ApplicationsQuery) ([]ApplicationEntity, error) {
+
+	all := []ApplicationEntity{}
+	var after *ApplicationsCursor
+
+	for {
+
+		applications, err := s.applicationRepository.FetchByQuery(query, after, MaxApplicationsQueryNext)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, applications...)
+
+		if len(applications) < MaxApplicationsQueryNext {
+			break
+		}
+
+		last := applications[len(applications)-1]
+		after = &ApplicationsCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+
+	}
+
+	return all, nil
+
+}
@@ -0,0 +1,109 @@
+package community_bl
+
+import (
+	"testing"
+	"time"
+)
+
+func withSLAPolicy(policy SLAPolicy) func(dependencies *Dependencies) {
+	return func(dependencies *Dependencies) {
+		dependencies.SLAPolicy = policy
+	}
+}
+
+func TestMaintenanceEscalatesWaitingApplications(t *testing.T) {
+
+	c := newTestCommunity(t, withSLAPolicy(SLAPolicy{EscalateAfter: 48 * time.Hour}))
+
+	admin := c.signUp("admin", RoleAdmin)
+	c.signUp("reviewer", RoleReviewer)
+	application := c.apply(c.signUp("applicant"))
+
+	report, err := c.RunMaintenance(time.Now().Add(24 * time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Escalated) != 0 || len(c.transport.escalationRecipients) != 0 {
+		t.Fatalf("expected nothing to be escalated before the deadline, got: %+v", report.Escalated)
+	}
+
+	report, err = c.RunMaintenance(time.Now().Add(72 * time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Escalated) != 1 || report.Escalated[0].ID != application.ID {
+		t.Fatalf("expected the waiting application to be escalated, got: %+v", report.Escalated)
+	}
+
+	if recipients := c.transport.escalationRecipients; len(recipients) != 1 || recipients[0] != admin.ID {
+		t.Fatalf("expected only the admin to be notified, got: %v", recipients)
+	}
+
+	if c.application(application.ID).EscalatedAt == nil {
+		t.Fatal("expected the escalation to be saved")
+	}
+
+	report, err = c.RunMaintenance(time.Now().Add(96 * time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Escalated) != 0 || len(c.transport.escalationRecipients) != 1 {
+		t.Fatalf("expected the application to be escalated once, got: %+v", report.Escalated)
+	}
+
+}
+
+func TestMaintenanceExpiresStaleApplications(t *testing.T) {
+
+	c := newTestCommunity(t, withSLAPolicy(SLAPolicy{ExpireAfter: 30 * 24 * time.Hour}))
+
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	applicant := c.signUp("applicant")
+	stale := c.apply(applicant)
+	decided := c.apply(c.signUp("decided"))
+
+	if err := c.ApproveApplication(decided.ID, reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	expired := []ApplicationID{}
+	c.OnApplicationExpired(func(application ApplicationEntity) {
+		expired = append(expired, application.ID)
+	})
+
+	report, err := c.RunMaintenance(time.Now().Add(31 * 24 * time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Expired) != 1 || report.Expired[0].ID != stale.ID {
+		t.Fatalf("expected only the open application to expire, got: %+v", report.Expired)
+	}
+
+	if len(expired) != 1 || expired[0] != stale.ID {
+		t.Fatalf("expected the expiry to be announced, got: %v", expired)
+	}
+
+	application := c.application(stale.ID)
+	if application.State != ApplicationStateExpired || application.ExpiredAt == nil {
+		t.Fatalf("expected the application to be expired, got: %s", application.State)
+	}
+
+	history, err := c.ApplicationHistory(applicant.ID, applicant.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transitions := history[0].Transitions
+	if last := transitions[len(transitions)-1]; last.Actor != nil || last.Reason != DefaultExpiryReason {
+		t.Fatalf("expected the community to expire the application with the default reason, got: %+v", last)
+	}
+
+	if _, err := c.ApplyForVerification("I would like to join again", applicant.ID); err != nil {
+		t.Fatalf("expected the member to be able to apply again, got: %s", err.Error())
+	}
+
+}
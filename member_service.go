@@ -10,12 +10,36 @@ import (
 )
 
 type memberService struct {
-	onLogin []func(member MemberEntity)
+	onLogin                         []func(member MemberEntity)
+	onSignUp                        []func(member MemberEntity)
+	onProfileChanged                []func(member MemberEntity)
 	memberRepository                MemberRepository
 	confirmationCodeRepository      ConfirmationCodeRepository
 	transport                       Transport
 	memberAccessPublicKeyRepository MemberAccessPublicKeyRepository
 	accessTokenService              *accessTokenService
+	usernameChangeRepository        UsernameChangeRepository
+	loginRepository                 LoginRepository
+	sanctionRepository              SanctionRepository
+	usernamePolicy                  UsernamePolicy
+	invitationPolicy                InvitationPolicy
+}
+
+// UsernamePolicy configures how members can change their username.
+// A zero duration disables the rule.
+type UsernamePolicy struct {
+	// ChangeCoolDown is the time a member has to wait between two username changes
+	ChangeCoolDown time.Duration
+	// ReservationPeriod is the time an old username can't be claimed by another member
+	ReservationPeriod time.Duration
+}
+
+type ChangeUsernameCoolDownError struct {
+	TryAgainAt int64
+}
+
+func (e ChangeUsernameCoolDownError) Error() string {
+	return fmt.Sprintf("please retry to change the username at: %d", e.TryAgainAt)
 }
 
 type RequestLoginCoolDownError struct {
@@ -26,8 +50,21 @@ func (e RequestLoginCoolDownError) Error() string {
 	return fmt.Sprintf("please retry to request the login at: %d", e.TryAgainAt)
 }
 
+var SignUpErrorInvitationRequired = errors.New("sign up requires an invitation")
+
 func (s *memberService) SignUp(username vo.Username, emailAddress vo.EmailAddress, metadata MetadataEntity) (MemberEntity, error) {
 
+	if s.invitationPolicy.InviteOnly {
+		return MemberEntity{}, SignUpErrorInvitationRequired
+	}
+
+	return s.signUp(username, emailAddress, metadata, nil)
+
+}
+
+// signUp creates the member. prepare can be used to modify the member before it's saved.
+func (s *memberService) signUp(username vo.Username, emailAddress vo.EmailAddress, metadata MetadataEntity, prepare func(member *MemberEntity)) (MemberEntity, error) {
+
 	if reflect.DeepEqual(metadata, MetadataEntity{}) {
 		return MemberEntity{}, errors.New("received empty metadata")
 	}
@@ -44,6 +81,14 @@ func (s *memberService) SignUp(username vo.Username, emailAddress vo.EmailAddres
 		return MemberEntity{}, errors.New("UsernameTaken")
 	}
 
+	reserved, err := s.isUsernameReserved(username, uuid.UUID{})
+	if err != nil {
+		return MemberEntity{}, err
+	}
+	if reserved {
+		return MemberEntity{}, errors.New("UsernameReserved")
+	}
+
 	if reflect.DeepEqual(emailAddress, vo.EmailAddress{}) {
 		return MemberEntity{}, errors.New("email address value object was not correct initialized")
 	}
@@ -56,6 +101,14 @@ func (s *memberService) SignUp(username vo.Username, emailAddress vo.EmailAddres
 		return MemberEntity{}, errors.New("EmailAddressTaken")
 	}
 
+	banned, err := s.sanctionRepository.IsEmailAddressBanned(emailAddress)
+	if err != nil {
+		return MemberEntity{}, err
+	}
+	if banned {
+		return MemberEntity{}, SignUpErrorEmailAddressBanned
+	}
+
 	member := MemberEntity{
 		ID:           uuid.NewV4(),
 		EmailAddress: emailAddress,
@@ -64,10 +117,18 @@ func (s *memberService) SignUp(username vo.Username, emailAddress vo.EmailAddres
 		CreatedAt:    time.Now(),
 	}
 
+	if prepare != nil {
+		prepare(&member)
+	}
+
 	if err := s.memberRepository.Save(member); err != nil {
 		return MemberEntity{}, err
 	}
 
+	for _, onSignUp := range s.onSignUp {
+		onSignUp(member)
+	}
+
 	return member, nil
 
 }
@@ -82,6 +143,14 @@ func (s *memberService) RequestLogin(emailAddress vo.EmailAddress) error {
 		return errors.New("couldn't find member")
 	}
 
+	if member.DeletedAt != nil {
+		return MemberErrorDeleted
+	}
+
+	if err := standing(*member); err != nil {
+		return err
+	}
+
 	lastConfirmationCode, err := s.confirmationCodeRepository.Last(emailAddress)
 	if err != nil {
 		return err
@@ -95,6 +164,39 @@ func (s *memberService) RequestLogin(emailAddress vo.EmailAddress) error {
 		}
 	}
 
+	return s.sendConfirmationCode(*member)
+
+}
+
+// ResendConfirmationCode sends a new confirmation code without respecting the request login cool down
+func (s *memberService) ResendConfirmationCode(emailAddress vo.EmailAddress, requesterID MemberIdentifier) error {
+
+	member, err := s.memberRepository.FetchByEmailAddress(emailAddress)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return errors.New("couldn't find member")
+	}
+
+	if err := s.authorizeAdministration(*member, requesterID, PermissionMembersModerate); err != nil {
+		return err
+	}
+
+	if member.DeletedAt != nil {
+		return MemberErrorDeleted
+	}
+
+	if err := standing(*member); err != nil {
+		return err
+	}
+
+	return s.sendConfirmationCode(*member)
+
+}
+
+func (s *memberService) sendConfirmationCode(member MemberEntity) error {
+
 	code, err := vo.ConfirmationCodeFactory()
 	if err != nil {
 		return err
@@ -102,7 +204,7 @@ func (s *memberService) RequestLogin(emailAddress vo.EmailAddress) error {
 
 	confirmationCode := &ConfirmationCode{
 		ID:               uuid.NewV4(),
-		EmailAddress:     emailAddress,
+		EmailAddress:     member.EmailAddress,
 		ConfirmationCode: code,
 		IssuedAt:         time.Now().Unix(),
 		MemberIdentifier: member.ID,
@@ -115,6 +217,44 @@ func (s *memberService) RequestLogin(emailAddress vo.EmailAddress) error {
 
 }
 
+// InvalidateConfirmationCodes marks all unused confirmation codes of the member as used
+func (s *memberService) InvalidateConfirmationCodes(emailAddress vo.EmailAddress, requesterID MemberIdentifier) error {
+
+	member, err := s.memberRepository.FetchByEmailAddress(emailAddress)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return errors.New("couldn't find member")
+	}
+
+	if err := s.authorizeAdministration(*member, requesterID, PermissionMembersModerate); err != nil {
+		return err
+	}
+
+	return s.invalidateConfirmationCodes(emailAddress)
+
+}
+
+// invalidateConfirmationCodes marks all unused confirmation codes of the email address as used
+func (s *memberService) invalidateConfirmationCodes(emailAddress vo.EmailAddress) error {
+
+	confirmationCodes, err := s.confirmationCodeRepository.Unused(emailAddress)
+	if err != nil {
+		return err
+	}
+
+	for _, confirmationCode := range confirmationCodes {
+		confirmationCode.Used = true
+		if err := s.confirmationCodeRepository.Save(confirmationCode); err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
+
 var LoginErrorConfirmationCodeNotFound = errors.New("confirmation code doesn't exist")
 var LoginErrorConfirmationCodeExpired = errors.New("confirmation code expired")
 var LoginErrorConfirmationCodeAlreadyUsed = errors.New("confirmation code already used")
@@ -152,6 +292,10 @@ func (s *memberService) Login(emailAddress vo.EmailAddress, memberAccessPublicKe
 		return MemberAccessTokenEntity{}, LoginErrorConfirmationCodeAlreadyUsed
 	}
 
+	if cc.Purpose != ConfirmationCodePurposeLogin {
+		return MemberAccessTokenEntity{}, LoginErrorConfirmationCodeNotFound
+	}
+
 	used, err := s.memberAccessPublicKeyRepository.AlreadyUsed(memberAccessPublicKey)
 	if err != nil {
 		return MemberAccessTokenEntity{}, err
@@ -160,23 +304,33 @@ func (s *memberService) Login(emailAddress vo.EmailAddress, memberAccessPublicKe
 		return MemberAccessTokenEntity{}, LoginErrorMemberAccessKeyHasAlreadyBeenUsed
 	}
 
-	cc.Used = true
-	if err := s.confirmationCodeRepository.Save(cc); err != nil {
-		return MemberAccessTokenEntity{}, err
-	}
-
 	member, err := s.memberRepository.FetchByEmailAddress(emailAddress)
 	if err != nil {
-		return MemberAccessTokenEntity{}, nil
+		return MemberAccessTokenEntity{}, err
 	}
 	if member == nil {
 		return MemberAccessTokenEntity{}, errors.New("couldn't find member")
 	}
 
+	// the code is only consumed by members that are allowed to log in, so a lifted
+	// sanction doesn't require a new code
+	if member.DeletedAt != nil {
+		return MemberAccessTokenEntity{}, MemberErrorDeleted
+	}
+
+	if err := standing(*member); err != nil {
+		return MemberAccessTokenEntity{}, err
+	}
+
 	if cc.MemberIdentifier != member.ID {
 		return MemberAccessTokenEntity{}, LoginErrorConfirmationCodeMemberMismatch
 	}
 
+	cc.Used = true
+	if err := s.confirmationCodeRepository.Save(cc); err != nil {
+		return MemberAccessTokenEntity{}, err
+	}
+
 	signedAccessToken, err := s.accessTokenService.New(*member)
 	if err != nil {
 		return MemberAccessTokenEntity{}, err
@@ -197,6 +351,17 @@ func (s *memberService) Login(emailAddress vo.EmailAddress, memberAccessPublicKe
 		return MemberAccessTokenEntity{}, err
 	}
 
+	login := LoginEntity{
+		ID:                    uuid.NewV4(),
+		MemberID:              member.ID,
+		AccessTokenID:         accessToken.ID,
+		MemberAccessPublicKey: memberAccessPublicKey,
+		LoggedInAt:            time.Now(),
+	}
+	if err := s.loginRepository.Save(login); err != nil {
+		return MemberAccessTokenEntity{}, err
+	}
+
 	for _, onLogin := range s.onLogin {
 		onLogin(*member)
 	}
@@ -205,7 +370,189 @@ func (s *memberService) Login(emailAddress vo.EmailAddress, memberAccessPublicKe
 
 }
 
+type RequestEmailChangeCoolDownError struct {
+	TryAgainAt int64
+}
+
+func (e RequestEmailChangeCoolDownError) Error() string {
+	return fmt.Sprintf("please retry to request the email change at: %d", e.TryAgainAt)
+}
+
+func (s *memberService) RequestEmailChange(memberID MemberIdentifier, newEmailAddress vo.EmailAddress) error {
+
+	if reflect.DeepEqual(newEmailAddress, vo.EmailAddress{}) {
+		return errors.New("email address value object was not correct initialized")
+	}
+
+	member, err := s.memberRepository.FetchByID(memberID)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return errors.New("MemberDoesNotExist")
+	}
+
+	if member.EmailAddress == newEmailAddress {
+		return errors.New("EmailAddressUnchanged")
+	}
+
+	if err := s.emailChangeAllowed(*member, newEmailAddress); err != nil {
+		return err
+	}
+
+	lastConfirmationCode, err := s.confirmationCodeRepository.Last(newEmailAddress)
+	if err != nil {
+		return err
+	}
+
+	if lastConfirmationCode != nil {
+		if lastConfirmationCode.IssuedAt+120 >= time.Now().Unix() {
+			return RequestEmailChangeCoolDownError{
+				TryAgainAt: lastConfirmationCode.IssuedAt + 120,
+			}
+		}
+	}
+
+	if err := s.transport.SendEmailAddressChangeRequestedNotification(*member, newEmailAddress); err != nil {
+		return err
+	}
+
+	code, err := vo.ConfirmationCodeFactory()
+	if err != nil {
+		return err
+	}
+
+	confirmationCode := &ConfirmationCode{
+		ID:               uuid.NewV4(),
+		EmailAddress:     newEmailAddress,
+		ConfirmationCode: code,
+		IssuedAt:         time.Now().Unix(),
+		MemberIdentifier: member.ID,
+		Purpose:          ConfirmationCodePurposeEmailChange,
+	}
+	if err := s.confirmationCodeRepository.Save(confirmationCode); err != nil {
+		return err
+	}
+
+	return s.transport.SendConfirmationCode(*confirmationCode)
+
+}
+
+var EmailChangeErrorEmailAddressBanned = errors.New("email address is banned")
+
+// emailChangeAllowed checks that the member is in good standing and that the new address is available
+func (s *memberService) emailChangeAllowed(member MemberEntity, newEmailAddress vo.EmailAddress) error {
+
+	if member.DeletedAt != nil {
+		return MemberErrorDeleted
+	}
+
+	if err := standing(member); err != nil {
+		return err
+	}
+
+	taken, err := s.memberRepository.IsEmailAddressTaken(newEmailAddress)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return errors.New("EmailAddressTaken")
+	}
+
+	banned, err := s.sanctionRepository.IsEmailAddressBanned(newEmailAddress)
+	if err != nil {
+		return err
+	}
+	if banned {
+		return EmailChangeErrorEmailAddressBanned
+	}
+
+	return nil
+
+}
+
+var EmailChangeErrorConfirmationCodeNotFound = errors.New("confirmation code doesn't exist")
+var EmailChangeErrorConfirmationCodeExpired = errors.New("confirmation code expired")
+var EmailChangeErrorConfirmationCodeAlreadyUsed = errors.New("confirmation code already used")
+var EmailChangeErrorConfirmationCodeMemberMismatch = errors.New("member miss match - please try again")
+
+func (s *memberService) ConfirmEmailChange(memberID MemberIdentifier, newEmailAddress vo.EmailAddress, confirmationCode vo.ConfirmationCode) (MemberEntity, error) {
+
+	if reflect.DeepEqual(newEmailAddress, vo.EmailAddress{}) {
+		return MemberEntity{}, errors.New("email address value object was not correct initialized")
+	}
+
+	if reflect.DeepEqual(confirmationCode, vo.ConfirmationCode{}) {
+		return MemberEntity{}, errors.New("confirmation code is not a correctly initialized value object")
+	}
+
+	cc, err := s.confirmationCodeRepository.Fetch(newEmailAddress, confirmationCode)
+	if err != nil {
+		return MemberEntity{}, err
+	}
+
+	if cc == nil || cc.Purpose != ConfirmationCodePurposeEmailChange {
+		return MemberEntity{}, EmailChangeErrorConfirmationCodeNotFound
+	}
+
+	if cc.Expired() {
+		return MemberEntity{}, EmailChangeErrorConfirmationCodeExpired
+	}
+
+	if cc.Used {
+		return MemberEntity{}, EmailChangeErrorConfirmationCodeAlreadyUsed
+	}
+
+	if cc.MemberIdentifier != memberID {
+		return MemberEntity{}, EmailChangeErrorConfirmationCodeMemberMismatch
+	}
+
+	member, err := s.memberRepository.FetchByID(memberID)
+	if err != nil {
+		return MemberEntity{}, err
+	}
+	if member == nil {
+		return MemberEntity{}, errors.New("MemberDoesNotExist")
+	}
+
+	// the member might have been sanctioned or the address claimed or banned since the change has been requested
+	if err := s.emailChangeAllowed(*member, newEmailAddress); err != nil {
+		return MemberEntity{}, err
+	}
+
+	cc.Used = true
+	if err := s.confirmationCodeRepository.Save(cc); err != nil {
+		return MemberEntity{}, err
+	}
+
+	oldEmailAddress := member.EmailAddress
+
+	if err := s.invalidateConfirmationCodes(oldEmailAddress); err != nil {
+		return MemberEntity{}, err
+	}
+
+	// sessions opened with the old address end with the change
+	if err := s.revokeAccessTokens(member.ID); err != nil {
+		return MemberEntity{}, err
+	}
+
+	member.EmailAddress = newEmailAddress
+	member.VerifiedEmailAddress = true
+	member.AccessTokenID = nil
+	if err := s.memberRepository.Save(*member); err != nil {
+		return MemberEntity{}, err
+	}
+
+	// the address has been changed at this point, a failed notice must not report the change as failed.
+	// The old address has already been notified when the change was requested.
+	_ = s.transport.SendEmailAddressChangedNotification(oldEmailAddress, *member)
+
+	return *member, nil
+
+}
+
 var GetMemberByAccessTokenErrorNoMember = errors.New("couldn't get member from access token")
+var GetMemberByAccessTokenErrorRevoked = errors.New("access token has been revoked")
 
 func (s *memberService) GetByAccessToken(accessToken string) (MemberEntity, error) {
 
@@ -223,16 +570,111 @@ func (s *memberService) GetByAccessToken(accessToken string) (MemberEntity, erro
 		return MemberEntity{}, GetMemberByAccessTokenErrorNoMember
 	}
 
+	if member.DeletedAt != nil {
+		return MemberEntity{}, MemberErrorDeleted
+	}
+
+	if err := standing(*member); err != nil {
+		return MemberEntity{}, err
+	}
+
+	storedAccessToken, err := s.accessTokenService.accessTokenRepository.FetchByID(fetchedAccessToken.ID)
+	if err != nil {
+		return MemberEntity{}, err
+	}
+
+	if storedAccessToken == nil || storedAccessToken.RevokedAt != nil {
+		return MemberEntity{}, GetMemberByAccessTokenErrorRevoked
+	}
+
 	return *member, nil
 
 }
 
+// RevokeAccessToken revokes all access tokens of the member. Members can revoke their own access tokens,
+// everyone else needs the members:moderate permission and has to out rank the member.
+func (s *memberService) RevokeAccessToken(memberID MemberIdentifier, requesterID MemberIdentifier) error {
+
+	member, err := s.memberRepository.FetchByID(memberID)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return errors.New("MemberDoesNotExist")
+	}
+
+	if member.ID != requesterID {
+		if err := s.authorizeAdministration(*member, requesterID, PermissionMembersModerate); err != nil {
+			return err
+		}
+	}
+
+	if err := s.revokeAccessTokens(member.ID); err != nil {
+		return err
+	}
+
+	member.AccessTokenID = nil
+
+	return s.memberRepository.Save(*member)
+
+}
+
+// revokeAccessTokens revokes every access token of the member that hasn't expired yet
+func (s *memberService) revokeAccessTokens(memberID MemberIdentifier) error {
+
+	accessTokens, err := s.accessTokenService.accessTokenRepository.FetchByMember(memberID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for _, accessToken := range accessTokens {
+
+		if accessToken.RevokedAt != nil {
+			continue
+		}
+
+		accessToken.RevokedAt = &now
+		if err := s.accessTokenService.accessTokenRepository.Save(&accessToken); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+
+}
+
+// authorizeAdministration makes sure that the requester has the permission and out ranks the member
+func (s *memberService) authorizeAdministration(member MemberEntity, requesterID MemberIdentifier, permission Permission) error {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return err
+	}
+
+	if requester == nil {
+		return errors.New("RequesterNotFound")
+	}
+
+	if !requester.HasPermission(permission) || member.ID == requester.ID || member.rank() >= requester.rank() {
+		return AuthorizationErrorInsufficientPermissions
+	}
+
+	return nil
+
+}
+
 func (s memberService) GetMemberByID(id MemberIdentifier) (MemberEntity, error) {
 
 	member, err := s.memberRepository.FetchByID(id)
 
 	switch err {
 	case nil:
+		if member == nil {
+			return MemberEntity{}, errors.New("MemberDoesNotExist")
+		}
 		return *member, nil
 	default:
 		return MemberEntity{}, err
@@ -240,6 +682,367 @@ func (s memberService) GetMemberByID(id MemberIdentifier) (MemberEntity, error)
 
 }
 
+func (s *memberService) UpdateProfile(memberID MemberIdentifier, changes ProfileChanges, requesterID MemberIdentifier) (MemberEntity, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return MemberEntity{}, err
+	}
+
+	if requester == nil {
+		return MemberEntity{}, errors.New("RequesterNotFound")
+	}
+
+	if requester.ID != memberID && !requester.HasPermission(PermissionMembersEdit) {
+		return MemberEntity{}, AuthorizationErrorInsufficientPermissions
+	}
+
+	member, err := s.memberRepository.FetchByID(memberID)
+	if err != nil {
+		return MemberEntity{}, err
+	}
+
+	if member == nil {
+		return MemberEntity{}, errors.New("MemberDoesNotExist")
+	}
+
+	// editors can only edit members they out rank
+	if requester.ID != member.ID && member.rank() >= requester.rank() {
+		return MemberEntity{}, AuthorizationErrorInsufficientPermissions
+	}
+
+	if member.DeletedAt != nil || member.Erased {
+		return MemberEntity{}, MemberErrorDeleted
+	}
+
+	if reflect.DeepEqual(changes, ProfileChanges{}) {
+		return MemberEntity{}, errors.New("received empty profile changes")
+	}
+
+	if changes.ProfileImage != nil && changes.RemoveProfileImage {
+		return MemberEntity{}, errors.New("can't set and remove the profile image at the same time")
+	}
+
+	if changes.ProperName != nil {
+		if reflect.DeepEqual(*changes.ProperName, vo.ProperName{}) {
+			return MemberEntity{}, errors.New("proper name value object was not correct initialized")
+		}
+		member.Metadata.ProperName = *changes.ProperName
+	}
+
+	if changes.ProfileImage != nil {
+		if reflect.DeepEqual(*changes.ProfileImage, vo.Base64String{}) {
+			return MemberEntity{}, errors.New("profile image value object was not correct initialized")
+		}
+		member.Metadata.ProfileImage = changes.ProfileImage
+	}
+
+	if changes.RemoveProfileImage {
+		member.Metadata.ProfileImage = nil
+	}
+
+	if err := s.memberRepository.Save(*member); err != nil {
+		return MemberEntity{}, err
+	}
+
+	for _, onProfileChanged := range s.onProfileChanged {
+		onProfileChanged(*member)
+	}
+
+	return *member, nil
+
+}
+
+func (s *memberService) ChangeUsername(memberID MemberIdentifier, username vo.Username, requesterID MemberIdentifier) (MemberEntity, error) {
+
+	if reflect.DeepEqual(username, vo.Username{}) {
+		return MemberEntity{}, errors.New("username value object was not correct initialized")
+	}
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return MemberEntity{}, err
+	}
+
+	if requester == nil {
+		return MemberEntity{}, errors.New("RequesterNotFound")
+	}
+
+	if requester.ID != memberID && !requester.HasPermission(PermissionMembersEdit) {
+		return MemberEntity{}, AuthorizationErrorInsufficientPermissions
+	}
+
+	member, err := s.memberRepository.FetchByID(memberID)
+	if err != nil {
+		return MemberEntity{}, err
+	}
+
+	if member == nil {
+		return MemberEntity{}, errors.New("MemberDoesNotExist")
+	}
+
+	// editors can only rename members they out rank
+	if requester.ID != member.ID && member.rank() >= requester.rank() {
+		return MemberEntity{}, AuthorizationErrorInsufficientPermissions
+	}
+
+	if member.DeletedAt != nil || member.Erased {
+		return MemberEntity{}, MemberErrorDeleted
+	}
+
+	if member.Username == username {
+		return MemberEntity{}, errors.New("UsernameUnchanged")
+	}
+
+	// members editing other members are not bound to the cool down
+	if requester.ID == member.ID && s.usernamePolicy.ChangeCoolDown > 0 {
+		lastChange, err := s.usernameChangeRepository.FetchLastByMember(member.ID)
+		if err != nil {
+			return MemberEntity{}, err
+		}
+		if lastChange != nil {
+			tryAgainAt := lastChange.ChangedAt.Add(s.usernamePolicy.ChangeCoolDown)
+			if time.Now().Before(tryAgainAt) {
+				return MemberEntity{}, ChangeUsernameCoolDownError{
+					TryAgainAt: tryAgainAt.Unix(),
+				}
+			}
+		}
+	}
+
+	taken, err := s.memberRepository.IsUsernameTaken(username)
+	if err != nil {
+		return MemberEntity{}, err
+	}
+	if taken {
+		return MemberEntity{}, errors.New("UsernameTaken")
+	}
+
+	reserved, err := s.isUsernameReserved(username, member.ID)
+	if err != nil {
+		return MemberEntity{}, err
+	}
+	if reserved {
+		return MemberEntity{}, errors.New("UsernameReserved")
+	}
+
+	change := UsernameChangeEntity{
+		ID:          uuid.NewV4(),
+		MemberID:    member.ID,
+		OldUsername: member.Username,
+		NewUsername: username,
+		ChangedAt:   time.Now(),
+	}
+	if err := s.usernameChangeRepository.Save(change); err != nil {
+		return MemberEntity{}, err
+	}
+
+	member.Username = username
+	if err := s.memberRepository.Save(*member); err != nil {
+		return MemberEntity{}, err
+	}
+
+	for _, onProfileChanged := range s.onProfileChanged {
+		onProfileChanged(*member)
+	}
+
+	return *member, nil
+
+}
+
+// isUsernameReserved checks if the username has recently been given up by another member than the claimant
+func (s *memberService) isUsernameReserved(username vo.Username, claimant MemberIdentifier) (bool, error) {
+
+	if s.usernamePolicy.ReservationPeriod <= 0 {
+		return false, nil
+	}
+
+	lastChange, err := s.usernameChangeRepository.FetchLastByOldUsername(username)
+	if err != nil {
+		return false, err
+	}
+
+	if lastChange == nil || lastChange.MemberID == claimant {
+		return false, nil
+	}
+
+	return time.Now().Before(lastChange.ChangedAt.Add(s.usernamePolicy.ReservationPeriod)), nil
+
+}
+
+func (s *memberService) UsernameHistory(memberID MemberIdentifier) ([]UsernameChangeEntity, error) {
+	return s.usernameChangeRepository.FetchByMember(memberID)
+}
+
+// ResolveUsername returns the member that currently has the username or,
+// if nobody has it, the member that had it most recently.
+func (s *memberService) ResolveUsername(username vo.Username) (MemberEntity, error) {
+
+	member, err := s.memberRepository.FetchByUsername(username)
+	if err != nil {
+		return MemberEntity{}, err
+	}
+
+	if member != nil {
+		return *member, nil
+	}
+
+	lastChange, err := s.usernameChangeRepository.FetchLastByOldUsername(username)
+	if err != nil {
+		return MemberEntity{}, err
+	}
+
+	if lastChange == nil {
+		return MemberEntity{}, errors.New("MemberDoesNotExist")
+	}
+
+	return s.GetMemberByID(lastChange.MemberID)
+
+}
+
+// MaxMembersQueryNext is the maximum amount of members a single query returns
+const MaxMembersQueryNext = 100
+
+func (s *memberService) Members(query MembersQuery, requesterID MemberIdentifier) ([]MemberEntity, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if requester == nil {
+		return nil, errors.New("MemberDoesNotExist")
+	}
+
+	if !requester.HasPermission(PermissionMembersRead) {
+		return nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	if query.Sort == "" {
+		query.Sort = MembersQuerySortCreatedAt
+	}
+
+	if !query.Sort.Valid() {
+		return nil, fmt.Errorf("members query sort: '%s' is invalid", query.Sort)
+	}
+
+	if query.Role != nil && !query.Role.Valid() {
+		return nil, fmt.Errorf("role: '%s' is invalid", *query.Role)
+	}
+
+	if query.Next == 0 || query.Next > MaxMembersQueryNext {
+		query.Next = MaxMembersQueryNext
+	}
+
+	return s.memberRepository.FetchByQuery(query)
+
+}
+
+// Directory lists verified members to verified members. Filters on private data are ignored.
+func (s *memberService) Directory(query MembersQuery, requesterID MemberIdentifier) ([]DirectoryEntry, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if requester == nil {
+		return nil, errors.New("MemberDoesNotExist")
+	}
+
+	if !requester.Verified {
+		return nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	if query.Sort == "" {
+		query.Sort = MembersQuerySortCreatedAt
+	}
+
+	if !query.Sort.Valid() {
+		return nil, fmt.Errorf("members query sort: '%s' is invalid", query.Sort)
+	}
+
+	verified := true
+	banned := false
+	query.Verified = &verified
+	query.Banned = &banned
+	query.VerifiedEmailAddress = nil
+	query.IncludeDeleted = false
+
+	// filtering by these would reveal data the directory doesn't contain
+	if !requester.HasPermission(PermissionMembersRead) {
+		query.InvitedBy = nil
+		query.Applied = nil
+		query.Role = nil
+	}
+
+	if query.Role != nil && !query.Role.Valid() {
+		return nil, fmt.Errorf("role: '%s' is invalid", *query.Role)
+	}
+
+	if query.Next == 0 || query.Next > MaxMembersQueryNext {
+		query.Next = MaxMembersQueryNext
+	}
+
+	members, err := s.memberRepository.FetchByQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]DirectoryEntry, 0, len(members))
+	for _, member := range members {
+		entries = append(entries, DirectoryEntry{
+			ID:          member.ID,
+			Username:    member.Username,
+			Metadata:    member.Metadata,
+			Verified:    member.Verified,
+			Roles:       member.Roles,
+			MemberSince: member.CreatedAt,
+		})
+	}
+
+	return entries, nil
+
+}
+
+func (s memberService) GetMemberByEmailAddress(emailAddress vo.EmailAddress) (MemberEntity, error) {
+
+	member, err := s.memberRepository.FetchByEmailAddress(emailAddress)
+	if err != nil {
+		return MemberEntity{}, err
+	}
+
+	if member == nil {
+		return MemberEntity{}, errors.New("MemberDoesNotExist")
+	}
+
+	return *member, nil
+
+}
+
+func (s memberService) GetMemberByUsername(username vo.Username) (MemberEntity, error) {
+
+	member, err := s.memberRepository.FetchByUsername(username)
+	if err != nil {
+		return MemberEntity{}, err
+	}
+
+	if member == nil {
+		return MemberEntity{}, errors.New("MemberDoesNotExist")
+	}
+
+	return *member, nil
+
+}
+
 func (s *memberService) OnLogin(cb func(member MemberEntity)) {
 	s.onLogin = append(s.onLogin, cb)
 }
+
+func (s *memberService) OnSignUp(cb func(member MemberEntity)) {
+	s.onSignUp = append(s.onSignUp, cb)
+}
+
+func (s *memberService) OnProfileChanged(cb func(member MemberEntity)) {
+	s.onProfileChanged = append(s.onProfileChanged, cb)
+}
@@ -0,0 +1,303 @@
+package community_bl
+
+import (
+	"testing"
+	"time"
+
+	vo "github.com/214alphadev/community-bl/value_objects"
+)
+
+func TestUpdateProfile(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	peer := c.signUp("peer", RoleAdmin)
+	moderator := c.signUp("moderator", RoleModerator)
+	member := c.signUp("member")
+	other := c.signUp("other")
+
+	properName, err := vo.NewProperName("New", "Name")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changes := ProfileChanges{ProperName: &properName}
+
+	changed := []MemberIdentifier{}
+	c.OnProfileChanged(func(member MemberEntity) {
+		changed = append(changed, member.ID)
+	})
+
+	updated, err := c.UpdateProfile(member.ID, changes, member.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updated.Metadata.ProperName != properName || c.member(member.ID).Metadata.ProperName != properName {
+		t.Fatalf("expected the proper name to be changed, got: %v", updated.Metadata.ProperName)
+	}
+
+	if len(changed) != 1 || changed[0] != member.ID {
+		t.Fatalf("expected the profile change to be reported, got: %v", changed)
+	}
+
+	_, err = c.UpdateProfile(member.ID, ProfileChanges{}, member.ID)
+	expectError(t, err, "received empty profile changes")
+
+	_, err = c.UpdateProfile(member.ID, changes, other.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	// editors can only edit members they out rank
+	_, err = c.UpdateProfile(peer.ID, changes, admin.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	_, err = c.UpdateProfile(admin.ID, changes, moderator.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	if _, err := c.UpdateProfile(other.ID, changes, moderator.ID); err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+func TestDeletedMembersCantBeEdited(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	member := c.signUp("member")
+
+	if _, err := c.DeleteMember(member.ID, member.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	properName, err := vo.NewProperName("New", "Name")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.UpdateProfile(member.ID, ProfileChanges{ProperName: &properName}, admin.ID)
+	if err != MemberErrorDeleted {
+		t.Fatalf("expected the deleted member not to be editable, got: %v", err)
+	}
+
+}
+
+func TestEmailChange(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	member := c.signUp("member")
+	accessToken := c.login(member)
+
+	newEmailAddress, err := vo.NewEmailAddress("new@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.RequestEmailChange(member.ID, newEmailAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	// the current address learns about the change before it can be confirmed
+	if notices := c.transport.emailChangeNotices; len(notices) != 1 || notices[0] != member.EmailAddress {
+		t.Fatalf("expected the current address to be notified, got: %v", notices)
+	}
+
+	confirmationCode := c.transport.confirmationCodes[len(c.transport.confirmationCodes)-1]
+	if confirmationCode.EmailAddress != newEmailAddress {
+		t.Fatalf("expected the code to be sent to the new address, got: %s", confirmationCode.EmailAddress.String())
+	}
+
+	// a failed notice after the change doesn't fail the change
+	c.transport.emailChangedUnavailable = true
+
+	changed, err := c.ConfirmEmailChange(member.ID, newEmailAddress, confirmationCode.ConfirmationCode)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if changed.EmailAddress != newEmailAddress || c.member(member.ID).EmailAddress != newEmailAddress {
+		t.Fatalf("expected the email address to be changed, got: %s", changed.EmailAddress.String())
+	}
+
+	if revoked := c.accessTokens.accessTokens[accessToken.ID]; revoked.RevokedAt == nil {
+		t.Fatal("expected the access tokens to be revoked with the change")
+	}
+
+	if c.member(member.ID).AccessTokenID != nil {
+		t.Fatal("expected the current access token to be cleared")
+	}
+
+	_, err = c.ConfirmEmailChange(member.ID, newEmailAddress, confirmationCode.ConfirmationCode)
+	if err != EmailChangeErrorConfirmationCodeAlreadyUsed {
+		t.Fatalf("expected the code to be used up, got: %v", err)
+	}
+
+}
+
+func withUsernamePolicy(policy UsernamePolicy) func(dependencies *Dependencies) {
+	return func(dependencies *Dependencies) {
+		dependencies.UsernamePolicy = policy
+	}
+}
+
+func TestChangeUsername(t *testing.T) {
+
+	c := newTestCommunity(t, withUsernamePolicy(UsernamePolicy{ChangeCoolDown: time.Hour, ReservationPeriod: time.Hour}))
+
+	admin := c.signUp("admin", RoleAdmin)
+	member := c.signUp("member")
+	other := c.signUp("other")
+
+	renamed, err := vo.NewUsername("renamed")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	again, err := vo.NewUsername("again")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.ChangeUsername(member.ID, renamed, other.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	_, err = c.ChangeUsername(member.ID, other.Username, member.ID)
+	expectError(t, err, "UsernameTaken")
+
+	if _, err := c.ChangeUsername(member.ID, renamed, member.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.ChangeUsername(member.ID, again, member.ID)
+	if _, ok := err.(ChangeUsernameCoolDownError); !ok {
+		t.Fatalf("expected the member to wait for the cool down, got: %v", err)
+	}
+
+	// the old username is reserved for its previous owner
+	_, err = c.ChangeUsername(other.ID, member.Username, other.ID)
+	expectError(t, err, "UsernameReserved")
+
+	// editors are not bound to the cool down
+	if _, err := c.ChangeUsername(member.ID, member.Username, admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	history, err := c.UsernameHistory(member.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(history) != 2 || history[0].NewUsername != renamed || history[1].NewUsername != member.Username {
+		t.Fatalf("expected both changes in the history, got: %v", history)
+	}
+
+}
+
+func TestDeletedMembersCantBeRenamed(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	member := c.signUp("member")
+
+	if _, err := c.DeleteMember(member.ID, member.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	renamed, err := vo.NewUsername("renamed")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.ChangeUsername(member.ID, renamed, admin.ID)
+	if err != MemberErrorDeleted {
+		t.Fatalf("expected the deleted member not to be renamable, got: %v", err)
+	}
+
+}
+
+func TestMembersRequiresPermission(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	moderator := c.signUp("moderator", RoleModerator)
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	c.signUp("member")
+
+	_, err := c.Members(MembersQuery{}, reviewer.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	members, err := c.Members(MembersQuery{}, moderator.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(members) != 3 {
+		t.Fatalf("expected all members, got: %d", len(members))
+	}
+
+	role := RoleReviewer
+	members, err = c.Members(MembersQuery{Role: &role}, moderator.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(members) != 1 || members[0].ID != reviewer.ID {
+		t.Fatalf("expected only the reviewer, got: %v", members)
+	}
+
+	_, err = c.Members(MembersQuery{Sort: MembersQuerySort("EmailAddress")}, moderator.ID)
+	expectError(t, err, "members query sort: 'EmailAddress' is invalid")
+
+}
+
+func TestDirectoryOnlyListsVerifiedMembersInGoodStanding(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	banned := c.signUp("banned", RoleReviewer)
+	unverified := c.signUp("unverified")
+
+	if _, err := c.BanMember(banned.ID, "spam", admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := c.Directory(MembersQuery{}, unverified.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	unbanned := false
+	entries, err := c.Directory(MembersQuery{Banned: &unbanned}, reviewer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 2 || entries[0].ID != admin.ID || entries[1].ID != reviewer.ID {
+		t.Fatalf("expected only the verified members in good standing, got: %v", entries)
+	}
+
+	// members without the permission to read members can't filter by role
+	role := RoleAdmin
+	entries, err = c.Directory(MembersQuery{Role: &role}, reviewer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("expected the role filter to be ignored, got: %v", entries)
+	}
+
+	entries, err = c.Directory(MembersQuery{Role: &role}, admin.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 1 || entries[0].ID != admin.ID || entries[0].Username != admin.Username {
+		t.Fatalf("expected only the admin, got: %v", entries)
+	}
+
+}
@@ -0,0 +1,1138 @@
+package community_bl
+
+import (
+	"crypto/rand"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	vo "github.com/214alphadev/community-bl/value_objects"
+	"github.com/satori/go.uuid"
+	"golang.org/x/crypto/ed25519"
+)
+
+// The repositories of this file keep everything in memory. They implement the
+// filters the tests rely on and are not meant as reference implementations.
+
+type memoryMemberRepository struct {
+	members      map[MemberIdentifier]MemberEntity
+	applications *memoryApplicationRepository
+}
+
+func (r *memoryMemberRepository) FetchByID(memberID MemberIdentifier) (*MemberEntity, error) {
+	if member, ok := r.members[memberID]; ok {
+		return &member, nil
+	}
+	return nil, nil
+}
+
+func (r *memoryMemberRepository) Save(member MemberEntity) error {
+	r.members[member.ID] = member
+	return nil
+}
+
+func (r *memoryMemberRepository) IsUsernameTaken(username vo.Username) (bool, error) {
+	member, err := r.FetchByUsername(username)
+	return member != nil, err
+}
+
+func (r *memoryMemberRepository) IsEmailAddressTaken(emailAddress vo.EmailAddress) (bool, error) {
+	member, err := r.FetchByEmailAddress(emailAddress)
+	return member != nil, err
+}
+
+func (r *memoryMemberRepository) FetchByEmailAddress(emailAddress vo.EmailAddress) (*MemberEntity, error) {
+	for _, member := range r.members {
+		if member.EmailAddress == emailAddress {
+			return &member, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryMemberRepository) FetchByUsername(username vo.Username) (*MemberEntity, error) {
+	for _, member := range r.members {
+		if member.Username == username {
+			return &member, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryMemberRepository) matches(query MembersQuery, member MemberEntity) bool {
+
+	if query.Verified != nil && member.Verified != *query.Verified {
+		return false
+	}
+
+	if query.VerifiedEmailAddress != nil && member.VerifiedEmailAddress != *query.VerifiedEmailAddress {
+		return false
+	}
+
+	if query.Applied != nil {
+		applied := false
+		for _, application := range r.applications.applications {
+			applied = applied || application.MemberID == member.ID
+		}
+		if applied != *query.Applied {
+			return false
+		}
+	}
+
+	if query.Role != nil && !member.HasRole(*query.Role) {
+		return false
+	}
+
+	if query.Banned != nil && member.Banned != *query.Banned {
+		return false
+	}
+
+	if !query.IncludeDeleted && member.DeletedAt != nil {
+		return false
+	}
+
+	if query.CreatedAfter != nil && member.CreatedAt.Before(*query.CreatedAfter) {
+		return false
+	}
+
+	if query.CreatedBefore != nil && !member.CreatedAt.Before(*query.CreatedBefore) {
+		return false
+	}
+
+	return true
+
+}
+
+func (r *memoryMemberRepository) FetchByQuery(query MembersQuery) ([]MemberEntity, error) {
+
+	members := []MemberEntity{}
+	for _, member := range r.members {
+		if r.matches(query, member) {
+			members = append(members, member)
+		}
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].CreatedAt.Before(members[j].CreatedAt)
+	})
+
+	if query.Next > 0 && uint(len(members)) > query.Next {
+		members = members[:query.Next]
+	}
+
+	return members, nil
+
+}
+
+func (r *memoryMemberRepository) CountByQuery(query MembersQuery) (uint, error) {
+	members, err := r.FetchByQuery(MembersQuery{
+		Verified:             query.Verified,
+		VerifiedEmailAddress: query.VerifiedEmailAddress,
+		Role:                 query.Role,
+		Banned:               query.Banned,
+		CreatedAfter:         query.CreatedAfter,
+		CreatedBefore:        query.CreatedBefore,
+		Applied:              query.Applied,
+		IncludeDeleted:       query.IncludeDeleted,
+	})
+	return uint(len(members)), err
+}
+
+type memoryApplicationRepository struct {
+	applications []ApplicationEntity
+}
+
+func (r *memoryApplicationRepository) FetchLast(member MemberIdentifier) (*ApplicationEntity, error) {
+	var last *ApplicationEntity
+	for _, application := range r.applications {
+		if application.MemberID == member {
+			a := application
+			last = &a
+		}
+	}
+	return last, nil
+}
+
+func (r *memoryApplicationRepository) Save(application ApplicationEntity) error {
+	for i := range r.applications {
+		if r.applications[i].ID == application.ID {
+			r.applications[i] = application
+			return nil
+		}
+	}
+	r.applications = append(r.applications, application)
+	return nil
+}
+
+func (r *memoryApplicationRepository) FetchByID(applicationID ApplicationID) (*ApplicationEntity, error) {
+	for _, application := range r.applications {
+		if application.ID == applicationID {
+			return &application, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryApplicationRepository) matches(query ApplicationsQuery, application ApplicationEntity) bool {
+
+	if len(query.States) > 0 {
+		matches := false
+		for _, state := range query.States {
+			matches = matches || state == application.State
+		}
+		if !matches {
+			return false
+		}
+	}
+
+	if query.Member != nil && application.MemberID != *query.Member {
+		return false
+	}
+
+	claimed := application.AssignedTo != nil
+	if claimed && query.ClaimsActiveAt != nil && application.ClaimExpiresAt != nil {
+		claimed = query.ClaimsActiveAt.Before(*application.ClaimExpiresAt)
+	}
+
+	if query.AssignedTo != nil && (!claimed || *application.AssignedTo != *query.AssignedTo) {
+		return false
+	}
+
+	if query.Unassigned && claimed {
+		return false
+	}
+
+	if query.Escalated != nil && (application.EscalatedAt != nil) != *query.Escalated {
+		return false
+	}
+
+	if query.CreatedAfter != nil && application.CreatedAt.Before(*query.CreatedAfter) {
+		return false
+	}
+
+	if query.CreatedBefore != nil && !application.CreatedAt.Before(*query.CreatedBefore) {
+		return false
+	}
+
+	if query.TransitionedBefore != nil && !application.TransitionedAt.Before(*query.TransitionedBefore) {
+		return false
+	}
+
+	return true
+
+}
+
+func (r *memoryApplicationRepository) FetchByQuery(query ApplicationsQuery, after *ApplicationsCursor, limit uint) ([]ApplicationEntity, error) {
+
+	applications := []ApplicationEntity{}
+	passed := after == nil
+
+	for _, application := range r.applications {
+		if !passed {
+			passed = application.ID == after.ID
+			continue
+		}
+		if r.matches(query, application) {
+			applications = append(applications, application)
+		}
+		if uint(len(applications)) == limit {
+			break
+		}
+	}
+
+	return applications, nil
+
+}
+
+func (r *memoryApplicationRepository) CountByQuery(query ApplicationsQuery) (uint, error) {
+	applications, err := r.FetchByQuery(query, nil, uint(len(r.applications)))
+	return uint(len(applications)), err
+}
+
+func (r *memoryApplicationRepository) FetchByMember(member MemberIdentifier) ([]ApplicationEntity, error) {
+	return r.FetchByQuery(ApplicationsQuery{Member: &member}, nil, uint(len(r.applications)))
+}
+
+func (r *memoryApplicationRepository) FetchExpiredClaims(now time.Time) ([]ApplicationEntity, error) {
+	expired := []ApplicationEntity{}
+	for _, application := range r.applications {
+		if application.State.open() && application.ClaimExpiresAt != nil && application.ClaimExpiresAt.Before(now) {
+			expired = append(expired, application)
+		}
+	}
+	return expired, nil
+}
+
+func (r *memoryApplicationRepository) CountClaimed(reviewer MemberIdentifier) (uint, error) {
+	claimed := uint(0)
+	for _, application := range r.applications {
+		if application.State.open() && application.AssignedTo != nil && *application.AssignedTo == reviewer {
+			claimed++
+		}
+	}
+	return claimed, nil
+}
+
+func (r *memoryApplicationRepository) MedianReviewDuration(from time.Time, until time.Time) (*time.Duration, error) {
+	return nil, nil
+}
+
+func (r *memoryApplicationRepository) CountDecisionsByReviewer(from time.Time, until time.Time) ([]ReviewerStats, error) {
+	return []ReviewerStats{}, nil
+}
+
+type memoryAssignmentCursorRepository struct {
+	cursor      *MemberIdentifier
+	unavailable bool
+}
+
+func (r *memoryAssignmentCursorRepository) Fetch() (*MemberIdentifier, error) {
+	if r.unavailable {
+		return nil, errors.New("assignment cursor repository unavailable")
+	}
+	return r.cursor, nil
+}
+
+func (r *memoryAssignmentCursorRepository) Save(reviewer MemberIdentifier) error {
+	r.cursor = &reviewer
+	return nil
+}
+
+type memoryConfirmationCodeRepository struct {
+	confirmationCodes []ConfirmationCode
+}
+
+func (r *memoryConfirmationCodeRepository) Fetch(emailAddress vo.EmailAddress, confirmationCode vo.ConfirmationCode) (*ConfirmationCode, error) {
+	for _, cc := range r.confirmationCodes {
+		if cc.EmailAddress == emailAddress && cc.ConfirmationCode == confirmationCode {
+			return &cc, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryConfirmationCodeRepository) Save(confirmationCode *ConfirmationCode) error {
+	for i := range r.confirmationCodes {
+		if r.confirmationCodes[i].ID == confirmationCode.ID {
+			r.confirmationCodes[i] = *confirmationCode
+			return nil
+		}
+	}
+	r.confirmationCodes = append(r.confirmationCodes, *confirmationCode)
+	return nil
+}
+
+func (r *memoryConfirmationCodeRepository) Last(emailAddress vo.EmailAddress) (*ConfirmationCode, error) {
+	var last *ConfirmationCode
+	for _, cc := range r.confirmationCodes {
+		if cc.EmailAddress == emailAddress {
+			c := cc
+			last = &c
+		}
+	}
+	return last, nil
+}
+
+func (r *memoryConfirmationCodeRepository) Unused(emailAddress vo.EmailAddress) ([]*ConfirmationCode, error) {
+	unused := []*ConfirmationCode{}
+	for _, cc := range r.confirmationCodes {
+		if cc.EmailAddress == emailAddress && !cc.Used {
+			c := cc
+			unused = append(unused, &c)
+		}
+	}
+	return unused, nil
+}
+
+func (r *memoryConfirmationCodeRepository) FetchByMember(member MemberIdentifier) ([]*ConfirmationCode, error) {
+	codes := []*ConfirmationCode{}
+	for _, cc := range r.confirmationCodes {
+		if cc.MemberIdentifier == member {
+			c := cc
+			codes = append(codes, &c)
+		}
+	}
+	return codes, nil
+}
+
+type memoryTransport struct {
+	confirmationCodes       []ConfirmationCode
+	emailChangeNotices      []vo.EmailAddress
+	emailChangedNotices     []vo.EmailAddress
+	emailChangedUnavailable bool
+	// commentRecipients are the members that have been notified about comments
+	commentRecipients []MemberIdentifier
+	// escalationRecipients are the members that have been notified about waiting applications
+	escalationRecipients []MemberIdentifier
+}
+
+func (t *memoryTransport) SendConfirmationCode(confirmationCode ConfirmationCode) error {
+	t.confirmationCodes = append(t.confirmationCodes, confirmationCode)
+	return nil
+}
+
+func (t *memoryTransport) SendEmailAddressChangeRequestedNotification(member MemberEntity, newEmailAddress vo.EmailAddress) error {
+	t.emailChangeNotices = append(t.emailChangeNotices, member.EmailAddress)
+	return nil
+}
+
+func (t *memoryTransport) SendEmailAddressChangedNotification(oldEmailAddress vo.EmailAddress, member MemberEntity) error {
+	if t.emailChangedUnavailable {
+		return errors.New("transport unavailable")
+	}
+	t.emailChangedNotices = append(t.emailChangedNotices, oldEmailAddress)
+	return nil
+}
+
+func (t *memoryTransport) SendApplicationCommentNotification(recipient MemberEntity, application ApplicationEntity, comment ApplicationCommentEntity) error {
+	t.commentRecipients = append(t.commentRecipients, recipient.ID)
+	return nil
+}
+
+func (t *memoryTransport) SendApplicationEscalationNotification(recipient MemberEntity, application ApplicationEntity) error {
+	t.escalationRecipients = append(t.escalationRecipients, recipient.ID)
+	return nil
+}
+
+type memoryMemberAccessPublicKeyRepository struct {
+	keys []vo.MemberAccessPublicKey
+}
+
+func (r *memoryMemberAccessPublicKeyRepository) AlreadyUsed(key vo.MemberAccessPublicKey) (bool, error) {
+	for _, k := range r.keys {
+		if string(k.Key()) == string(key.Key()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memoryMemberAccessPublicKeyRepository) Save(key vo.MemberAccessPublicKey) error {
+	r.keys = append(r.keys, key)
+	return nil
+}
+
+type memoryAccessTokenRepository struct {
+	accessTokens map[uuid.UUID]MemberAccessTokenEntity
+}
+
+func (r *memoryAccessTokenRepository) Save(accessToken *MemberAccessTokenEntity) error {
+	r.accessTokens[accessToken.ID] = *accessToken
+	return nil
+}
+
+func (r *memoryAccessTokenRepository) FetchByID(id uuid.UUID) (*MemberAccessTokenEntity, error) {
+	if accessToken, ok := r.accessTokens[id]; ok {
+		return &accessToken, nil
+	}
+	return nil, nil
+}
+
+func (r *memoryAccessTokenRepository) FetchByMember(member MemberIdentifier) ([]MemberAccessTokenEntity, error) {
+	accessTokens := []MemberAccessTokenEntity{}
+	for _, accessToken := range r.accessTokens {
+		if accessToken.Subject == member {
+			accessTokens = append(accessTokens, accessToken)
+		}
+	}
+	return accessTokens, nil
+}
+
+type memoryRoleChangeRepository struct {
+	changes []RoleChangeEntity
+}
+
+func (r *memoryRoleChangeRepository) Save(change RoleChangeEntity) error {
+	r.changes = append(r.changes, change)
+	return nil
+}
+
+func (r *memoryRoleChangeRepository) FetchByMember(member MemberIdentifier) ([]RoleChangeEntity, error) {
+	changes := []RoleChangeEntity{}
+	for _, change := range r.changes {
+		if change.MemberID == member {
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+type memoryUsernameChangeRepository struct {
+	changes []UsernameChangeEntity
+}
+
+func (r *memoryUsernameChangeRepository) Save(change UsernameChangeEntity) error {
+	r.changes = append(r.changes, change)
+	return nil
+}
+
+func (r *memoryUsernameChangeRepository) FetchLastByOldUsername(username vo.Username) (*UsernameChangeEntity, error) {
+	var last *UsernameChangeEntity
+	for _, change := range r.changes {
+		if change.OldUsername == username {
+			c := change
+			last = &c
+		}
+	}
+	return last, nil
+}
+
+func (r *memoryUsernameChangeRepository) FetchLastByMember(member MemberIdentifier) (*UsernameChangeEntity, error) {
+	var last *UsernameChangeEntity
+	for _, change := range r.changes {
+		if change.MemberID == member {
+			c := change
+			last = &c
+		}
+	}
+	return last, nil
+}
+
+func (r *memoryUsernameChangeRepository) FetchByMember(member MemberIdentifier) ([]UsernameChangeEntity, error) {
+	changes := []UsernameChangeEntity{}
+	for _, change := range r.changes {
+		if change.MemberID == member {
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+func (r *memoryUsernameChangeRepository) DeleteByMember(member MemberIdentifier) error {
+	changes := []UsernameChangeEntity{}
+	for _, change := range r.changes {
+		if change.MemberID != member {
+			changes = append(changes, change)
+		}
+	}
+	r.changes = changes
+	return nil
+}
+
+type memoryMemberDeletionRepository struct {
+	deletions []MemberDeletionEntity
+}
+
+func (r *memoryMemberDeletionRepository) Save(deletion MemberDeletionEntity) error {
+	for i := range r.deletions {
+		if r.deletions[i].ID == deletion.ID {
+			r.deletions[i] = deletion
+			return nil
+		}
+	}
+	r.deletions = append(r.deletions, deletion)
+	return nil
+}
+
+func (r *memoryMemberDeletionRepository) FetchPending(member MemberIdentifier) (*MemberDeletionEntity, error) {
+	for _, deletion := range r.deletions {
+		if deletion.MemberID == member && deletion.CancelledAt == nil && deletion.ErasedAt == nil {
+			return &deletion, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryMemberDeletionRepository) FetchDue(now time.Time) ([]MemberDeletionEntity, error) {
+	due := []MemberDeletionEntity{}
+	for _, deletion := range r.deletions {
+		if deletion.CancelledAt == nil && deletion.ErasedAt == nil && !deletion.EraseAt.After(now) {
+			due = append(due, deletion)
+		}
+	}
+	return due, nil
+}
+
+type memoryLoginRepository struct {
+	logins []LoginEntity
+}
+
+func (r *memoryLoginRepository) Save(login LoginEntity) error {
+	r.logins = append(r.logins, login)
+	return nil
+}
+
+func (r *memoryLoginRepository) FetchByMember(member MemberIdentifier) ([]LoginEntity, error) {
+	logins := []LoginEntity{}
+	for _, login := range r.logins {
+		if login.MemberID == member {
+			logins = append(logins, login)
+		}
+	}
+	return logins, nil
+}
+
+func (r *memoryLoginRepository) CountLogins(from time.Time, until time.Time) (uint, error) {
+	return 0, nil
+}
+
+func (r *memoryLoginRepository) CountActiveMembers(from time.Time, until time.Time) (uint, error) {
+	return 0, nil
+}
+
+type memorySanctionRepository struct {
+	sanctions []SanctionEntity
+}
+
+func (r *memorySanctionRepository) Save(sanction SanctionEntity) error {
+	for i := range r.sanctions {
+		if r.sanctions[i].ID == sanction.ID {
+			r.sanctions[i] = sanction
+			return nil
+		}
+	}
+	r.sanctions = append(r.sanctions, sanction)
+	return nil
+}
+
+func (r *memorySanctionRepository) FetchByMember(member MemberIdentifier) ([]SanctionEntity, error) {
+	sanctions := []SanctionEntity{}
+	for _, sanction := range r.sanctions {
+		if sanction.MemberID == member {
+			sanctions = append(sanctions, sanction)
+		}
+	}
+	return sanctions, nil
+}
+
+func (r *memorySanctionRepository) IsEmailAddressBanned(emailAddress vo.EmailAddress) (bool, error) {
+	for _, sanction := range r.sanctions {
+		if sanction.EmailAddress == emailAddress && sanction.Type == SanctionTypeBan && sanction.LiftedAt == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type memoryInvitationRepository struct {
+	invitations []InvitationEntity
+}
+
+func (r *memoryInvitationRepository) Save(invitation InvitationEntity) error {
+	for i := range r.invitations {
+		if r.invitations[i].ID == invitation.ID {
+			r.invitations[i] = invitation
+			return nil
+		}
+	}
+	r.invitations = append(r.invitations, invitation)
+	return nil
+}
+
+func (r *memoryInvitationRepository) FetchByID(id uuid.UUID) (*InvitationEntity, error) {
+	for _, invitation := range r.invitations {
+		if invitation.ID == id {
+			return &invitation, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryInvitationRepository) FetchByCode(code vo.InvitationCode) (*InvitationEntity, error) {
+	for _, invitation := range r.invitations {
+		if invitation.Code == code {
+			return &invitation, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryInvitationRepository) FetchByCreator(member MemberIdentifier) ([]InvitationEntity, error) {
+	invitations := []InvitationEntity{}
+	for _, invitation := range r.invitations {
+		if invitation.CreatedBy == member {
+			invitations = append(invitations, invitation)
+		}
+	}
+	return invitations, nil
+}
+
+type memoryVouchRepository struct {
+	vouches []VouchEntity
+}
+
+func (r *memoryVouchRepository) Save(vouch VouchEntity) error {
+	for i := range r.vouches {
+		if r.vouches[i].ID == vouch.ID {
+			r.vouches[i] = vouch
+			return nil
+		}
+	}
+	r.vouches = append(r.vouches, vouch)
+	return nil
+}
+
+func (r *memoryVouchRepository) FetchByApplication(application ApplicationID) ([]VouchEntity, error) {
+	vouches := []VouchEntity{}
+	for _, vouch := range r.vouches {
+		if vouch.ApplicationID == application && vouch.RevokedAt == nil {
+			vouches = append(vouches, vouch)
+		}
+	}
+	return vouches, nil
+}
+
+func (r *memoryVouchRepository) FetchByVoucherSince(voucher MemberIdentifier, since time.Time) ([]VouchEntity, error) {
+	vouches := []VouchEntity{}
+	for _, vouch := range r.vouches {
+		if vouch.VoucherID == voucher && !vouch.VouchedAt.Before(since) {
+			vouches = append(vouches, vouch)
+		}
+	}
+	return vouches, nil
+}
+
+type memoryReviewVoteRepository struct {
+	votes []ReviewVoteEntity
+}
+
+func (r *memoryReviewVoteRepository) Save(vote ReviewVoteEntity) error {
+	for i := range r.votes {
+		if r.votes[i].ID == vote.ID {
+			r.votes[i] = vote
+			return nil
+		}
+	}
+	r.votes = append(r.votes, vote)
+	return nil
+}
+
+func (r *memoryReviewVoteRepository) FetchByApplication(application ApplicationID) ([]ReviewVoteEntity, error) {
+	votes := []ReviewVoteEntity{}
+	for _, vote := range r.votes {
+		if vote.ApplicationID == application {
+			votes = append(votes, vote)
+		}
+	}
+	return votes, nil
+}
+
+type memoryApplicationCommentRepository struct {
+	comments []ApplicationCommentEntity
+}
+
+func (r *memoryApplicationCommentRepository) Save(comment ApplicationCommentEntity) error {
+	r.comments = append(r.comments, comment)
+	return nil
+}
+
+func (r *memoryApplicationCommentRepository) FetchByApplication(application ApplicationID) ([]ApplicationCommentEntity, error) {
+	comments := []ApplicationCommentEntity{}
+	for _, comment := range r.comments {
+		if comment.ApplicationID == application {
+			comments = append(comments, comment)
+		}
+	}
+	return comments, nil
+}
+
+func (r *memoryApplicationCommentRepository) DeleteByApplication(application ApplicationID) error {
+	comments := []ApplicationCommentEntity{}
+	for _, comment := range r.comments {
+		if comment.ApplicationID != application {
+			comments = append(comments, comment)
+		}
+	}
+	r.comments = comments
+	return nil
+}
+
+type memoryApplicationFormRepository struct {
+	forms []ApplicationFormEntity
+}
+
+func (r *memoryApplicationFormRepository) Save(form ApplicationFormEntity) error {
+	r.forms = append(r.forms, form)
+	return nil
+}
+
+func (r *memoryApplicationFormRepository) FetchActive() (*ApplicationFormEntity, error) {
+	if len(r.forms) == 0 {
+		return nil, nil
+	}
+	form := r.forms[len(r.forms)-1]
+	return &form, nil
+}
+
+func (r *memoryApplicationFormRepository) FetchByVersion(version uint) (*ApplicationFormEntity, error) {
+	for _, form := range r.forms {
+		if form.Version == version {
+			return &form, nil
+		}
+	}
+	return nil, nil
+}
+
+type memoryApplicationRevisionRepository struct {
+	revisions []ApplicationRevisionEntity
+}
+
+func (r *memoryApplicationRevisionRepository) Save(revision ApplicationRevisionEntity) error {
+	r.revisions = append(r.revisions, revision)
+	return nil
+}
+
+func (r *memoryApplicationRevisionRepository) FetchByApplication(application ApplicationID) ([]ApplicationRevisionEntity, error) {
+	revisions := []ApplicationRevisionEntity{}
+	for _, revision := range r.revisions {
+		if revision.ApplicationID == application {
+			revisions = append(revisions, revision)
+		}
+	}
+	return revisions, nil
+}
+
+func (r *memoryApplicationRevisionRepository) DeleteByApplication(application ApplicationID) error {
+	revisions := []ApplicationRevisionEntity{}
+	for _, revision := range r.revisions {
+		if revision.ApplicationID != application {
+			revisions = append(revisions, revision)
+		}
+	}
+	r.revisions = revisions
+	return nil
+}
+
+type memoryAppealRepository struct {
+	appeals []AppealEntity
+}
+
+func (r *memoryAppealRepository) Save(appeal AppealEntity) error {
+	for i := range r.appeals {
+		if r.appeals[i].ID == appeal.ID {
+			r.appeals[i] = appeal
+			return nil
+		}
+	}
+	r.appeals = append(r.appeals, appeal)
+	return nil
+}
+
+func (r *memoryAppealRepository) FetchByID(id uuid.UUID) (*AppealEntity, error) {
+	for _, appeal := range r.appeals {
+		if appeal.ID == id {
+			return &appeal, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryAppealRepository) FetchByApplication(application ApplicationID) (*AppealEntity, error) {
+	for _, appeal := range r.appeals {
+		if appeal.ApplicationID == application {
+			return &appeal, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryAppealRepository) FetchByState(state AppealState) ([]AppealEntity, error) {
+	appeals := []AppealEntity{}
+	for _, appeal := range r.appeals {
+		if appeal.State == state {
+			appeals = append(appeals, appeal)
+		}
+	}
+	return appeals, nil
+}
+
+type memoryVerificationRevocationRepository struct {
+	revocations []VerificationRevocationEntity
+}
+
+func (r *memoryVerificationRevocationRepository) Save(revocation VerificationRevocationEntity) error {
+	r.revocations = append(r.revocations, revocation)
+	return nil
+}
+
+func (r *memoryVerificationRevocationRepository) FetchLastByMember(member MemberIdentifier) (*VerificationRevocationEntity, error) {
+	var last *VerificationRevocationEntity
+	for _, revocation := range r.revocations {
+		if revocation.MemberID == member {
+			r := revocation
+			last = &r
+		}
+	}
+	return last, nil
+}
+
+func (r *memoryVerificationRevocationRepository) FetchByMember(member MemberIdentifier) ([]VerificationRevocationEntity, error) {
+	revocations := []VerificationRevocationEntity{}
+	for _, revocation := range r.revocations {
+		if revocation.MemberID == member {
+			revocations = append(revocations, revocation)
+		}
+	}
+	return revocations, nil
+}
+
+type memoryApplicationTransitionRepository struct {
+	transitions []ApplicationTransitionEntity
+}
+
+func (r *memoryApplicationTransitionRepository) Save(transition ApplicationTransitionEntity) error {
+	r.transitions = append(r.transitions, transition)
+	return nil
+}
+
+func (r *memoryApplicationTransitionRepository) FetchByApplication(application ApplicationID) ([]ApplicationTransitionEntity, error) {
+	transitions := []ApplicationTransitionEntity{}
+	for _, transition := range r.transitions {
+		if transition.ApplicationID == application {
+			transitions = append(transitions, transition)
+		}
+	}
+	return transitions, nil
+}
+
+func (r *memoryApplicationTransitionRepository) ClearReasons(application ApplicationID) error {
+	for i := range r.transitions {
+		if r.transitions[i].ApplicationID == application {
+			r.transitions[i].Reason = ""
+		}
+	}
+	return nil
+}
+
+type memoryAuditLogRepository struct {
+	entries []AuditEntryEntity
+	// unavailable makes appending fail
+	unavailable bool
+}
+
+func (r *memoryAuditLogRepository) Append(entry AuditEntryEntity) error {
+
+	if r.unavailable {
+		return errors.New("audit log unavailable")
+	}
+
+	r.entries = append(r.entries, entry)
+	return nil
+
+}
+
+func (r *memoryAuditLogRepository) Last() (*AuditEntryEntity, error) {
+	if len(r.entries) == 0 {
+		return nil, nil
+	}
+	last := r.entries[len(r.entries)-1]
+	return &last, nil
+}
+
+func (r *memoryAuditLogRepository) FetchByQuery(query AuditLogQuery) ([]AuditEntryEntity, error) {
+
+	entries := []AuditEntryEntity{}
+
+	for _, entry := range r.entries {
+		if query.Position != nil && entry.Sequence <= *query.Position {
+			continue
+		}
+		if query.Action != "" && entry.Action != query.Action {
+			continue
+		}
+		if query.Actor != nil && (entry.Actor == nil || *entry.Actor != *query.Actor) {
+			continue
+		}
+		if query.Outcome != "" && entry.Outcome != query.Outcome {
+			continue
+		}
+		entries = append(entries, entry)
+		if query.Next > 0 && uint(len(entries)) == query.Next {
+			break
+		}
+	}
+
+	return entries, nil
+
+}
+
+// testCommunity is a community backed by memory repositories
+type testCommunity struct {
+	*Community
+	t            *testing.T
+	members      *memoryMemberRepository
+	applications *memoryApplicationRepository
+	accessTokens *memoryAccessTokenRepository
+	transport    *memoryTransport
+	auditLog     *memoryAuditLogRepository
+}
+
+func newTestCommunity(t *testing.T, configure ...func(dependencies *Dependencies)) *testCommunity {
+
+	t.Helper()
+
+	signingKey, err := vo.AccessTokenSigningKeyFactory()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	applications := &memoryApplicationRepository{}
+	members := &memoryMemberRepository{members: map[MemberIdentifier]MemberEntity{}, applications: applications}
+	accessTokens := &memoryAccessTokenRepository{accessTokens: map[uuid.UUID]MemberAccessTokenEntity{}}
+	transport := &memoryTransport{}
+	auditLog := &memoryAuditLogRepository{}
+
+	dependencies := Dependencies{
+		MemberRepository:                 members,
+		ApplicationRepository:            applications,
+		ConfirmationCodeRepository:       &memoryConfirmationCodeRepository{},
+		Transport:                        transport,
+		MemberAccessPublicKeyRepository:  &memoryMemberAccessPublicKeyRepository{},
+		AccessTokenSigningKey:            signingKey,
+		AccessTokenRepository:            accessTokens,
+		RoleChangeRepository:             &memoryRoleChangeRepository{},
+		UsernameChangeRepository:         &memoryUsernameChangeRepository{},
+		MemberDeletionRepository:         &memoryMemberDeletionRepository{},
+		LoginRepository:                  &memoryLoginRepository{},
+		SanctionRepository:               &memorySanctionRepository{},
+		InvitationRepository:             &memoryInvitationRepository{},
+		VouchRepository:                  &memoryVouchRepository{},
+		ReviewVoteRepository:             &memoryReviewVoteRepository{},
+		ApplicationCommentRepository:     &memoryApplicationCommentRepository{},
+		ApplicationFormRepository:        &memoryApplicationFormRepository{},
+		ApplicationRevisionRepository:    &memoryApplicationRevisionRepository{},
+		AppealRepository:                 &memoryAppealRepository{},
+		VerificationRevocationRepository: &memoryVerificationRevocationRepository{},
+		ApplicationTransitionRepository:  &memoryApplicationTransitionRepository{},
+		AuditLog:                         auditLog,
+		AssignmentCursorRepository:       &memoryAssignmentCursorRepository{},
+	}
+
+	for _, c := range configure {
+		c(&dependencies)
+	}
+
+	community, err := NewCommunity(dependencies)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testCommunity{
+		Community:    community,
+		t:            t,
+		members:      members,
+		applications: applications,
+		accessTokens: accessTokens,
+		transport:    transport,
+		auditLog:     auditLog,
+	}
+
+}
+
+// signUp creates a member with the given roles. The roles are saved directly,
+// so tests don't depend on the rules of granting them.
+func (c *testCommunity) signUp(username string, roles ...Role) MemberEntity {
+
+	c.t.Helper()
+
+	u, err := vo.NewUsername(username)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	emailAddress, err := vo.NewEmailAddress(username + "@example.com")
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	properName, err := vo.NewProperName("First", "Last")
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	member, err := c.SignUp(u, emailAddress, MetadataEntity{ProperName: properName})
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	if len(roles) > 0 {
+		member.Roles = roles
+		member.Verified = true
+		if err := c.members.Save(member); err != nil {
+			c.t.Fatal(err)
+		}
+	}
+
+	return member
+
+}
+
+// login logs the member in with the last confirmation code sent to its email address
+func (c *testCommunity) login(member MemberEntity) MemberAccessTokenEntity {
+
+	c.t.Helper()
+
+	if err := c.RequestLogin(member.EmailAddress); err != nil {
+		c.t.Fatal(err)
+	}
+
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	memberAccessPublicKey, err := vo.NewMemberAccessPublicKey(publicKey)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	confirmationCode := c.transport.confirmationCodes[len(c.transport.confirmationCodes)-1]
+
+	accessToken, err := c.Login(member.EmailAddress, memberAccessPublicKey, confirmationCode.ConfirmationCode)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	return accessToken
+
+}
+
+func (c *testCommunity) member(id MemberIdentifier) MemberEntity {
+
+	c.t.Helper()
+
+	member, err := c.members.FetchByID(id)
+	if err != nil || member == nil {
+		c.t.Fatalf("couldn't fetch member %s: %v", id.String(), err)
+	}
+
+	return *member
+
+}
+
+func (c *testCommunity) application(id ApplicationID) ApplicationEntity {
+
+	c.t.Helper()
+
+	application, err := c.applications.FetchByID(id)
+	if err != nil || application == nil {
+		c.t.Fatalf("couldn't fetch application %s: %v", id.String(), err)
+	}
+
+	return *application
+
+}
+
+func (c *testCommunity) apply(member MemberEntity) ApplicationEntity {
+
+	c.t.Helper()
+
+	application, err := c.ApplyForVerification("I would like to join", member.ID)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	return application
+
+}
+
+func expectError(t *testing.T, err error, expected string) {
+
+	t.Helper()
+
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected error %q, got: %v", expected, err)
+	}
+
+}
@@ -0,0 +1,226 @@
+package community_bl
+
+import (
+	"errors"
+	"fmt"
+	"github.com/satori/go.uuid"
+	"time"
+)
+
+type MemberSuspendedError struct {
+	SuspendedUntil int64
+}
+
+func (e MemberSuspendedError) Error() string {
+	return fmt.Sprintf("member is suspended until: %d", e.SuspendedUntil)
+}
+
+type MemberBannedError struct{}
+
+func (e MemberBannedError) Error() string {
+	return "member is banned"
+}
+
+var SignUpErrorEmailAddressBanned = errors.New("email address is banned")
+
+// standing returns a typed error if the member is currently suspended or banned
+func standing(member MemberEntity) error {
+
+	if member.Banned {
+		return MemberBannedError{}
+	}
+
+	if member.SuspendedUntil != nil && time.Now().Before(*member.SuspendedUntil) {
+		return MemberSuspendedError{
+			SuspendedUntil: member.SuspendedUntil.Unix(),
+		}
+	}
+
+	return nil
+
+}
+
+type moderationService struct {
+	memberRepository   MemberRepository
+	sanctionRepository SanctionRepository
+	memberService      *memberService
+}
+
+func (s *moderationService) SuspendMember(memberID MemberIdentifier, reason string, until time.Time, requesterID MemberIdentifier) (SanctionEntity, error) {
+
+	if !until.After(time.Now()) {
+		return SanctionEntity{}, errors.New("suspension must end in the future")
+	}
+
+	return s.sanction(memberID, SanctionTypeSuspension, reason, &until, requesterID)
+
+}
+
+func (s *moderationService) BanMember(memberID MemberIdentifier, reason string, requesterID MemberIdentifier) (SanctionEntity, error) {
+	return s.sanction(memberID, SanctionTypeBan, reason, nil, requesterID)
+}
+
+func (s *moderationService) sanction(memberID MemberIdentifier, sanctionType SanctionType, reason string, expiresAt *time.Time, requesterID MemberIdentifier) (SanctionEntity, error) {
+
+	if reason == "" {
+		return SanctionEntity{}, errors.New("a reason is required")
+	}
+
+	requester, member, err := s.authorize(memberID, requesterID)
+	if err != nil {
+		return SanctionEntity{}, err
+	}
+
+	if member.Banned {
+		return SanctionEntity{}, errors.New("MemberAlreadyBanned")
+	}
+
+	sanction := SanctionEntity{
+		ID:           uuid.NewV4(),
+		MemberID:     member.ID,
+		EmailAddress: member.EmailAddress,
+		Type:         sanctionType,
+		Reason:       reason,
+		IssuedBy:     requester.ID,
+		IssuedAt:     time.Now(),
+		ExpiresAt:    expiresAt,
+	}
+	if err := s.sanctionRepository.Save(sanction); err != nil {
+		return SanctionEntity{}, err
+	}
+
+	switch sanctionType {
+	case SanctionTypeBan:
+		member.Banned = true
+	case SanctionTypeSuspension:
+		// an earlier suspension that ends later keeps the member suspended
+		if member.SuspendedUntil == nil || expiresAt.After(*member.SuspendedUntil) {
+			member.SuspendedUntil = expiresAt
+		}
+	}
+
+	if err := s.memberService.revokeAccessTokens(member.ID); err != nil {
+		return SanctionEntity{}, err
+	}
+
+	member.AccessTokenID = nil
+	if err := s.memberRepository.Save(*member); err != nil {
+		return SanctionEntity{}, err
+	}
+
+	if err := s.memberService.invalidateConfirmationCodes(member.EmailAddress); err != nil {
+		return SanctionEntity{}, err
+	}
+
+	return sanction, nil
+
+}
+
+// Unsuspend lifts all active suspensions of the member. Bans are lifted with LiftBan.
+// The sanctions are kept for the history.
+func (s *moderationService) Unsuspend(memberID MemberIdentifier, reason string, requesterID MemberIdentifier) error {
+	return s.lift(memberID, SanctionTypeSuspension, reason, requesterID)
+}
+
+// LiftBan lifts the ban of the member. The sanction is kept for the history.
+func (s *moderationService) LiftBan(memberID MemberIdentifier, reason string, requesterID MemberIdentifier) error {
+	return s.lift(memberID, SanctionTypeBan, reason, requesterID)
+}
+
+func (s *moderationService) lift(memberID MemberIdentifier, sanctionType SanctionType, reason string, requesterID MemberIdentifier) error {
+
+	requester, member, err := s.authorize(memberID, requesterID)
+	if err != nil {
+		return err
+	}
+
+	sanctions, err := s.sanctionRepository.FetchByMember(member.ID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	lifted := 0
+
+	for _, sanction := range sanctions {
+		if sanction.Type != sanctionType || !sanction.Active(now) {
+			continue
+		}
+		sanction.LiftedAt = &now
+		sanction.LiftedBy = &requester.ID
+		sanction.LiftReason = reason
+		if err := s.sanctionRepository.Save(sanction); err != nil {
+			return err
+		}
+		lifted++
+	}
+
+	switch sanctionType {
+	case SanctionTypeBan:
+		if lifted == 0 && !member.Banned {
+			return errors.New("MemberNotBanned")
+		}
+		member.Banned = false
+	case SanctionTypeSuspension:
+		if lifted == 0 && (member.SuspendedUntil == nil || !now.Before(*member.SuspendedUntil)) {
+			return errors.New("MemberNotSuspended")
+		}
+		member.SuspendedUntil = nil
+	}
+
+	return s.memberRepository.Save(*member)
+
+}
+
+func (s *moderationService) Sanctions(memberID MemberIdentifier, requesterID MemberIdentifier) ([]SanctionEntity, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if requester == nil {
+		return nil, errors.New("RequesterNotFound")
+	}
+
+	if !requester.HasPermission(PermissionMembersModerate) {
+		return nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	return s.sanctionRepository.FetchByMember(memberID)
+
+}
+
+// authorize makes sure the requester is allowed to moderate the member.
+// Members can't moderate themselves or members that out rank them.
+func (s *moderationService) authorize(memberID MemberIdentifier, requesterID MemberIdentifier) (*MemberEntity, *MemberEntity, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if requester == nil {
+		return nil, nil, errors.New("RequesterNotFound")
+	}
+
+	if !requester.HasPermission(PermissionMembersModerate) {
+		return nil, nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	member, err := s.memberRepository.FetchByID(memberID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if member == nil {
+		return nil, nil, errors.New("MemberDoesNotExist")
+	}
+
+	if member.ID == requester.ID || member.rank() >= requester.rank() {
+		return nil, nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	return requester, member, nil
+
+}
@@ -0,0 +1,269 @@
+package community_bl
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	vo "github.com/214alphadev/community-bl/value_objects"
+	"golang.org/x/crypto/ed25519"
+)
+
+func TestSanctionsAreLimitedByRank(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	owner := c.signUp("owner", RoleOwner)
+	admin := c.signUp("admin", RoleAdmin)
+	moderator := c.signUp("moderator", RoleModerator)
+	peer := c.signUp("peer", RoleModerator)
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	member := c.signUp("member")
+
+	until := time.Now().Add(time.Hour)
+
+	cases := []struct {
+		name   string
+		member MemberEntity
+	}{
+		{"an owner", owner},
+		{"an admin", admin},
+		{"a peer", peer},
+		{"a reviewer", reviewer},
+		{"themselves", moderator},
+	}
+
+	for _, tc := range cases {
+
+		_, err := c.SuspendMember(tc.member.ID, "spam", until, moderator.ID)
+		if err == nil || err.Error() != "InsufficientPermissions" {
+			t.Errorf("expected a moderator not to be able to suspend %s, got: %v", tc.name, err)
+		}
+
+		_, err = c.BanMember(tc.member.ID, "spam", moderator.ID)
+		if err == nil || err.Error() != "InsufficientPermissions" {
+			t.Errorf("expected a moderator not to be able to ban %s, got: %v", tc.name, err)
+		}
+
+	}
+
+	_, err := c.BanMember(member.ID, "spam", reviewer.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	if _, err := c.SuspendMember(member.ID, "spam", until, moderator.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.BanMember(moderator.ID, "spam", admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.BanMember(admin.ID, "spam", owner.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.BanMember(admin.ID, "spam", owner.ID)
+	expectError(t, err, "MemberAlreadyBanned")
+
+}
+
+func TestSanctionedMembersAreRejected(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	suspended := c.signUp("suspended")
+	banned := c.signUp("banned")
+
+	until := time.Now().Add(time.Hour)
+
+	if _, err := c.SuspendMember(suspended.ID, "spam", until, admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.BanMember(banned.ID, "spam", admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	err := c.RequestLogin(suspended.EmailAddress)
+	if e, ok := err.(MemberSuspendedError); !ok || e.SuspendedUntil != until.Unix() {
+		t.Fatalf("expected the suspended member to be rejected, got: %v", err)
+	}
+
+	if err := c.RequestLogin(banned.EmailAddress); err != (MemberBannedError{}) {
+		t.Fatalf("expected the banned member to be rejected, got: %v", err)
+	}
+
+	if err := c.Unsuspend(suspended.ID, "appealed", admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := standing(c.member(suspended.ID)); err != nil {
+		t.Fatalf("expected the member to be in good standing again, got: %v", err)
+	}
+
+	expectError(t, c.Unsuspend(suspended.ID, "appealed", admin.ID), "MemberNotSuspended")
+
+	sanctions, err := c.Sanctions(suspended.ID, admin.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sanctions) != 1 || sanctions[0].LiftedAt == nil || sanctions[0].LiftReason != "appealed" {
+		t.Fatalf("expected the lifted suspension to be kept, got: %v", sanctions)
+	}
+
+}
+
+func TestSuspensionMustEndInTheFuture(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	member := c.signUp("member")
+
+	_, err := c.SuspendMember(member.ID, "spam", time.Now().Add(-time.Hour), admin.ID)
+	expectError(t, err, "suspension must end in the future")
+
+	_, err = c.BanMember(member.ID, "", admin.ID)
+	expectError(t, err, "a reason is required")
+
+}
+
+func TestSuspensionsKeepTheLaterExpiry(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	member := c.signUp("member")
+
+	later := time.Now().Add(2 * time.Hour)
+	earlier := time.Now().Add(time.Hour)
+
+	if _, err := c.SuspendMember(member.ID, "spam", later, admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.SuspendMember(member.ID, "spam again", earlier, admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if until := c.member(member.ID).SuspendedUntil; until == nil || until.Unix() != later.Unix() {
+		t.Fatalf("expected the later suspension to be kept, got: %v", until)
+	}
+
+	// lifting the suspension lifts all of them
+	if err := c.Unsuspend(member.ID, "appealed", admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := standing(c.member(member.ID)); err != nil {
+		t.Fatalf("expected the member to be in good standing again, got: %v", err)
+	}
+
+}
+
+func TestBansAreLiftedSeparately(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	member := c.signUp("member")
+
+	expectError(t, c.LiftBan(member.ID, "appealed", admin.ID), "MemberNotBanned")
+
+	if _, err := c.SuspendMember(member.ID, "spam", time.Now().Add(time.Hour), admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.BanMember(member.ID, "spam", admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// lifting the suspension keeps the ban
+	if err := c.Unsuspend(member.ID, "appealed", admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := standing(c.member(member.ID)); err != (MemberBannedError{}) {
+		t.Fatalf("expected the member to stay banned, got: %v", err)
+	}
+
+	expectError(t, c.Unsuspend(member.ID, "appealed", admin.ID), "MemberNotSuspended")
+
+	if err := c.LiftBan(member.ID, "appealed", admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := standing(c.member(member.ID)); err != nil {
+		t.Fatalf("expected the member to be in good standing again, got: %v", err)
+	}
+
+	sanctions, err := c.Sanctions(member.ID, admin.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, sanction := range sanctions {
+		if sanction.LiftedAt == nil {
+			t.Fatalf("expected all sanctions to be lifted, got: %v", sanction)
+		}
+	}
+
+	last := c.auditLog.entries[len(c.auditLog.entries)-1]
+	if last.Action != AuditActionBanLifted || last.Outcome != AuditOutcomeSucceeded {
+		t.Fatalf("expected the lifted ban to be recorded, got: %s %s", last.Action, last.Outcome)
+	}
+
+}
+
+func TestLoginOfSanctionedMembersKeepsTheCode(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	member := c.signUp("member")
+
+	if err := c.RequestLogin(member.EmailAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	confirmationCode := c.transport.confirmationCodes[len(c.transport.confirmationCodes)-1]
+
+	// the member gets suspended while the code is on its way
+	until := time.Now().Add(time.Hour)
+	if _, err := c.SuspendMember(member.ID, "spam", until, admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// the suspension invalidated the code, restore it to simulate a code that is still valid
+	confirmationCode.Used = false
+	if err := c.Community.memberService.confirmationCodeRepository.Save(&confirmationCode); err != nil {
+		t.Fatal(err)
+	}
+
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	memberAccessPublicKey, err := vo.NewMemberAccessPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.Login(member.EmailAddress, memberAccessPublicKey, confirmationCode.ConfirmationCode)
+	if _, ok := err.(MemberSuspendedError); !ok {
+		t.Fatalf("expected the suspended member to be rejected, got: %v", err)
+	}
+
+	fetched, err := c.Community.memberService.confirmationCodeRepository.Fetch(member.EmailAddress, confirmationCode.ConfirmationCode)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fetched.Used {
+		t.Fatal("expected the rejected login not to consume the code")
+	}
+
+}
@@ -1,7 +1,9 @@
 package community_bl
 
 import (
+	"github.com/satori/go.uuid"
 	vo "github.com/214alphadev/community-bl/value_objects"
+	"time"
 )
 
 type MemberRepository interface {
@@ -10,19 +12,40 @@ type MemberRepository interface {
 	IsUsernameTaken(username vo.Username) (bool, error)
 	IsEmailAddressTaken(emailAddress vo.EmailAddress) (bool, error)
 	FetchByEmailAddress(emailAddress vo.EmailAddress) (*MemberEntity, error)
+	FetchByUsername(username vo.Username) (*MemberEntity, error)
+	FetchByQuery(query MembersQuery) ([]MemberEntity, error)
+	// CountByQuery counts the members matching the filters of the query. Position, Next and the sorting are ignored.
+	CountByQuery(query MembersQuery) (uint, error)
 }
 
 type ApplicationRepository interface {
 	FetchLast(member MemberIdentifier) (*ApplicationEntity, error)
 	Save(application ApplicationEntity) error
 	FetchByID(applicationID ApplicationID) (*ApplicationEntity, error)
-	FetchByQuery(query ApplicationsQuery) ([]ApplicationEntity, error)
+	// FetchByQuery returns up to limit applications matching the filters of the query. They are ordered
+	// by CreatedAt and ID, descending if the query asks for it, and start after the cursor if there is one.
+	FetchByQuery(query ApplicationsQuery, after *ApplicationsCursor, limit uint) ([]ApplicationEntity, error)
+	// CountByQuery counts the applications matching the filters of the query
+	CountByQuery(query ApplicationsQuery) (uint, error)
+	FetchByMember(member MemberIdentifier) ([]ApplicationEntity, error)
+	// FetchExpiredClaims returns the open applications whose claim expired before now
+	FetchExpiredClaims(now time.Time) ([]ApplicationEntity, error)
+	// CountClaimed counts the open applications assigned to the reviewer
+	CountClaimed(reviewer MemberIdentifier) (uint, error)
+	// MedianReviewDuration returns the median time between submission and decision of the applications
+	// approved or rejected within the period [from, until). It's nil if no application has been decided.
+	MedianReviewDuration(from time.Time, until time.Time) (*time.Duration, error)
+	// CountDecisionsByReviewer counts the approvals and rejections of every reviewer within the period [from, until).
+	// Applications approved by vouches have no reviewer and aren't counted.
+	CountDecisionsByReviewer(from time.Time, until time.Time) ([]ReviewerStats, error)
 }
 
 type ConfirmationCodeRepository interface {
 	Fetch(emailAddress vo.EmailAddress, confirmationCode vo.ConfirmationCode) (*ConfirmationCode, error)
 	Save(cc *ConfirmationCode) error
 	Last(emailAddress vo.EmailAddress) (*ConfirmationCode, error)
+	Unused(emailAddress vo.EmailAddress) ([]*ConfirmationCode, error)
+	FetchByMember(member MemberIdentifier) ([]*ConfirmationCode, error)
 }
 
 type MemberAccessPublicKeyRepository interface {
@@ -32,4 +55,125 @@ type MemberAccessPublicKeyRepository interface {
 
 type AccessTokenRepository interface {
 	Save(accessToken *MemberAccessTokenEntity) error
+	FetchByID(accessTokenID uuid.UUID) (*MemberAccessTokenEntity, error)
+	// FetchByMember returns the access tokens issued to the member that haven't expired yet
+	FetchByMember(member MemberIdentifier) ([]MemberAccessTokenEntity, error)
+}
+
+type RoleChangeRepository interface {
+	Save(change RoleChangeEntity) error
+	FetchByMember(member MemberIdentifier) ([]RoleChangeEntity, error)
+}
+
+type UsernameChangeRepository interface {
+	Save(change UsernameChangeEntity) error
+	// FetchLastByOldUsername returns the latest change away from the username
+	FetchLastByOldUsername(username vo.Username) (*UsernameChangeEntity, error)
+	FetchLastByMember(member MemberIdentifier) (*UsernameChangeEntity, error)
+	FetchByMember(member MemberIdentifier) ([]UsernameChangeEntity, error)
+	DeleteByMember(member MemberIdentifier) error
+}
+
+type MemberDeletionRepository interface {
+	Save(deletion MemberDeletionEntity) error
+	// FetchPending returns the deletion of the member that has neither been cancelled nor executed
+	FetchPending(member MemberIdentifier) (*MemberDeletionEntity, error)
+	// FetchDue returns all pending deletions that should be erased at the given time
+	FetchDue(now time.Time) ([]MemberDeletionEntity, error)
+}
+
+type LoginRepository interface {
+	Save(login LoginEntity) error
+	FetchByMember(member MemberIdentifier) ([]LoginEntity, error)
+	// CountLogins counts the logins within the period [from, until)
+	CountLogins(from time.Time, until time.Time) (uint, error)
+	// CountActiveMembers counts the distinct members that logged in within the period [from, until)
+	CountActiveMembers(from time.Time, until time.Time) (uint, error)
+}
+
+type SanctionRepository interface {
+	Save(sanction SanctionEntity) error
+	FetchByMember(member MemberIdentifier) ([]SanctionEntity, error)
+	// IsEmailAddressBanned checks if there is a ban that hasn't been lifted for the email address
+	IsEmailAddressBanned(emailAddress vo.EmailAddress) (bool, error)
+}
+
+type InvitationRepository interface {
+	Save(invitation InvitationEntity) error
+	FetchByID(id uuid.UUID) (*InvitationEntity, error)
+	FetchByCode(code vo.InvitationCode) (*InvitationEntity, error)
+	FetchByCreator(member MemberIdentifier) ([]InvitationEntity, error)
+}
+
+type ApplicationFormRepository interface {
+	Save(form ApplicationFormEntity) error
+	// FetchActive returns the form with the highest version
+	FetchActive() (*ApplicationFormEntity, error)
+	FetchByVersion(version uint) (*ApplicationFormEntity, error)
+}
+
+type ApplicationRevisionRepository interface {
+	Save(revision ApplicationRevisionEntity) error
+	// FetchByApplication returns the revisions of the application ordered by RevisedAt
+	FetchByApplication(application ApplicationID) ([]ApplicationRevisionEntity, error)
+	DeleteByApplication(application ApplicationID) error
+}
+
+// ApplicationTransitionRepository is append only. ClearReasons is only used to erase members.
+type ApplicationTransitionRepository interface {
+	Save(transition ApplicationTransitionEntity) error
+	FetchByApplication(application ApplicationID) ([]ApplicationTransitionEntity, error)
+	ClearReasons(application ApplicationID) error
+}
+
+// AuditLogRepository stores the audit log. Append must fail if an entry with the same sequence already exists.
+// FetchByQuery returns the entries ordered by their sequence.
+type AuditLogRepository interface {
+	Append(entry AuditEntryEntity) error
+	Last() (*AuditEntryEntity, error)
+	FetchByQuery(query AuditLogQuery) ([]AuditEntryEntity, error)
+}
+
+type VerificationRevocationRepository interface {
+	Save(revocation VerificationRevocationEntity) error
+	FetchLastByMember(member MemberIdentifier) (*VerificationRevocationEntity, error)
+	FetchByMember(member MemberIdentifier) ([]VerificationRevocationEntity, error)
+}
+
+type AppealRepository interface {
+	Save(appeal AppealEntity) error
+	FetchByID(id uuid.UUID) (*AppealEntity, error)
+	FetchByApplication(application ApplicationID) (*AppealEntity, error)
+	// FetchByState returns the appeals in the given state ordered by creation time
+	FetchByState(state AppealState) ([]AppealEntity, error)
+}
+
+type ApplicationCommentRepository interface {
+	Save(comment ApplicationCommentEntity) error
+	// FetchByApplication returns the comments of the application ordered by creation time
+	FetchByApplication(application ApplicationID) ([]ApplicationCommentEntity, error)
+	DeleteByApplication(application ApplicationID) error
+}
+
+type ReviewVoteRepository interface {
+	// Save saves the vote. A reviewer has at most one vote per application, so a vote
+	// with the same id replaces the previous one.
+	Save(vote ReviewVoteEntity) error
+	FetchByApplication(application ApplicationID) ([]ReviewVoteEntity, error)
+}
+
+type VouchRepository interface {
+	Save(vouch VouchEntity) error
+	// FetchByApplication returns all vouches for the application that haven't been revoked
+	FetchByApplication(application ApplicationID) ([]VouchEntity, error)
+	// FetchByVoucherSince returns all vouches, including revoked ones, the member gave since the given time
+	FetchByVoucherSince(voucher MemberIdentifier, since time.Time) ([]VouchEntity, error)
+}
+
+// AssignmentCursorRepository keeps the reviewer that got the last application assigned by the round robin strategy.
+// It's only required by the AssignmentStrategyRoundRobin, claims don't move the cursor.
+type AssignmentCursorRepository interface {
+	// Fetch returns nil if no application has been assigned yet
+	Fetch() (*MemberIdentifier, error)
+	Save(reviewer MemberIdentifier) error
 }
@@ -0,0 +1,170 @@
+package community_bl
+
+import (
+	"errors"
+	"github.com/satori/go.uuid"
+	"sort"
+	"time"
+)
+
+type Role string
+
+func (r Role) Valid() bool {
+
+	switch r {
+	case RoleReviewer:
+		return true
+	case RoleModerator:
+		return true
+	case RoleAdmin:
+		return true
+	case RoleOwner:
+		return true
+	default:
+		return false
+	}
+
+}
+
+// rank is used to decide which roles a member is allowed to grant and revoke
+func (r Role) rank() int {
+
+	switch r {
+	case RoleReviewer, RoleModerator:
+		return 1
+	case RoleAdmin:
+		return 2
+	case RoleOwner:
+		return 3
+	default:
+		return 0
+	}
+
+}
+
+var RoleReviewer = Role("Reviewer")
+var RoleModerator = Role("Moderator")
+var RoleAdmin = Role("Admin")
+var RoleOwner = Role("Owner")
+
+type Permission string
+
+// The errors of denied authorizations. They're recorded as denied in the audit log.
+var AuthorizationErrorInsufficientPermissions = errors.New("InsufficientPermissions")
+var AuthorizationErrorNotAllowedToAccessApplication = errors.New("NotAllowedToAccessApplication")
+var AuthorizationErrorCannotDecideOwnRejection = errors.New("CannotDecideOwnRejection")
+
+var PermissionApplicationsRead = Permission("applications:read")
+var PermissionApplicationsReview = Permission("applications:review")
+var PermissionApplicationsManage = Permission("applications:manage")
+var PermissionMembersRead = Permission("members:read")
+var PermissionMembersModerate = Permission("members:moderate")
+var PermissionMembersEdit = Permission("members:edit")
+var PermissionMembersDelete = Permission("members:delete")
+var PermissionMembersExport = Permission("members:export")
+var PermissionMembersInvite = Permission("members:invite")
+var PermissionMembersPromote = Permission("members:promote")
+var PermissionAuditRead = Permission("audit:read")
+var PermissionStatsRead = Permission("stats:read")
+
+var rolePermissions = map[Role][]Permission{
+	RoleReviewer: {
+		PermissionApplicationsRead,
+		PermissionApplicationsReview,
+		PermissionMembersInvite,
+	},
+	RoleModerator: {
+		PermissionApplicationsRead,
+		PermissionMembersRead,
+		PermissionMembersModerate,
+		PermissionMembersEdit,
+	},
+	RoleAdmin: {
+		PermissionApplicationsRead,
+		PermissionApplicationsReview,
+		PermissionApplicationsManage,
+		PermissionMembersRead,
+		PermissionMembersModerate,
+		PermissionMembersEdit,
+		PermissionMembersDelete,
+		PermissionMembersExport,
+		PermissionMembersInvite,
+		PermissionMembersPromote,
+		PermissionAuditRead,
+		PermissionStatsRead,
+	},
+	RoleOwner: {
+		PermissionApplicationsRead,
+		PermissionApplicationsReview,
+		PermissionApplicationsManage,
+		PermissionMembersRead,
+		PermissionMembersModerate,
+		PermissionMembersEdit,
+		PermissionMembersDelete,
+		PermissionMembersExport,
+		PermissionMembersInvite,
+		PermissionMembersPromote,
+		PermissionAuditRead,
+		PermissionStatsRead,
+	},
+}
+
+func (r Role) Permissions() []Permission {
+	return rolePermissions[r]
+}
+
+type RoleChangeEntity struct {
+	ID        uuid.UUID
+	MemberID  MemberIdentifier
+	Role      Role
+	Granted   bool
+	ChangedBy *MemberIdentifier
+	ChangedAt time.Time
+}
+
+// membersWithPermission returns the members in good standing that have the permission, ordered by their id
+func membersWithPermission(memberRepository MemberRepository, permission Permission) ([]MemberEntity, error) {
+
+	seen := map[MemberIdentifier]bool{}
+	members := []MemberEntity{}
+
+	for _, role := range []Role{RoleReviewer, RoleModerator, RoleAdmin, RoleOwner} {
+
+		r := role
+		query := MembersQuery{
+			Next: 100,
+			Role: &r,
+		}
+
+		for {
+
+			page, err := memberRepository.FetchByQuery(query)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, member := range page {
+				if seen[member.ID] || !member.HasPermission(permission) || member.DeletedAt != nil || standing(member) != nil {
+					continue
+				}
+				seen[member.ID] = true
+				members = append(members, member)
+			}
+
+			if uint(len(page)) < query.Next {
+				break
+			}
+
+			query.Position = &page[len(page)-1].ID
+
+		}
+
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].ID.String() < members[j].ID.String()
+	})
+
+	return members, nil
+
+}
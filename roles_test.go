@@ -0,0 +1,196 @@
+package community_bl
+
+import (
+	"testing"
+)
+
+func TestRolePermissions(t *testing.T) {
+
+	cases := []struct {
+		role       Role
+		permission Permission
+		granted    bool
+	}{
+		{RoleReviewer, PermissionApplicationsReview, true},
+		{RoleReviewer, PermissionMembersRead, false},
+		{RoleReviewer, PermissionMembersModerate, false},
+		{RoleModerator, PermissionMembersModerate, true},
+		{RoleModerator, PermissionApplicationsReview, false},
+		{RoleModerator, PermissionMembersPromote, false},
+		{RoleAdmin, PermissionMembersPromote, true},
+		{RoleAdmin, PermissionAuditRead, true},
+		{RoleOwner, PermissionMembersPromote, true},
+		{Role("Unknown"), PermissionApplicationsRead, false},
+	}
+
+	for _, c := range cases {
+		member := MemberEntity{Roles: []Role{c.role}}
+		if member.HasPermission(c.permission) != c.granted {
+			t.Errorf("%s: expected %s to be granted: %t", c.role, c.permission, c.granted)
+		}
+	}
+
+	if (MemberEntity{}).HasPermission(PermissionApplicationsRead) {
+		t.Error("expected a member without roles to have no permissions")
+	}
+
+}
+
+func TestRanks(t *testing.T) {
+
+	if RoleReviewer.rank() != RoleModerator.rank() {
+		t.Error("expected reviewers and moderators to be peers")
+	}
+
+	if !(RoleModerator.rank() < RoleAdmin.rank() && RoleAdmin.rank() < RoleOwner.rank()) {
+		t.Error("expected moderators to be out ranked by admins and admins by owners")
+	}
+
+	if Role("Unknown").rank() != 0 {
+		t.Error("expected an unknown role to have no rank")
+	}
+
+	member := MemberEntity{Roles: []Role{RoleReviewer, RoleAdmin}}
+	if member.rank() != RoleAdmin.rank() {
+		t.Errorf("expected the rank of the highest role, got: %d", member.rank())
+	}
+
+	if (MemberEntity{}).rank() != 0 {
+		t.Error("expected a member without roles to have no rank")
+	}
+
+}
+
+func TestBootstrapOwner(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	member := c.signUp("member")
+	other := c.signUp("other")
+
+	// members can't promote themselves, even when there is no owner
+	expectError(t, c.Promote(member.EmailAddress, member.ID), "InsufficientPermissions")
+
+	if err := c.BootstrapOwner(member.EmailAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	owner := c.member(member.ID)
+	if !owner.HasRole(RoleOwner) || !owner.Verified {
+		t.Fatalf("expected a verified owner, got roles: %v", owner.Roles)
+	}
+
+	expectError(t, c.BootstrapOwner(other.EmailAddress), "CommunityHasOwner")
+
+	// a deleted owner still counts, so deleting the last owner doesn't reopen the bootstrap
+	if _, err := c.DeleteMember(member.ID, member.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	expectError(t, c.BootstrapOwner(other.EmailAddress), "CommunityHasOwner")
+	expectError(t, c.Promote(other.EmailAddress, other.ID), "InsufficientPermissions")
+
+}
+
+func TestPromoteGrantsAdmin(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	owner := c.signUp("owner", RoleOwner)
+	admin := c.signUp("admin", RoleAdmin)
+	member := c.signUp("member")
+
+	// admins have members:promote but don't out rank admins
+	expectError(t, c.Promote(member.EmailAddress, admin.ID), "InsufficientPermissions")
+
+	if err := c.Promote(member.EmailAddress, owner.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	promoted := c.member(member.ID)
+	if len(promoted.Roles) != 1 || !promoted.HasRole(RoleAdmin) || !promoted.Verified {
+		t.Fatalf("expected a verified admin, got roles: %v", promoted.Roles)
+	}
+
+	expectError(t, c.Promote(member.EmailAddress, owner.ID), "RoleAlreadyGranted")
+
+	last := c.auditLog.entries[len(c.auditLog.entries)-1]
+	if last.Action != AuditActionRoleGranted || last.Outcome != AuditOutcomeFailed {
+		t.Fatalf("expected the failed promotion to be recorded, got: %s %s", last.Action, last.Outcome)
+	}
+
+}
+
+func TestDemoteRevokesAdmin(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	owner := c.signUp("owner", RoleOwner)
+	admin := c.signUp("admin", RoleAdmin, RoleReviewer)
+	peer := c.signUp("peer", RoleAdmin)
+	moderator := c.signUp("moderator", RoleModerator)
+
+	expectError(t, c.Demote(admin.EmailAddress, moderator.ID), "InsufficientPermissions")
+	expectError(t, c.Demote(peer.EmailAddress, admin.ID), "InsufficientPermissions")
+	expectError(t, c.Demote(admin.EmailAddress, admin.ID), "InsufficientPermissions")
+	expectError(t, c.Demote(owner.EmailAddress, admin.ID), "InsufficientPermissions")
+	expectError(t, c.Demote(moderator.EmailAddress, owner.ID), "RoleNotGranted")
+
+	if err := c.Demote(admin.EmailAddress, owner.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// other roles are kept
+	if roles := c.member(admin.ID).Roles; len(roles) != 1 || roles[0] != RoleReviewer {
+		t.Fatalf("expected only the admin role to be revoked, got: %v", roles)
+	}
+
+}
+
+func TestGrantRoleIsLimitedByRank(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	owner := c.signUp("owner", RoleOwner)
+	admin := c.signUp("admin", RoleAdmin)
+	peer := c.signUp("peer", RoleAdmin)
+	moderator := c.signUp("moderator", RoleModerator)
+	member := c.signUp("member")
+
+	expectError(t, c.GrantRole(member.ID, RoleReviewer, moderator.ID), "InsufficientPermissions")
+	expectError(t, c.GrantRole(member.ID, RoleOwner, admin.ID), "InsufficientPermissions")
+	expectError(t, c.GrantRole(member.ID, RoleAdmin, admin.ID), "InsufficientPermissions")
+	expectError(t, c.GrantRole(owner.ID, RoleReviewer, admin.ID), "InsufficientPermissions")
+	expectError(t, c.GrantRole(peer.ID, RoleReviewer, admin.ID), "InsufficientPermissions")
+	expectError(t, c.RevokeRole(peer.ID, RoleAdmin, admin.ID), "InsufficientPermissions")
+	expectError(t, c.GrantRole(member.ID, Role("Unknown"), admin.ID), "role: 'Unknown' is invalid")
+
+	if err := c.GrantRole(member.ID, RoleModerator, admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	expectError(t, c.GrantRole(member.ID, RoleModerator, admin.ID), "RoleAlreadyGranted")
+
+	if err := c.RevokeRole(member.ID, RoleModerator, admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	expectError(t, c.RevokeRole(member.ID, RoleModerator, admin.ID), "RoleNotGranted")
+
+	// members can manage their own roles below their rank, but not their highest role
+	if err := c.GrantRole(admin.ID, RoleReviewer, admin.ID); err != nil {
+		t.Fatal(err)
+	}
+	expectError(t, c.RevokeRole(admin.ID, RoleAdmin, admin.ID), "InsufficientPermissions")
+	expectError(t, c.RevokeRole(owner.ID, RoleOwner, owner.ID), "InsufficientPermissions")
+
+	changes, err := c.RoleChanges(member.ID, admin.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(changes) != 2 || !changes[0].Granted || changes[1].Granted {
+		t.Fatalf("expected a grant and a revocation to be recorded, got: %v", changes)
+	}
+
+}
@@ -0,0 +1,105 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	bl "github.com/214alphadev/community-bl"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type memberContextKey struct{}
+
+// publicMethods can be called without an access token
+var publicMethods = map[string]bool{
+	"/community.Community/SignUp":               true,
+	"/community.Community/SignUpWithInvitation": true,
+	"/community.Community/RequestLogin":         true,
+	"/community.Community/Login":                true,
+}
+
+func authenticate(ctx context.Context, community bl.CommunityInterface) (context.Context, error) {
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	authorization := md.Get("authorization")
+	if len(authorization) != 1 {
+		return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+	}
+
+	accessToken := strings.TrimPrefix(authorization[0], "Bearer ")
+	if accessToken == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing access token")
+	}
+
+	member, err := community.GetMemberByAccessToken(accessToken)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return context.WithValue(ctx, memberContextKey{}, member), nil
+
+}
+
+func authenticatedMember(ctx context.Context) (bl.MemberEntity, error) {
+
+	member, ok := ctx.Value(memberContextKey{}).(bl.MemberEntity)
+	if !ok {
+		return bl.MemberEntity{}, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	return member, nil
+
+}
+
+// UnaryAuthInterceptor validates the access token sent in the "authorization" metadata
+// and makes the member it belongs to available to the handler.
+func UnaryAuthInterceptor(community bl.CommunityInterface) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+		if publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		ctx, err := authenticate(ctx, community)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamAuthInterceptor is the streaming counterpart of UnaryAuthInterceptor.
+func StreamAuthInterceptor(community bl.CommunityInterface) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		if publicMethods[info.FullMethod] {
+			return handler(srv, stream)
+		}
+
+		ctx, err := authenticate(stream.Context(), community)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, authenticatedStream{ServerStream: stream, ctx: ctx})
+
+	}
+}
@@ -0,0 +1,9295 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: community.proto
+
+package rpc
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Event_Type int32
+
+const (
+	Event_UNKNOWN               Event_Type = 0
+	Event_SIGNED_UP             Event_Type = 1
+	Event_LOGGED_IN             Event_Type = 2
+	Event_APPLICATION_SUBMITTED Event_Type = 3
+	Event_APPLICATION_APPROVED  Event_Type = 4
+	Event_APPLICATION_REJECTED  Event_Type = 5
+	Event_ROLE_CHANGED          Event_Type = 6
+	Event_PROFILE_CHANGED       Event_Type = 7
+	Event_MEMBER_DELETED        Event_Type = 8
+	Event_MEMBER_ERASED         Event_Type = 9
+	Event_VERIFICATION_REVOKED  Event_Type = 10
+	Event_APPLICATION_EXPIRED   Event_Type = 11
+)
+
+var Event_Type_name = map[int32]string{
+	0:  "UNKNOWN",
+	1:  "SIGNED_UP",
+	2:  "LOGGED_IN",
+	3:  "APPLICATION_SUBMITTED",
+	4:  "APPLICATION_APPROVED",
+	5:  "APPLICATION_REJECTED",
+	6:  "ROLE_CHANGED",
+	7:  "PROFILE_CHANGED",
+	8:  "MEMBER_DELETED",
+	9:  "MEMBER_ERASED",
+	10: "VERIFICATION_REVOKED",
+	11: "APPLICATION_EXPIRED",
+}
+
+var Event_Type_value = map[string]int32{
+	"UNKNOWN":               0,
+	"SIGNED_UP":             1,
+	"LOGGED_IN":             2,
+	"APPLICATION_SUBMITTED": 3,
+	"APPLICATION_APPROVED":  4,
+	"APPLICATION_REJECTED":  5,
+	"ROLE_CHANGED":          6,
+	"PROFILE_CHANGED":       7,
+	"MEMBER_DELETED":        8,
+	"MEMBER_ERASED":         9,
+	"VERIFICATION_REVOKED":  10,
+	"APPLICATION_EXPIRED":   11,
+}
+
+func (x Event_Type) String() string {
+	return proto.EnumName(Event_Type_name, int32(x))
+}
+
+func (Event_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{123, 0}
+}
+
+type Metadata struct {
+	FirstName            string   `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName             string   `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	ProfileImage         string   `protobuf:"bytes,3,opt,name=profile_image,json=profileImage,proto3" json:"profile_image,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Metadata) Reset()         { *m = Metadata{} }
+func (m *Metadata) String() string { return proto.CompactTextString(m) }
+func (*Metadata) ProtoMessage()    {}
+func (*Metadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{0}
+}
+
+func (m *Metadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Metadata.Unmarshal(m, b)
+}
+func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Metadata.Marshal(b, m, deterministic)
+}
+func (m *Metadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metadata.Merge(m, src)
+}
+func (m *Metadata) XXX_Size() int {
+	return xxx_messageInfo_Metadata.Size(m)
+}
+func (m *Metadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_Metadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Metadata proto.InternalMessageInfo
+
+func (m *Metadata) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
+	}
+	return ""
+}
+
+func (m *Metadata) GetLastName() string {
+	if m != nil {
+		return m.LastName
+	}
+	return ""
+}
+
+func (m *Metadata) GetProfileImage() string {
+	if m != nil {
+		return m.ProfileImage
+	}
+	return ""
+}
+
+type Member struct {
+	Id                   string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt            int64     `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	VerifiedEmailAddress bool      `protobuf:"varint,3,opt,name=verified_email_address,json=verifiedEmailAddress,proto3" json:"verified_email_address,omitempty"`
+	Username             string    `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	EmailAddress         string    `protobuf:"bytes,5,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	Metadata             *Metadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Verified             bool      `protobuf:"varint,8,opt,name=verified,proto3" json:"verified,omitempty"`
+	Roles                []string  `protobuf:"bytes,9,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Member) Reset()         { *m = Member{} }
+func (m *Member) String() string { return proto.CompactTextString(m) }
+func (*Member) ProtoMessage()    {}
+func (*Member) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{1}
+}
+
+func (m *Member) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Member.Unmarshal(m, b)
+}
+func (m *Member) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Member.Marshal(b, m, deterministic)
+}
+func (m *Member) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Member.Merge(m, src)
+}
+func (m *Member) XXX_Size() int {
+	return xxx_messageInfo_Member.Size(m)
+}
+func (m *Member) XXX_DiscardUnknown() {
+	xxx_messageInfo_Member.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Member proto.InternalMessageInfo
+
+func (m *Member) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Member) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Member) GetVerifiedEmailAddress() bool {
+	if m != nil {
+		return m.VerifiedEmailAddress
+	}
+	return false
+}
+
+func (m *Member) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *Member) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+func (m *Member) GetMetadata() *Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Member) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *Member) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type Application struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId             string               `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	ApplicationText      string               `protobuf:"bytes,3,opt,name=application_text,json=applicationText,proto3" json:"application_text,omitempty"`
+	State                string               `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	RejectionReason      string               `protobuf:"bytes,5,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	CreatedAt            int64                `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RejectedAt           int64                `protobuf:"varint,7,opt,name=rejected_at,json=rejectedAt,proto3" json:"rejected_at,omitempty"`
+	ApprovedAt           int64                `protobuf:"varint,8,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	RejectedBy           []string             `protobuf:"bytes,9,rep,name=rejected_by,json=rejectedBy,proto3" json:"rejected_by,omitempty"`
+	ApprovedBy           []string             `protobuf:"bytes,10,rep,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	Votes                []*ReviewVote        `protobuf:"bytes,11,rep,name=votes,proto3" json:"votes,omitempty"`
+	FormVersion          uint32               `protobuf:"varint,12,opt,name=form_version,json=formVersion,proto3" json:"form_version,omitempty"`
+	Answers              []*ApplicationAnswer `protobuf:"bytes,13,rep,name=answers,proto3" json:"answers,omitempty"`
+	WithdrawnAt          int64                `protobuf:"varint,14,opt,name=withdrawn_at,json=withdrawnAt,proto3" json:"withdrawn_at,omitempty"`
+	ReapplyAfter         int64                `protobuf:"varint,15,opt,name=reapply_after,json=reapplyAfter,proto3" json:"reapply_after,omitempty"`
+	PermanentlyRejected  bool                 `protobuf:"varint,16,opt,name=permanently_rejected,json=permanentlyRejected,proto3" json:"permanently_rejected,omitempty"`
+	AssignedTo           string               `protobuf:"bytes,17,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
+	AssignedAt           int64                `protobuf:"varint,18,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	ClaimExpiresAt       int64                `protobuf:"varint,19,opt,name=claim_expires_at,json=claimExpiresAt,proto3" json:"claim_expires_at,omitempty"`
+	EscalatedAt          int64                `protobuf:"varint,20,opt,name=escalated_at,json=escalatedAt,proto3" json:"escalated_at,omitempty"`
+	ExpiredAt            int64                `protobuf:"varint,21,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Application) Reset()         { *m = Application{} }
+func (m *Application) String() string { return proto.CompactTextString(m) }
+func (*Application) ProtoMessage()    {}
+func (*Application) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{2}
+}
+
+func (m *Application) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Application.Unmarshal(m, b)
+}
+func (m *Application) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Application.Marshal(b, m, deterministic)
+}
+func (m *Application) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Application.Merge(m, src)
+}
+func (m *Application) XXX_Size() int {
+	return xxx_messageInfo_Application.Size(m)
+}
+func (m *Application) XXX_DiscardUnknown() {
+	xxx_messageInfo_Application.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Application proto.InternalMessageInfo
+
+func (m *Application) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Application) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *Application) GetApplicationText() string {
+	if m != nil {
+		return m.ApplicationText
+	}
+	return ""
+}
+
+func (m *Application) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *Application) GetRejectionReason() string {
+	if m != nil {
+		return m.RejectionReason
+	}
+	return ""
+}
+
+func (m *Application) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Application) GetRejectedAt() int64 {
+	if m != nil {
+		return m.RejectedAt
+	}
+	return 0
+}
+
+func (m *Application) GetApprovedAt() int64 {
+	if m != nil {
+		return m.ApprovedAt
+	}
+	return 0
+}
+
+func (m *Application) GetRejectedBy() []string {
+	if m != nil {
+		return m.RejectedBy
+	}
+	return nil
+}
+
+func (m *Application) GetApprovedBy() []string {
+	if m != nil {
+		return m.ApprovedBy
+	}
+	return nil
+}
+
+func (m *Application) GetVotes() []*ReviewVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *Application) GetFormVersion() uint32 {
+	if m != nil {
+		return m.FormVersion
+	}
+	return 0
+}
+
+func (m *Application) GetAnswers() []*ApplicationAnswer {
+	if m != nil {
+		return m.Answers
+	}
+	return nil
+}
+
+func (m *Application) GetWithdrawnAt() int64 {
+	if m != nil {
+		return m.WithdrawnAt
+	}
+	return 0
+}
+
+func (m *Application) GetReapplyAfter() int64 {
+	if m != nil {
+		return m.ReapplyAfter
+	}
+	return 0
+}
+
+func (m *Application) GetPermanentlyRejected() bool {
+	if m != nil {
+		return m.PermanentlyRejected
+	}
+	return false
+}
+
+func (m *Application) GetAssignedTo() string {
+	if m != nil {
+		return m.AssignedTo
+	}
+	return ""
+}
+
+func (m *Application) GetAssignedAt() int64 {
+	if m != nil {
+		return m.AssignedAt
+	}
+	return 0
+}
+
+func (m *Application) GetClaimExpiresAt() int64 {
+	if m != nil {
+		return m.ClaimExpiresAt
+	}
+	return 0
+}
+
+func (m *Application) GetEscalatedAt() int64 {
+	if m != nil {
+		return m.EscalatedAt
+	}
+	return 0
+}
+
+func (m *Application) GetExpiredAt() int64 {
+	if m != nil {
+		return m.ExpiredAt
+	}
+	return 0
+}
+
+type Appeal struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId        string   `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	MemberId             string   `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Statement            string   `protobuf:"bytes,4,opt,name=statement,proto3" json:"statement,omitempty"`
+	State                string   `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DecidedBy            string   `protobuf:"bytes,7,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	DecidedAt            int64    `protobuf:"varint,8,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	Decision             string   `protobuf:"bytes,9,opt,name=decision,proto3" json:"decision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Appeal) Reset()         { *m = Appeal{} }
+func (m *Appeal) String() string { return proto.CompactTextString(m) }
+func (*Appeal) ProtoMessage()    {}
+func (*Appeal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{3}
+}
+
+func (m *Appeal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Appeal.Unmarshal(m, b)
+}
+func (m *Appeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Appeal.Marshal(b, m, deterministic)
+}
+func (m *Appeal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Appeal.Merge(m, src)
+}
+func (m *Appeal) XXX_Size() int {
+	return xxx_messageInfo_Appeal.Size(m)
+}
+func (m *Appeal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Appeal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Appeal proto.InternalMessageInfo
+
+func (m *Appeal) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Appeal) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+func (m *Appeal) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *Appeal) GetStatement() string {
+	if m != nil {
+		return m.Statement
+	}
+	return ""
+}
+
+func (m *Appeal) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *Appeal) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Appeal) GetDecidedBy() string {
+	if m != nil {
+		return m.DecidedBy
+	}
+	return ""
+}
+
+func (m *Appeal) GetDecidedAt() int64 {
+	if m != nil {
+		return m.DecidedAt
+	}
+	return 0
+}
+
+func (m *Appeal) GetDecision() string {
+	if m != nil {
+		return m.Decision
+	}
+	return ""
+}
+
+type FileAppealRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Statement            string   `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FileAppealRequest) Reset()         { *m = FileAppealRequest{} }
+func (m *FileAppealRequest) String() string { return proto.CompactTextString(m) }
+func (*FileAppealRequest) ProtoMessage()    {}
+func (*FileAppealRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{4}
+}
+
+func (m *FileAppealRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileAppealRequest.Unmarshal(m, b)
+}
+func (m *FileAppealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileAppealRequest.Marshal(b, m, deterministic)
+}
+func (m *FileAppealRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileAppealRequest.Merge(m, src)
+}
+func (m *FileAppealRequest) XXX_Size() int {
+	return xxx_messageInfo_FileAppealRequest.Size(m)
+}
+func (m *FileAppealRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileAppealRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileAppealRequest proto.InternalMessageInfo
+
+func (m *FileAppealRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+func (m *FileAppealRequest) GetStatement() string {
+	if m != nil {
+		return m.Statement
+	}
+	return ""
+}
+
+type ApplicationAppealRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationAppealRequest) Reset()         { *m = ApplicationAppealRequest{} }
+func (m *ApplicationAppealRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationAppealRequest) ProtoMessage()    {}
+func (*ApplicationAppealRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{5}
+}
+
+func (m *ApplicationAppealRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationAppealRequest.Unmarshal(m, b)
+}
+func (m *ApplicationAppealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationAppealRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplicationAppealRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationAppealRequest.Merge(m, src)
+}
+func (m *ApplicationAppealRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplicationAppealRequest.Size(m)
+}
+func (m *ApplicationAppealRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationAppealRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationAppealRequest proto.InternalMessageInfo
+
+func (m *ApplicationAppealRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+type DecideAppealRequest struct {
+	AppealId             string   `protobuf:"bytes,1,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"`
+	Decision             string   `protobuf:"bytes,2,opt,name=decision,proto3" json:"decision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecideAppealRequest) Reset()         { *m = DecideAppealRequest{} }
+func (m *DecideAppealRequest) String() string { return proto.CompactTextString(m) }
+func (*DecideAppealRequest) ProtoMessage()    {}
+func (*DecideAppealRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{6}
+}
+
+func (m *DecideAppealRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecideAppealRequest.Unmarshal(m, b)
+}
+func (m *DecideAppealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecideAppealRequest.Marshal(b, m, deterministic)
+}
+func (m *DecideAppealRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecideAppealRequest.Merge(m, src)
+}
+func (m *DecideAppealRequest) XXX_Size() int {
+	return xxx_messageInfo_DecideAppealRequest.Size(m)
+}
+func (m *DecideAppealRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecideAppealRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecideAppealRequest proto.InternalMessageInfo
+
+func (m *DecideAppealRequest) GetAppealId() string {
+	if m != nil {
+		return m.AppealId
+	}
+	return ""
+}
+
+func (m *DecideAppealRequest) GetDecision() string {
+	if m != nil {
+		return m.Decision
+	}
+	return ""
+}
+
+type DecideAppealResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecideAppealResponse) Reset()         { *m = DecideAppealResponse{} }
+func (m *DecideAppealResponse) String() string { return proto.CompactTextString(m) }
+func (*DecideAppealResponse) ProtoMessage()    {}
+func (*DecideAppealResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{7}
+}
+
+func (m *DecideAppealResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecideAppealResponse.Unmarshal(m, b)
+}
+func (m *DecideAppealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecideAppealResponse.Marshal(b, m, deterministic)
+}
+func (m *DecideAppealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecideAppealResponse.Merge(m, src)
+}
+func (m *DecideAppealResponse) XXX_Size() int {
+	return xxx_messageInfo_DecideAppealResponse.Size(m)
+}
+func (m *DecideAppealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecideAppealResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecideAppealResponse proto.InternalMessageInfo
+
+type AppealsRequest struct {
+	// an empty state returns the pending appeals
+	State                string   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AppealsRequest) Reset()         { *m = AppealsRequest{} }
+func (m *AppealsRequest) String() string { return proto.CompactTextString(m) }
+func (*AppealsRequest) ProtoMessage()    {}
+func (*AppealsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{8}
+}
+
+func (m *AppealsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppealsRequest.Unmarshal(m, b)
+}
+func (m *AppealsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppealsRequest.Marshal(b, m, deterministic)
+}
+func (m *AppealsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppealsRequest.Merge(m, src)
+}
+func (m *AppealsRequest) XXX_Size() int {
+	return xxx_messageInfo_AppealsRequest.Size(m)
+}
+func (m *AppealsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppealsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppealsRequest proto.InternalMessageInfo
+
+func (m *AppealsRequest) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+type AppealsResponse struct {
+	Appeals              []*Appeal `protobuf:"bytes,1,rep,name=appeals,proto3" json:"appeals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *AppealsResponse) Reset()         { *m = AppealsResponse{} }
+func (m *AppealsResponse) String() string { return proto.CompactTextString(m) }
+func (*AppealsResponse) ProtoMessage()    {}
+func (*AppealsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{9}
+}
+
+func (m *AppealsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppealsResponse.Unmarshal(m, b)
+}
+func (m *AppealsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppealsResponse.Marshal(b, m, deterministic)
+}
+func (m *AppealsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppealsResponse.Merge(m, src)
+}
+func (m *AppealsResponse) XXX_Size() int {
+	return xxx_messageInfo_AppealsResponse.Size(m)
+}
+func (m *AppealsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppealsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppealsResponse proto.InternalMessageInfo
+
+func (m *AppealsResponse) GetAppeals() []*Appeal {
+	if m != nil {
+		return m.Appeals
+	}
+	return nil
+}
+
+type ApplicationRevision struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationText      string               `protobuf:"bytes,2,opt,name=application_text,json=applicationText,proto3" json:"application_text,omitempty"`
+	FormVersion          uint32               `protobuf:"varint,3,opt,name=form_version,json=formVersion,proto3" json:"form_version,omitempty"`
+	Answers              []*ApplicationAnswer `protobuf:"bytes,4,rep,name=answers,proto3" json:"answers,omitempty"`
+	RevisedAt            int64                `protobuf:"varint,5,opt,name=revised_at,json=revisedAt,proto3" json:"revised_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ApplicationRevision) Reset()         { *m = ApplicationRevision{} }
+func (m *ApplicationRevision) String() string { return proto.CompactTextString(m) }
+func (*ApplicationRevision) ProtoMessage()    {}
+func (*ApplicationRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{10}
+}
+
+func (m *ApplicationRevision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationRevision.Unmarshal(m, b)
+}
+func (m *ApplicationRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationRevision.Marshal(b, m, deterministic)
+}
+func (m *ApplicationRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationRevision.Merge(m, src)
+}
+func (m *ApplicationRevision) XXX_Size() int {
+	return xxx_messageInfo_ApplicationRevision.Size(m)
+}
+func (m *ApplicationRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationRevision proto.InternalMessageInfo
+
+func (m *ApplicationRevision) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ApplicationRevision) GetApplicationText() string {
+	if m != nil {
+		return m.ApplicationText
+	}
+	return ""
+}
+
+func (m *ApplicationRevision) GetFormVersion() uint32 {
+	if m != nil {
+		return m.FormVersion
+	}
+	return 0
+}
+
+func (m *ApplicationRevision) GetAnswers() []*ApplicationAnswer {
+	if m != nil {
+		return m.Answers
+	}
+	return nil
+}
+
+func (m *ApplicationRevision) GetRevisedAt() int64 {
+	if m != nil {
+		return m.RevisedAt
+	}
+	return 0
+}
+
+type EditApplicationRequest struct {
+	ApplicationId        string               `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	ApplicationText      string               `protobuf:"bytes,2,opt,name=application_text,json=applicationText,proto3" json:"application_text,omitempty"`
+	Answers              []*ApplicationAnswer `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *EditApplicationRequest) Reset()         { *m = EditApplicationRequest{} }
+func (m *EditApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*EditApplicationRequest) ProtoMessage()    {}
+func (*EditApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{11}
+}
+
+func (m *EditApplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EditApplicationRequest.Unmarshal(m, b)
+}
+func (m *EditApplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EditApplicationRequest.Marshal(b, m, deterministic)
+}
+func (m *EditApplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditApplicationRequest.Merge(m, src)
+}
+func (m *EditApplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_EditApplicationRequest.Size(m)
+}
+func (m *EditApplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditApplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EditApplicationRequest proto.InternalMessageInfo
+
+func (m *EditApplicationRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+func (m *EditApplicationRequest) GetApplicationText() string {
+	if m != nil {
+		return m.ApplicationText
+	}
+	return ""
+}
+
+func (m *EditApplicationRequest) GetAnswers() []*ApplicationAnswer {
+	if m != nil {
+		return m.Answers
+	}
+	return nil
+}
+
+type WithdrawApplicationRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WithdrawApplicationRequest) Reset()         { *m = WithdrawApplicationRequest{} }
+func (m *WithdrawApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*WithdrawApplicationRequest) ProtoMessage()    {}
+func (*WithdrawApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{12}
+}
+
+func (m *WithdrawApplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawApplicationRequest.Unmarshal(m, b)
+}
+func (m *WithdrawApplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawApplicationRequest.Marshal(b, m, deterministic)
+}
+func (m *WithdrawApplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawApplicationRequest.Merge(m, src)
+}
+func (m *WithdrawApplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_WithdrawApplicationRequest.Size(m)
+}
+func (m *WithdrawApplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawApplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawApplicationRequest proto.InternalMessageInfo
+
+func (m *WithdrawApplicationRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+type WithdrawApplicationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WithdrawApplicationResponse) Reset()         { *m = WithdrawApplicationResponse{} }
+func (m *WithdrawApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*WithdrawApplicationResponse) ProtoMessage()    {}
+func (*WithdrawApplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{13}
+}
+
+func (m *WithdrawApplicationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawApplicationResponse.Unmarshal(m, b)
+}
+func (m *WithdrawApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawApplicationResponse.Marshal(b, m, deterministic)
+}
+func (m *WithdrawApplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawApplicationResponse.Merge(m, src)
+}
+func (m *WithdrawApplicationResponse) XXX_Size() int {
+	return xxx_messageInfo_WithdrawApplicationResponse.Size(m)
+}
+func (m *WithdrawApplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawApplicationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawApplicationResponse proto.InternalMessageInfo
+
+type ApplicationRevisionsRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationRevisionsRequest) Reset()         { *m = ApplicationRevisionsRequest{} }
+func (m *ApplicationRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationRevisionsRequest) ProtoMessage()    {}
+func (*ApplicationRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{14}
+}
+
+func (m *ApplicationRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationRevisionsRequest.Unmarshal(m, b)
+}
+func (m *ApplicationRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationRevisionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplicationRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationRevisionsRequest.Merge(m, src)
+}
+func (m *ApplicationRevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplicationRevisionsRequest.Size(m)
+}
+func (m *ApplicationRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationRevisionsRequest proto.InternalMessageInfo
+
+func (m *ApplicationRevisionsRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+type ApplicationRevisionsResponse struct {
+	Revisions            []*ApplicationRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ApplicationRevisionsResponse) Reset()         { *m = ApplicationRevisionsResponse{} }
+func (m *ApplicationRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationRevisionsResponse) ProtoMessage()    {}
+func (*ApplicationRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{15}
+}
+
+func (m *ApplicationRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationRevisionsResponse.Unmarshal(m, b)
+}
+func (m *ApplicationRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationRevisionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ApplicationRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationRevisionsResponse.Merge(m, src)
+}
+func (m *ApplicationRevisionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ApplicationRevisionsResponse.Size(m)
+}
+func (m *ApplicationRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationRevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationRevisionsResponse proto.InternalMessageInfo
+
+func (m *ApplicationRevisionsResponse) GetRevisions() []*ApplicationRevision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+type ApplicationAnswer struct {
+	QuestionId           string   `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	File                 string   `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationAnswer) Reset()         { *m = ApplicationAnswer{} }
+func (m *ApplicationAnswer) String() string { return proto.CompactTextString(m) }
+func (*ApplicationAnswer) ProtoMessage()    {}
+func (*ApplicationAnswer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{16}
+}
+
+func (m *ApplicationAnswer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationAnswer.Unmarshal(m, b)
+}
+func (m *ApplicationAnswer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationAnswer.Marshal(b, m, deterministic)
+}
+func (m *ApplicationAnswer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationAnswer.Merge(m, src)
+}
+func (m *ApplicationAnswer) XXX_Size() int {
+	return xxx_messageInfo_ApplicationAnswer.Size(m)
+}
+func (m *ApplicationAnswer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationAnswer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationAnswer proto.InternalMessageInfo
+
+func (m *ApplicationAnswer) GetQuestionId() string {
+	if m != nil {
+		return m.QuestionId
+	}
+	return ""
+}
+
+func (m *ApplicationAnswer) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ApplicationAnswer) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+type FormQuestion struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Type                 string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Required             bool     `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	MinLength            uint32   `protobuf:"varint,5,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	MaxLength            uint32   `protobuf:"varint,6,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	Choices              []string `protobuf:"bytes,7,rep,name=choices,proto3" json:"choices,omitempty"`
+	MaxFileSize          uint32   `protobuf:"varint,8,opt,name=max_file_size,json=maxFileSize,proto3" json:"max_file_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FormQuestion) Reset()         { *m = FormQuestion{} }
+func (m *FormQuestion) String() string { return proto.CompactTextString(m) }
+func (*FormQuestion) ProtoMessage()    {}
+func (*FormQuestion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{17}
+}
+
+func (m *FormQuestion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FormQuestion.Unmarshal(m, b)
+}
+func (m *FormQuestion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FormQuestion.Marshal(b, m, deterministic)
+}
+func (m *FormQuestion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FormQuestion.Merge(m, src)
+}
+func (m *FormQuestion) XXX_Size() int {
+	return xxx_messageInfo_FormQuestion.Size(m)
+}
+func (m *FormQuestion) XXX_DiscardUnknown() {
+	xxx_messageInfo_FormQuestion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FormQuestion proto.InternalMessageInfo
+
+func (m *FormQuestion) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *FormQuestion) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *FormQuestion) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *FormQuestion) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+func (m *FormQuestion) GetMinLength() uint32 {
+	if m != nil {
+		return m.MinLength
+	}
+	return 0
+}
+
+func (m *FormQuestion) GetMaxLength() uint32 {
+	if m != nil {
+		return m.MaxLength
+	}
+	return 0
+}
+
+func (m *FormQuestion) GetChoices() []string {
+	if m != nil {
+		return m.Choices
+	}
+	return nil
+}
+
+func (m *FormQuestion) GetMaxFileSize() uint32 {
+	if m != nil {
+		return m.MaxFileSize
+	}
+	return 0
+}
+
+type ApplicationForm struct {
+	Id                   string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version              uint32          `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Questions            []*FormQuestion `protobuf:"bytes,3,rep,name=questions,proto3" json:"questions,omitempty"`
+	PublishedAt          int64           `protobuf:"varint,4,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ApplicationForm) Reset()         { *m = ApplicationForm{} }
+func (m *ApplicationForm) String() string { return proto.CompactTextString(m) }
+func (*ApplicationForm) ProtoMessage()    {}
+func (*ApplicationForm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{18}
+}
+
+func (m *ApplicationForm) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationForm.Unmarshal(m, b)
+}
+func (m *ApplicationForm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationForm.Marshal(b, m, deterministic)
+}
+func (m *ApplicationForm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationForm.Merge(m, src)
+}
+func (m *ApplicationForm) XXX_Size() int {
+	return xxx_messageInfo_ApplicationForm.Size(m)
+}
+func (m *ApplicationForm) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationForm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationForm proto.InternalMessageInfo
+
+func (m *ApplicationForm) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ApplicationForm) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ApplicationForm) GetQuestions() []*FormQuestion {
+	if m != nil {
+		return m.Questions
+	}
+	return nil
+}
+
+func (m *ApplicationForm) GetPublishedAt() int64 {
+	if m != nil {
+		return m.PublishedAt
+	}
+	return 0
+}
+
+type ApplicationFormRequest struct {
+	// version zero returns the active form
+	Version              uint32   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationFormRequest) Reset()         { *m = ApplicationFormRequest{} }
+func (m *ApplicationFormRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationFormRequest) ProtoMessage()    {}
+func (*ApplicationFormRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{19}
+}
+
+func (m *ApplicationFormRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationFormRequest.Unmarshal(m, b)
+}
+func (m *ApplicationFormRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationFormRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplicationFormRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationFormRequest.Merge(m, src)
+}
+func (m *ApplicationFormRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplicationFormRequest.Size(m)
+}
+func (m *ApplicationFormRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationFormRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationFormRequest proto.InternalMessageInfo
+
+func (m *ApplicationFormRequest) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type PublishApplicationFormRequest struct {
+	// the ids of the questions are used as is, empty ids are rejected
+	Questions            []*FormQuestion `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PublishApplicationFormRequest) Reset()         { *m = PublishApplicationFormRequest{} }
+func (m *PublishApplicationFormRequest) String() string { return proto.CompactTextString(m) }
+func (*PublishApplicationFormRequest) ProtoMessage()    {}
+func (*PublishApplicationFormRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{20}
+}
+
+func (m *PublishApplicationFormRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishApplicationFormRequest.Unmarshal(m, b)
+}
+func (m *PublishApplicationFormRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishApplicationFormRequest.Marshal(b, m, deterministic)
+}
+func (m *PublishApplicationFormRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishApplicationFormRequest.Merge(m, src)
+}
+func (m *PublishApplicationFormRequest) XXX_Size() int {
+	return xxx_messageInfo_PublishApplicationFormRequest.Size(m)
+}
+func (m *PublishApplicationFormRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishApplicationFormRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishApplicationFormRequest proto.InternalMessageInfo
+
+func (m *PublishApplicationFormRequest) GetQuestions() []*FormQuestion {
+	if m != nil {
+		return m.Questions
+	}
+	return nil
+}
+
+type ReviewVote struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewerId           string   `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Decision             string   `protobuf:"bytes,3,opt,name=decision,proto3" json:"decision,omitempty"`
+	Comment              string   `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	CastAt               int64    `protobuf:"varint,5,opt,name=cast_at,json=castAt,proto3" json:"cast_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReviewVote) Reset()         { *m = ReviewVote{} }
+func (m *ReviewVote) String() string { return proto.CompactTextString(m) }
+func (*ReviewVote) ProtoMessage()    {}
+func (*ReviewVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{21}
+}
+
+func (m *ReviewVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReviewVote.Unmarshal(m, b)
+}
+func (m *ReviewVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReviewVote.Marshal(b, m, deterministic)
+}
+func (m *ReviewVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewVote.Merge(m, src)
+}
+func (m *ReviewVote) XXX_Size() int {
+	return xxx_messageInfo_ReviewVote.Size(m)
+}
+func (m *ReviewVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReviewVote proto.InternalMessageInfo
+
+func (m *ReviewVote) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ReviewVote) GetReviewerId() string {
+	if m != nil {
+		return m.ReviewerId
+	}
+	return ""
+}
+
+func (m *ReviewVote) GetDecision() string {
+	if m != nil {
+		return m.Decision
+	}
+	return ""
+}
+
+func (m *ReviewVote) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *ReviewVote) GetCastAt() int64 {
+	if m != nil {
+		return m.CastAt
+	}
+	return 0
+}
+
+type AccessToken struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject              string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	IssuedAt             int64    `protobuf:"varint,3,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	SignedAccessToken    string   `protobuf:"bytes,5,opt,name=signed_access_token,json=signedAccessToken,proto3" json:"signed_access_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccessToken) Reset()         { *m = AccessToken{} }
+func (m *AccessToken) String() string { return proto.CompactTextString(m) }
+func (*AccessToken) ProtoMessage()    {}
+func (*AccessToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{22}
+}
+
+func (m *AccessToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessToken.Unmarshal(m, b)
+}
+func (m *AccessToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessToken.Marshal(b, m, deterministic)
+}
+func (m *AccessToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessToken.Merge(m, src)
+}
+func (m *AccessToken) XXX_Size() int {
+	return xxx_messageInfo_AccessToken.Size(m)
+}
+func (m *AccessToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessToken proto.InternalMessageInfo
+
+func (m *AccessToken) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AccessToken) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *AccessToken) GetIssuedAt() int64 {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return 0
+}
+
+func (m *AccessToken) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *AccessToken) GetSignedAccessToken() string {
+	if m != nil {
+		return m.SignedAccessToken
+	}
+	return ""
+}
+
+type SignUpRequest struct {
+	Username             string    `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	EmailAddress         string    `protobuf:"bytes,2,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	Metadata             *Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SignUpRequest) Reset()         { *m = SignUpRequest{} }
+func (m *SignUpRequest) String() string { return proto.CompactTextString(m) }
+func (*SignUpRequest) ProtoMessage()    {}
+func (*SignUpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{23}
+}
+
+func (m *SignUpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignUpRequest.Unmarshal(m, b)
+}
+func (m *SignUpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignUpRequest.Marshal(b, m, deterministic)
+}
+func (m *SignUpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignUpRequest.Merge(m, src)
+}
+func (m *SignUpRequest) XXX_Size() int {
+	return xxx_messageInfo_SignUpRequest.Size(m)
+}
+func (m *SignUpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignUpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignUpRequest proto.InternalMessageInfo
+
+func (m *SignUpRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *SignUpRequest) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+func (m *SignUpRequest) GetMetadata() *Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type SignUpWithInvitationRequest struct {
+	Username             string    `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	EmailAddress         string    `protobuf:"bytes,2,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	Metadata             *Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	InvitationCode       string    `protobuf:"bytes,4,opt,name=invitation_code,json=invitationCode,proto3" json:"invitation_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SignUpWithInvitationRequest) Reset()         { *m = SignUpWithInvitationRequest{} }
+func (m *SignUpWithInvitationRequest) String() string { return proto.CompactTextString(m) }
+func (*SignUpWithInvitationRequest) ProtoMessage()    {}
+func (*SignUpWithInvitationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{24}
+}
+
+func (m *SignUpWithInvitationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignUpWithInvitationRequest.Unmarshal(m, b)
+}
+func (m *SignUpWithInvitationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignUpWithInvitationRequest.Marshal(b, m, deterministic)
+}
+func (m *SignUpWithInvitationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignUpWithInvitationRequest.Merge(m, src)
+}
+func (m *SignUpWithInvitationRequest) XXX_Size() int {
+	return xxx_messageInfo_SignUpWithInvitationRequest.Size(m)
+}
+func (m *SignUpWithInvitationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignUpWithInvitationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignUpWithInvitationRequest proto.InternalMessageInfo
+
+func (m *SignUpWithInvitationRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *SignUpWithInvitationRequest) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+func (m *SignUpWithInvitationRequest) GetMetadata() *Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *SignUpWithInvitationRequest) GetInvitationCode() string {
+	if m != nil {
+		return m.InvitationCode
+	}
+	return ""
+}
+
+type RequestLoginRequest struct {
+	EmailAddress         string   `protobuf:"bytes,1,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestLoginRequest) Reset()         { *m = RequestLoginRequest{} }
+func (m *RequestLoginRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestLoginRequest) ProtoMessage()    {}
+func (*RequestLoginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{25}
+}
+
+func (m *RequestLoginRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestLoginRequest.Unmarshal(m, b)
+}
+func (m *RequestLoginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestLoginRequest.Marshal(b, m, deterministic)
+}
+func (m *RequestLoginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestLoginRequest.Merge(m, src)
+}
+func (m *RequestLoginRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestLoginRequest.Size(m)
+}
+func (m *RequestLoginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestLoginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestLoginRequest proto.InternalMessageInfo
+
+func (m *RequestLoginRequest) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+type RequestLoginResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestLoginResponse) Reset()         { *m = RequestLoginResponse{} }
+func (m *RequestLoginResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestLoginResponse) ProtoMessage()    {}
+func (*RequestLoginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{26}
+}
+
+func (m *RequestLoginResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestLoginResponse.Unmarshal(m, b)
+}
+func (m *RequestLoginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestLoginResponse.Marshal(b, m, deterministic)
+}
+func (m *RequestLoginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestLoginResponse.Merge(m, src)
+}
+func (m *RequestLoginResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestLoginResponse.Size(m)
+}
+func (m *RequestLoginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestLoginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestLoginResponse proto.InternalMessageInfo
+
+type LoginRequest struct {
+	EmailAddress          string   `protobuf:"bytes,1,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	MemberAccessPublicKey []byte   `protobuf:"bytes,2,opt,name=member_access_public_key,json=memberAccessPublicKey,proto3" json:"member_access_public_key,omitempty"`
+	ConfirmationCode      string   `protobuf:"bytes,3,opt,name=confirmation_code,json=confirmationCode,proto3" json:"confirmation_code,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *LoginRequest) Reset()         { *m = LoginRequest{} }
+func (m *LoginRequest) String() string { return proto.CompactTextString(m) }
+func (*LoginRequest) ProtoMessage()    {}
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{27}
+}
+
+func (m *LoginRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoginRequest.Unmarshal(m, b)
+}
+func (m *LoginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoginRequest.Marshal(b, m, deterministic)
+}
+func (m *LoginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginRequest.Merge(m, src)
+}
+func (m *LoginRequest) XXX_Size() int {
+	return xxx_messageInfo_LoginRequest.Size(m)
+}
+func (m *LoginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoginRequest proto.InternalMessageInfo
+
+func (m *LoginRequest) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+func (m *LoginRequest) GetMemberAccessPublicKey() []byte {
+	if m != nil {
+		return m.MemberAccessPublicKey
+	}
+	return nil
+}
+
+func (m *LoginRequest) GetConfirmationCode() string {
+	if m != nil {
+		return m.ConfirmationCode
+	}
+	return ""
+}
+
+type ApplyForVerificationRequest struct {
+	ApplicationText string `protobuf:"bytes,1,opt,name=application_text,json=applicationText,proto3" json:"application_text,omitempty"`
+	// answers to the active application form. Required once a form has been published.
+	Answers              []*ApplicationAnswer `protobuf:"bytes,2,rep,name=answers,proto3" json:"answers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ApplyForVerificationRequest) Reset()         { *m = ApplyForVerificationRequest{} }
+func (m *ApplyForVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplyForVerificationRequest) ProtoMessage()    {}
+func (*ApplyForVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{28}
+}
+
+func (m *ApplyForVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyForVerificationRequest.Unmarshal(m, b)
+}
+func (m *ApplyForVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyForVerificationRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplyForVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyForVerificationRequest.Merge(m, src)
+}
+func (m *ApplyForVerificationRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplyForVerificationRequest.Size(m)
+}
+func (m *ApplyForVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyForVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyForVerificationRequest proto.InternalMessageInfo
+
+func (m *ApplyForVerificationRequest) GetApplicationText() string {
+	if m != nil {
+		return m.ApplicationText
+	}
+	return ""
+}
+
+func (m *ApplyForVerificationRequest) GetAnswers() []*ApplicationAnswer {
+	if m != nil {
+		return m.Answers
+	}
+	return nil
+}
+
+type ApproveApplicationRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApproveApplicationRequest) Reset()         { *m = ApproveApplicationRequest{} }
+func (m *ApproveApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*ApproveApplicationRequest) ProtoMessage()    {}
+func (*ApproveApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{29}
+}
+
+func (m *ApproveApplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApproveApplicationRequest.Unmarshal(m, b)
+}
+func (m *ApproveApplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApproveApplicationRequest.Marshal(b, m, deterministic)
+}
+func (m *ApproveApplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApproveApplicationRequest.Merge(m, src)
+}
+func (m *ApproveApplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_ApproveApplicationRequest.Size(m)
+}
+func (m *ApproveApplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApproveApplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApproveApplicationRequest proto.InternalMessageInfo
+
+func (m *ApproveApplicationRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+type ApproveApplicationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApproveApplicationResponse) Reset()         { *m = ApproveApplicationResponse{} }
+func (m *ApproveApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*ApproveApplicationResponse) ProtoMessage()    {}
+func (*ApproveApplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{30}
+}
+
+func (m *ApproveApplicationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApproveApplicationResponse.Unmarshal(m, b)
+}
+func (m *ApproveApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApproveApplicationResponse.Marshal(b, m, deterministic)
+}
+func (m *ApproveApplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApproveApplicationResponse.Merge(m, src)
+}
+func (m *ApproveApplicationResponse) XXX_Size() int {
+	return xxx_messageInfo_ApproveApplicationResponse.Size(m)
+}
+func (m *ApproveApplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApproveApplicationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApproveApplicationResponse proto.InternalMessageInfo
+
+type RejectApplicationRequest struct {
+	ApplicationId string `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// override_cool_down replaces the cool down of the reapplication policy with cool_down_seconds
+	OverrideCoolDown     bool     `protobuf:"varint,3,opt,name=override_cool_down,json=overrideCoolDown,proto3" json:"override_cool_down,omitempty"`
+	CoolDownSeconds      int64    `protobuf:"varint,4,opt,name=cool_down_seconds,json=coolDownSeconds,proto3" json:"cool_down_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RejectApplicationRequest) Reset()         { *m = RejectApplicationRequest{} }
+func (m *RejectApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*RejectApplicationRequest) ProtoMessage()    {}
+func (*RejectApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{31}
+}
+
+func (m *RejectApplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RejectApplicationRequest.Unmarshal(m, b)
+}
+func (m *RejectApplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RejectApplicationRequest.Marshal(b, m, deterministic)
+}
+func (m *RejectApplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectApplicationRequest.Merge(m, src)
+}
+func (m *RejectApplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_RejectApplicationRequest.Size(m)
+}
+func (m *RejectApplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectApplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectApplicationRequest proto.InternalMessageInfo
+
+func (m *RejectApplicationRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+func (m *RejectApplicationRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RejectApplicationRequest) GetOverrideCoolDown() bool {
+	if m != nil {
+		return m.OverrideCoolDown
+	}
+	return false
+}
+
+func (m *RejectApplicationRequest) GetCoolDownSeconds() int64 {
+	if m != nil {
+		return m.CoolDownSeconds
+	}
+	return 0
+}
+
+type RejectApplicationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RejectApplicationResponse) Reset()         { *m = RejectApplicationResponse{} }
+func (m *RejectApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*RejectApplicationResponse) ProtoMessage()    {}
+func (*RejectApplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{32}
+}
+
+func (m *RejectApplicationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RejectApplicationResponse.Unmarshal(m, b)
+}
+func (m *RejectApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RejectApplicationResponse.Marshal(b, m, deterministic)
+}
+func (m *RejectApplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectApplicationResponse.Merge(m, src)
+}
+func (m *RejectApplicationResponse) XXX_Size() int {
+	return xxx_messageInfo_RejectApplicationResponse.Size(m)
+}
+func (m *RejectApplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectApplicationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectApplicationResponse proto.InternalMessageInfo
+
+type ApplicationsRequest struct {
+	Next uint32 `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+	// state is combined with the states
+	State         string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	AssignedTo    string `protobuf:"bytes,4,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
+	Unassigned    bool   `protobuf:"varint,5,opt,name=unassigned,proto3" json:"unassigned,omitempty"`
+	CreatedAfter  int64  `protobuf:"varint,6,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore int64  `protobuf:"varint,7,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// cursor is the next_cursor of the previous response
+	Cursor               string   `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	States               []string `protobuf:"bytes,9,rep,name=states,proto3" json:"states,omitempty"`
+	MemberId             string   `protobuf:"bytes,10,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	ReviewerId           string   `protobuf:"bytes,11,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	ApprovedAfter        int64    `protobuf:"varint,12,opt,name=approved_after,json=approvedAfter,proto3" json:"approved_after,omitempty"`
+	ApprovedBefore       int64    `protobuf:"varint,13,opt,name=approved_before,json=approvedBefore,proto3" json:"approved_before,omitempty"`
+	RejectedAfter        int64    `protobuf:"varint,14,opt,name=rejected_after,json=rejectedAfter,proto3" json:"rejected_after,omitempty"`
+	RejectedBefore       int64    `protobuf:"varint,15,opt,name=rejected_before,json=rejectedBefore,proto3" json:"rejected_before,omitempty"`
+	Search               string   `protobuf:"bytes,16,opt,name=search,proto3" json:"search,omitempty"`
+	SortDescending       bool     `protobuf:"varint,17,opt,name=sort_descending,json=sortDescending,proto3" json:"sort_descending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationsRequest) Reset()         { *m = ApplicationsRequest{} }
+func (m *ApplicationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationsRequest) ProtoMessage()    {}
+func (*ApplicationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{33}
+}
+
+func (m *ApplicationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationsRequest.Unmarshal(m, b)
+}
+func (m *ApplicationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationsRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplicationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationsRequest.Merge(m, src)
+}
+func (m *ApplicationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplicationsRequest.Size(m)
+}
+func (m *ApplicationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationsRequest proto.InternalMessageInfo
+
+func (m *ApplicationsRequest) GetNext() uint32 {
+	if m != nil {
+		return m.Next
+	}
+	return 0
+}
+
+func (m *ApplicationsRequest) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ApplicationsRequest) GetAssignedTo() string {
+	if m != nil {
+		return m.AssignedTo
+	}
+	return ""
+}
+
+func (m *ApplicationsRequest) GetUnassigned() bool {
+	if m != nil {
+		return m.Unassigned
+	}
+	return false
+}
+
+func (m *ApplicationsRequest) GetCreatedAfter() int64 {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return 0
+}
+
+func (m *ApplicationsRequest) GetCreatedBefore() int64 {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return 0
+}
+
+func (m *ApplicationsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ApplicationsRequest) GetStates() []string {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *ApplicationsRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *ApplicationsRequest) GetReviewerId() string {
+	if m != nil {
+		return m.ReviewerId
+	}
+	return ""
+}
+
+func (m *ApplicationsRequest) GetApprovedAfter() int64 {
+	if m != nil {
+		return m.ApprovedAfter
+	}
+	return 0
+}
+
+func (m *ApplicationsRequest) GetApprovedBefore() int64 {
+	if m != nil {
+		return m.ApprovedBefore
+	}
+	return 0
+}
+
+func (m *ApplicationsRequest) GetRejectedAfter() int64 {
+	if m != nil {
+		return m.RejectedAfter
+	}
+	return 0
+}
+
+func (m *ApplicationsRequest) GetRejectedBefore() int64 {
+	if m != nil {
+		return m.RejectedBefore
+	}
+	return 0
+}
+
+func (m *ApplicationsRequest) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *ApplicationsRequest) GetSortDescending() bool {
+	if m != nil {
+		return m.SortDescending
+	}
+	return false
+}
+
+type ApplicationsResponse struct {
+	Applications         []*Application `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	Total                uint32         `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	NextCursor           string         `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore              bool           `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ApplicationsResponse) Reset()         { *m = ApplicationsResponse{} }
+func (m *ApplicationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationsResponse) ProtoMessage()    {}
+func (*ApplicationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{34}
+}
+
+func (m *ApplicationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationsResponse.Unmarshal(m, b)
+}
+func (m *ApplicationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationsResponse.Marshal(b, m, deterministic)
+}
+func (m *ApplicationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationsResponse.Merge(m, src)
+}
+func (m *ApplicationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ApplicationsResponse.Size(m)
+}
+func (m *ApplicationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationsResponse proto.InternalMessageInfo
+
+func (m *ApplicationsResponse) GetApplications() []*Application {
+	if m != nil {
+		return m.Applications
+	}
+	return nil
+}
+
+func (m *ApplicationsResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ApplicationsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (m *ApplicationsResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+type ClaimApplicationRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClaimApplicationRequest) Reset()         { *m = ClaimApplicationRequest{} }
+func (m *ClaimApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*ClaimApplicationRequest) ProtoMessage()    {}
+func (*ClaimApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{35}
+}
+
+func (m *ClaimApplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClaimApplicationRequest.Unmarshal(m, b)
+}
+func (m *ClaimApplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClaimApplicationRequest.Marshal(b, m, deterministic)
+}
+func (m *ClaimApplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimApplicationRequest.Merge(m, src)
+}
+func (m *ClaimApplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_ClaimApplicationRequest.Size(m)
+}
+func (m *ClaimApplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimApplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimApplicationRequest proto.InternalMessageInfo
+
+func (m *ClaimApplicationRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+type UnclaimApplicationRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnclaimApplicationRequest) Reset()         { *m = UnclaimApplicationRequest{} }
+func (m *UnclaimApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*UnclaimApplicationRequest) ProtoMessage()    {}
+func (*UnclaimApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{36}
+}
+
+func (m *UnclaimApplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnclaimApplicationRequest.Unmarshal(m, b)
+}
+func (m *UnclaimApplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnclaimApplicationRequest.Marshal(b, m, deterministic)
+}
+func (m *UnclaimApplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnclaimApplicationRequest.Merge(m, src)
+}
+func (m *UnclaimApplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_UnclaimApplicationRequest.Size(m)
+}
+func (m *UnclaimApplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnclaimApplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnclaimApplicationRequest proto.InternalMessageInfo
+
+func (m *UnclaimApplicationRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+type UnclaimApplicationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnclaimApplicationResponse) Reset()         { *m = UnclaimApplicationResponse{} }
+func (m *UnclaimApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*UnclaimApplicationResponse) ProtoMessage()    {}
+func (*UnclaimApplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{37}
+}
+
+func (m *UnclaimApplicationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnclaimApplicationResponse.Unmarshal(m, b)
+}
+func (m *UnclaimApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnclaimApplicationResponse.Marshal(b, m, deterministic)
+}
+func (m *UnclaimApplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnclaimApplicationResponse.Merge(m, src)
+}
+func (m *UnclaimApplicationResponse) XXX_Size() int {
+	return xxx_messageInfo_UnclaimApplicationResponse.Size(m)
+}
+func (m *UnclaimApplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnclaimApplicationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnclaimApplicationResponse proto.InternalMessageInfo
+
+type ReviewQueueRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReviewQueueRequest) Reset()         { *m = ReviewQueueRequest{} }
+func (m *ReviewQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*ReviewQueueRequest) ProtoMessage()    {}
+func (*ReviewQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{38}
+}
+
+func (m *ReviewQueueRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReviewQueueRequest.Unmarshal(m, b)
+}
+func (m *ReviewQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReviewQueueRequest.Marshal(b, m, deterministic)
+}
+func (m *ReviewQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewQueueRequest.Merge(m, src)
+}
+func (m *ReviewQueueRequest) XXX_Size() int {
+	return xxx_messageInfo_ReviewQueueRequest.Size(m)
+}
+func (m *ReviewQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewQueueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReviewQueueRequest proto.InternalMessageInfo
+
+type ApplicationRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationRequest) Reset()         { *m = ApplicationRequest{} }
+func (m *ApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationRequest) ProtoMessage()    {}
+func (*ApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{39}
+}
+
+func (m *ApplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationRequest.Unmarshal(m, b)
+}
+func (m *ApplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationRequest.Merge(m, src)
+}
+func (m *ApplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplicationRequest.Size(m)
+}
+func (m *ApplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationRequest proto.InternalMessageInfo
+
+func (m *ApplicationRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+type GetLastApplicationRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLastApplicationRequest) Reset()         { *m = GetLastApplicationRequest{} }
+func (m *GetLastApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLastApplicationRequest) ProtoMessage()    {}
+func (*GetLastApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{40}
+}
+
+func (m *GetLastApplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLastApplicationRequest.Unmarshal(m, b)
+}
+func (m *GetLastApplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLastApplicationRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLastApplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLastApplicationRequest.Merge(m, src)
+}
+func (m *GetLastApplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLastApplicationRequest.Size(m)
+}
+func (m *GetLastApplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLastApplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLastApplicationRequest proto.InternalMessageInfo
+
+func (m *GetLastApplicationRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+type ApplicationTransition struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// from is empty for the submission of the application
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// actor is empty if the community moved the application itself
+	Actor                string   `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason               string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	TransitionedAt       int64    `protobuf:"varint,6,opt,name=transitioned_at,json=transitionedAt,proto3" json:"transitioned_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationTransition) Reset()         { *m = ApplicationTransition{} }
+func (m *ApplicationTransition) String() string { return proto.CompactTextString(m) }
+func (*ApplicationTransition) ProtoMessage()    {}
+func (*ApplicationTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{41}
+}
+
+func (m *ApplicationTransition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationTransition.Unmarshal(m, b)
+}
+func (m *ApplicationTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationTransition.Marshal(b, m, deterministic)
+}
+func (m *ApplicationTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationTransition.Merge(m, src)
+}
+func (m *ApplicationTransition) XXX_Size() int {
+	return xxx_messageInfo_ApplicationTransition.Size(m)
+}
+func (m *ApplicationTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationTransition proto.InternalMessageInfo
+
+func (m *ApplicationTransition) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ApplicationTransition) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ApplicationTransition) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *ApplicationTransition) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *ApplicationTransition) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ApplicationTransition) GetTransitionedAt() int64 {
+	if m != nil {
+		return m.TransitionedAt
+	}
+	return 0
+}
+
+type ApplicationHistoryEntry struct {
+	Application          *Application             `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	Transitions          []*ApplicationTransition `protobuf:"bytes,2,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ApplicationHistoryEntry) Reset()         { *m = ApplicationHistoryEntry{} }
+func (m *ApplicationHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*ApplicationHistoryEntry) ProtoMessage()    {}
+func (*ApplicationHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{42}
+}
+
+func (m *ApplicationHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationHistoryEntry.Unmarshal(m, b)
+}
+func (m *ApplicationHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationHistoryEntry.Marshal(b, m, deterministic)
+}
+func (m *ApplicationHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationHistoryEntry.Merge(m, src)
+}
+func (m *ApplicationHistoryEntry) XXX_Size() int {
+	return xxx_messageInfo_ApplicationHistoryEntry.Size(m)
+}
+func (m *ApplicationHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationHistoryEntry proto.InternalMessageInfo
+
+func (m *ApplicationHistoryEntry) GetApplication() *Application {
+	if m != nil {
+		return m.Application
+	}
+	return nil
+}
+
+func (m *ApplicationHistoryEntry) GetTransitions() []*ApplicationTransition {
+	if m != nil {
+		return m.Transitions
+	}
+	return nil
+}
+
+type ApplicationHistoryRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationHistoryRequest) Reset()         { *m = ApplicationHistoryRequest{} }
+func (m *ApplicationHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationHistoryRequest) ProtoMessage()    {}
+func (*ApplicationHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{43}
+}
+
+func (m *ApplicationHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationHistoryRequest.Unmarshal(m, b)
+}
+func (m *ApplicationHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplicationHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationHistoryRequest.Merge(m, src)
+}
+func (m *ApplicationHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplicationHistoryRequest.Size(m)
+}
+func (m *ApplicationHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationHistoryRequest proto.InternalMessageInfo
+
+func (m *ApplicationHistoryRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+type ApplicationHistoryResponse struct {
+	Entries              []*ApplicationHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ApplicationHistoryResponse) Reset()         { *m = ApplicationHistoryResponse{} }
+func (m *ApplicationHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationHistoryResponse) ProtoMessage()    {}
+func (*ApplicationHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{44}
+}
+
+func (m *ApplicationHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationHistoryResponse.Unmarshal(m, b)
+}
+func (m *ApplicationHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *ApplicationHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationHistoryResponse.Merge(m, src)
+}
+func (m *ApplicationHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ApplicationHistoryResponse.Size(m)
+}
+func (m *ApplicationHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationHistoryResponse proto.InternalMessageInfo
+
+func (m *ApplicationHistoryResponse) GetEntries() []*ApplicationHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type ApplicationComment struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId        string   `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	AuthorId             string   `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Text                 string   `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Internal             bool     `protobuf:"varint,5,opt,name=internal,proto3" json:"internal,omitempty"`
+	Question             bool     `protobuf:"varint,6,opt,name=question,proto3" json:"question,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationComment) Reset()         { *m = ApplicationComment{} }
+func (m *ApplicationComment) String() string { return proto.CompactTextString(m) }
+func (*ApplicationComment) ProtoMessage()    {}
+func (*ApplicationComment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{45}
+}
+
+func (m *ApplicationComment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationComment.Unmarshal(m, b)
+}
+func (m *ApplicationComment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationComment.Marshal(b, m, deterministic)
+}
+func (m *ApplicationComment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationComment.Merge(m, src)
+}
+func (m *ApplicationComment) XXX_Size() int {
+	return xxx_messageInfo_ApplicationComment.Size(m)
+}
+func (m *ApplicationComment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationComment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationComment proto.InternalMessageInfo
+
+func (m *ApplicationComment) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ApplicationComment) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+func (m *ApplicationComment) GetAuthorId() string {
+	if m != nil {
+		return m.AuthorId
+	}
+	return ""
+}
+
+func (m *ApplicationComment) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *ApplicationComment) GetInternal() bool {
+	if m != nil {
+		return m.Internal
+	}
+	return false
+}
+
+func (m *ApplicationComment) GetQuestion() bool {
+	if m != nil {
+		return m.Question
+	}
+	return false
+}
+
+func (m *ApplicationComment) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type RequestInformationRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Question             string   `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestInformationRequest) Reset()         { *m = RequestInformationRequest{} }
+func (m *RequestInformationRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestInformationRequest) ProtoMessage()    {}
+func (*RequestInformationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{46}
+}
+
+func (m *RequestInformationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestInformationRequest.Unmarshal(m, b)
+}
+func (m *RequestInformationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestInformationRequest.Marshal(b, m, deterministic)
+}
+func (m *RequestInformationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestInformationRequest.Merge(m, src)
+}
+func (m *RequestInformationRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestInformationRequest.Size(m)
+}
+func (m *RequestInformationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestInformationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestInformationRequest proto.InternalMessageInfo
+
+func (m *RequestInformationRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+func (m *RequestInformationRequest) GetQuestion() string {
+	if m != nil {
+		return m.Question
+	}
+	return ""
+}
+
+type AnswerApplicationRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Answer               string   `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AnswerApplicationRequest) Reset()         { *m = AnswerApplicationRequest{} }
+func (m *AnswerApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*AnswerApplicationRequest) ProtoMessage()    {}
+func (*AnswerApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{47}
+}
+
+func (m *AnswerApplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnswerApplicationRequest.Unmarshal(m, b)
+}
+func (m *AnswerApplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnswerApplicationRequest.Marshal(b, m, deterministic)
+}
+func (m *AnswerApplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnswerApplicationRequest.Merge(m, src)
+}
+func (m *AnswerApplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_AnswerApplicationRequest.Size(m)
+}
+func (m *AnswerApplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnswerApplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnswerApplicationRequest proto.InternalMessageInfo
+
+func (m *AnswerApplicationRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+func (m *AnswerApplicationRequest) GetAnswer() string {
+	if m != nil {
+		return m.Answer
+	}
+	return ""
+}
+
+type AddInternalNoteRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Note                 string   `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddInternalNoteRequest) Reset()         { *m = AddInternalNoteRequest{} }
+func (m *AddInternalNoteRequest) String() string { return proto.CompactTextString(m) }
+func (*AddInternalNoteRequest) ProtoMessage()    {}
+func (*AddInternalNoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{48}
+}
+
+func (m *AddInternalNoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddInternalNoteRequest.Unmarshal(m, b)
+}
+func (m *AddInternalNoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddInternalNoteRequest.Marshal(b, m, deterministic)
+}
+func (m *AddInternalNoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddInternalNoteRequest.Merge(m, src)
+}
+func (m *AddInternalNoteRequest) XXX_Size() int {
+	return xxx_messageInfo_AddInternalNoteRequest.Size(m)
+}
+func (m *AddInternalNoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddInternalNoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddInternalNoteRequest proto.InternalMessageInfo
+
+func (m *AddInternalNoteRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+func (m *AddInternalNoteRequest) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+type ApplicationCommentsRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationCommentsRequest) Reset()         { *m = ApplicationCommentsRequest{} }
+func (m *ApplicationCommentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationCommentsRequest) ProtoMessage()    {}
+func (*ApplicationCommentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{49}
+}
+
+func (m *ApplicationCommentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationCommentsRequest.Unmarshal(m, b)
+}
+func (m *ApplicationCommentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationCommentsRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplicationCommentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationCommentsRequest.Merge(m, src)
+}
+func (m *ApplicationCommentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplicationCommentsRequest.Size(m)
+}
+func (m *ApplicationCommentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationCommentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationCommentsRequest proto.InternalMessageInfo
+
+func (m *ApplicationCommentsRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+type ApplicationCommentsResponse struct {
+	Comments             []*ApplicationComment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ApplicationCommentsResponse) Reset()         { *m = ApplicationCommentsResponse{} }
+func (m *ApplicationCommentsResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationCommentsResponse) ProtoMessage()    {}
+func (*ApplicationCommentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{50}
+}
+
+func (m *ApplicationCommentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationCommentsResponse.Unmarshal(m, b)
+}
+func (m *ApplicationCommentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationCommentsResponse.Marshal(b, m, deterministic)
+}
+func (m *ApplicationCommentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationCommentsResponse.Merge(m, src)
+}
+func (m *ApplicationCommentsResponse) XXX_Size() int {
+	return xxx_messageInfo_ApplicationCommentsResponse.Size(m)
+}
+func (m *ApplicationCommentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationCommentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationCommentsResponse proto.InternalMessageInfo
+
+func (m *ApplicationCommentsResponse) GetComments() []*ApplicationComment {
+	if m != nil {
+		return m.Comments
+	}
+	return nil
+}
+
+type MeRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MeRequest) Reset()         { *m = MeRequest{} }
+func (m *MeRequest) String() string { return proto.CompactTextString(m) }
+func (*MeRequest) ProtoMessage()    {}
+func (*MeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{51}
+}
+
+func (m *MeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MeRequest.Unmarshal(m, b)
+}
+func (m *MeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MeRequest.Marshal(b, m, deterministic)
+}
+func (m *MeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeRequest.Merge(m, src)
+}
+func (m *MeRequest) XXX_Size() int {
+	return xxx_messageInfo_MeRequest.Size(m)
+}
+func (m *MeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeRequest proto.InternalMessageInfo
+
+type GetMemberRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMemberRequest) Reset()         { *m = GetMemberRequest{} }
+func (m *GetMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMemberRequest) ProtoMessage()    {}
+func (*GetMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{52}
+}
+
+func (m *GetMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMemberRequest.Unmarshal(m, b)
+}
+func (m *GetMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMemberRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMemberRequest.Merge(m, src)
+}
+func (m *GetMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMemberRequest.Size(m)
+}
+func (m *GetMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMemberRequest proto.InternalMessageInfo
+
+func (m *GetMemberRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+type UpdateProfileRequest struct {
+	MemberId string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	// the proper name is changed if the first or the last name is set
+	FirstName string `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	// the profile image is changed if it's set
+	ProfileImage         string   `protobuf:"bytes,4,opt,name=profile_image,json=profileImage,proto3" json:"profile_image,omitempty"`
+	RemoveProfileImage   bool     `protobuf:"varint,5,opt,name=remove_profile_image,json=removeProfileImage,proto3" json:"remove_profile_image,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateProfileRequest) Reset()         { *m = UpdateProfileRequest{} }
+func (m *UpdateProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateProfileRequest) ProtoMessage()    {}
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{53}
+}
+
+func (m *UpdateProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProfileRequest.Unmarshal(m, b)
+}
+func (m *UpdateProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProfileRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProfileRequest.Merge(m, src)
+}
+func (m *UpdateProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateProfileRequest.Size(m)
+}
+func (m *UpdateProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProfileRequest proto.InternalMessageInfo
+
+func (m *UpdateProfileRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *UpdateProfileRequest) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
+	}
+	return ""
+}
+
+func (m *UpdateProfileRequest) GetLastName() string {
+	if m != nil {
+		return m.LastName
+	}
+	return ""
+}
+
+func (m *UpdateProfileRequest) GetProfileImage() string {
+	if m != nil {
+		return m.ProfileImage
+	}
+	return ""
+}
+
+func (m *UpdateProfileRequest) GetRemoveProfileImage() bool {
+	if m != nil {
+		return m.RemoveProfileImage
+	}
+	return false
+}
+
+type RequestEmailChangeRequest struct {
+	EmailAddress         string   `protobuf:"bytes,1,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestEmailChangeRequest) Reset()         { *m = RequestEmailChangeRequest{} }
+func (m *RequestEmailChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestEmailChangeRequest) ProtoMessage()    {}
+func (*RequestEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{54}
+}
+
+func (m *RequestEmailChangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestEmailChangeRequest.Unmarshal(m, b)
+}
+func (m *RequestEmailChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestEmailChangeRequest.Marshal(b, m, deterministic)
+}
+func (m *RequestEmailChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestEmailChangeRequest.Merge(m, src)
+}
+func (m *RequestEmailChangeRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestEmailChangeRequest.Size(m)
+}
+func (m *RequestEmailChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestEmailChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestEmailChangeRequest proto.InternalMessageInfo
+
+func (m *RequestEmailChangeRequest) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+type RequestEmailChangeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestEmailChangeResponse) Reset()         { *m = RequestEmailChangeResponse{} }
+func (m *RequestEmailChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestEmailChangeResponse) ProtoMessage()    {}
+func (*RequestEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{55}
+}
+
+func (m *RequestEmailChangeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestEmailChangeResponse.Unmarshal(m, b)
+}
+func (m *RequestEmailChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestEmailChangeResponse.Marshal(b, m, deterministic)
+}
+func (m *RequestEmailChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestEmailChangeResponse.Merge(m, src)
+}
+func (m *RequestEmailChangeResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestEmailChangeResponse.Size(m)
+}
+func (m *RequestEmailChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestEmailChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestEmailChangeResponse proto.InternalMessageInfo
+
+type ConfirmEmailChangeRequest struct {
+	EmailAddress         string   `protobuf:"bytes,1,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	ConfirmationCode     string   `protobuf:"bytes,2,opt,name=confirmation_code,json=confirmationCode,proto3" json:"confirmation_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmEmailChangeRequest) Reset()         { *m = ConfirmEmailChangeRequest{} }
+func (m *ConfirmEmailChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfirmEmailChangeRequest) ProtoMessage()    {}
+func (*ConfirmEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{56}
+}
+
+func (m *ConfirmEmailChangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmEmailChangeRequest.Unmarshal(m, b)
+}
+func (m *ConfirmEmailChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmEmailChangeRequest.Marshal(b, m, deterministic)
+}
+func (m *ConfirmEmailChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmEmailChangeRequest.Merge(m, src)
+}
+func (m *ConfirmEmailChangeRequest) XXX_Size() int {
+	return xxx_messageInfo_ConfirmEmailChangeRequest.Size(m)
+}
+func (m *ConfirmEmailChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmEmailChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmEmailChangeRequest proto.InternalMessageInfo
+
+func (m *ConfirmEmailChangeRequest) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+func (m *ConfirmEmailChangeRequest) GetConfirmationCode() string {
+	if m != nil {
+		return m.ConfirmationCode
+	}
+	return ""
+}
+
+type ChangeUsernameRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangeUsernameRequest) Reset()         { *m = ChangeUsernameRequest{} }
+func (m *ChangeUsernameRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeUsernameRequest) ProtoMessage()    {}
+func (*ChangeUsernameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{57}
+}
+
+func (m *ChangeUsernameRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeUsernameRequest.Unmarshal(m, b)
+}
+func (m *ChangeUsernameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeUsernameRequest.Marshal(b, m, deterministic)
+}
+func (m *ChangeUsernameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeUsernameRequest.Merge(m, src)
+}
+func (m *ChangeUsernameRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangeUsernameRequest.Size(m)
+}
+func (m *ChangeUsernameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeUsernameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeUsernameRequest proto.InternalMessageInfo
+
+func (m *ChangeUsernameRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *ChangeUsernameRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type UsernameChange struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId             string   `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	OldUsername          string   `protobuf:"bytes,3,opt,name=old_username,json=oldUsername,proto3" json:"old_username,omitempty"`
+	NewUsername          string   `protobuf:"bytes,4,opt,name=new_username,json=newUsername,proto3" json:"new_username,omitempty"`
+	ChangedAt            int64    `protobuf:"varint,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UsernameChange) Reset()         { *m = UsernameChange{} }
+func (m *UsernameChange) String() string { return proto.CompactTextString(m) }
+func (*UsernameChange) ProtoMessage()    {}
+func (*UsernameChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{58}
+}
+
+func (m *UsernameChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UsernameChange.Unmarshal(m, b)
+}
+func (m *UsernameChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UsernameChange.Marshal(b, m, deterministic)
+}
+func (m *UsernameChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsernameChange.Merge(m, src)
+}
+func (m *UsernameChange) XXX_Size() int {
+	return xxx_messageInfo_UsernameChange.Size(m)
+}
+func (m *UsernameChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsernameChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsernameChange proto.InternalMessageInfo
+
+func (m *UsernameChange) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UsernameChange) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *UsernameChange) GetOldUsername() string {
+	if m != nil {
+		return m.OldUsername
+	}
+	return ""
+}
+
+func (m *UsernameChange) GetNewUsername() string {
+	if m != nil {
+		return m.NewUsername
+	}
+	return ""
+}
+
+func (m *UsernameChange) GetChangedAt() int64 {
+	if m != nil {
+		return m.ChangedAt
+	}
+	return 0
+}
+
+type UsernameHistoryRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UsernameHistoryRequest) Reset()         { *m = UsernameHistoryRequest{} }
+func (m *UsernameHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*UsernameHistoryRequest) ProtoMessage()    {}
+func (*UsernameHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{59}
+}
+
+func (m *UsernameHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UsernameHistoryRequest.Unmarshal(m, b)
+}
+func (m *UsernameHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UsernameHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *UsernameHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsernameHistoryRequest.Merge(m, src)
+}
+func (m *UsernameHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_UsernameHistoryRequest.Size(m)
+}
+func (m *UsernameHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsernameHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsernameHistoryRequest proto.InternalMessageInfo
+
+func (m *UsernameHistoryRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+type UsernameHistoryResponse struct {
+	UsernameChanges      []*UsernameChange `protobuf:"bytes,1,rep,name=username_changes,json=usernameChanges,proto3" json:"username_changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UsernameHistoryResponse) Reset()         { *m = UsernameHistoryResponse{} }
+func (m *UsernameHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*UsernameHistoryResponse) ProtoMessage()    {}
+func (*UsernameHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{60}
+}
+
+func (m *UsernameHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UsernameHistoryResponse.Unmarshal(m, b)
+}
+func (m *UsernameHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UsernameHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *UsernameHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsernameHistoryResponse.Merge(m, src)
+}
+func (m *UsernameHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_UsernameHistoryResponse.Size(m)
+}
+func (m *UsernameHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsernameHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsernameHistoryResponse proto.InternalMessageInfo
+
+func (m *UsernameHistoryResponse) GetUsernameChanges() []*UsernameChange {
+	if m != nil {
+		return m.UsernameChanges
+	}
+	return nil
+}
+
+type ResolveUsernameRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResolveUsernameRequest) Reset()         { *m = ResolveUsernameRequest{} }
+func (m *ResolveUsernameRequest) String() string { return proto.CompactTextString(m) }
+func (*ResolveUsernameRequest) ProtoMessage()    {}
+func (*ResolveUsernameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{61}
+}
+
+func (m *ResolveUsernameRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveUsernameRequest.Unmarshal(m, b)
+}
+func (m *ResolveUsernameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveUsernameRequest.Marshal(b, m, deterministic)
+}
+func (m *ResolveUsernameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveUsernameRequest.Merge(m, src)
+}
+func (m *ResolveUsernameRequest) XXX_Size() int {
+	return xxx_messageInfo_ResolveUsernameRequest.Size(m)
+}
+func (m *ResolveUsernameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveUsernameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveUsernameRequest proto.InternalMessageInfo
+
+func (m *ResolveUsernameRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type MemberDeletion struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId             string   `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	RequestedBy          string   `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RequestedAt          int64    `protobuf:"varint,4,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	EraseAt              int64    `protobuf:"varint,5,opt,name=erase_at,json=eraseAt,proto3" json:"erase_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MemberDeletion) Reset()         { *m = MemberDeletion{} }
+func (m *MemberDeletion) String() string { return proto.CompactTextString(m) }
+func (*MemberDeletion) ProtoMessage()    {}
+func (*MemberDeletion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{62}
+}
+
+func (m *MemberDeletion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MemberDeletion.Unmarshal(m, b)
+}
+func (m *MemberDeletion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MemberDeletion.Marshal(b, m, deterministic)
+}
+func (m *MemberDeletion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberDeletion.Merge(m, src)
+}
+func (m *MemberDeletion) XXX_Size() int {
+	return xxx_messageInfo_MemberDeletion.Size(m)
+}
+func (m *MemberDeletion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberDeletion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberDeletion proto.InternalMessageInfo
+
+func (m *MemberDeletion) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MemberDeletion) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *MemberDeletion) GetRequestedBy() string {
+	if m != nil {
+		return m.RequestedBy
+	}
+	return ""
+}
+
+func (m *MemberDeletion) GetRequestedAt() int64 {
+	if m != nil {
+		return m.RequestedAt
+	}
+	return 0
+}
+
+func (m *MemberDeletion) GetEraseAt() int64 {
+	if m != nil {
+		return m.EraseAt
+	}
+	return 0
+}
+
+type DeleteMemberRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteMemberRequest) Reset()         { *m = DeleteMemberRequest{} }
+func (m *DeleteMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteMemberRequest) ProtoMessage()    {}
+func (*DeleteMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{63}
+}
+
+func (m *DeleteMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteMemberRequest.Unmarshal(m, b)
+}
+func (m *DeleteMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteMemberRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMemberRequest.Merge(m, src)
+}
+func (m *DeleteMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteMemberRequest.Size(m)
+}
+func (m *DeleteMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteMemberRequest proto.InternalMessageInfo
+
+func (m *DeleteMemberRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+type CancelMemberDeletionRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelMemberDeletionRequest) Reset()         { *m = CancelMemberDeletionRequest{} }
+func (m *CancelMemberDeletionRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelMemberDeletionRequest) ProtoMessage()    {}
+func (*CancelMemberDeletionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{64}
+}
+
+func (m *CancelMemberDeletionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelMemberDeletionRequest.Unmarshal(m, b)
+}
+func (m *CancelMemberDeletionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelMemberDeletionRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelMemberDeletionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelMemberDeletionRequest.Merge(m, src)
+}
+func (m *CancelMemberDeletionRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelMemberDeletionRequest.Size(m)
+}
+func (m *CancelMemberDeletionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelMemberDeletionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelMemberDeletionRequest proto.InternalMessageInfo
+
+func (m *CancelMemberDeletionRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+type CancelMemberDeletionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelMemberDeletionResponse) Reset()         { *m = CancelMemberDeletionResponse{} }
+func (m *CancelMemberDeletionResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelMemberDeletionResponse) ProtoMessage()    {}
+func (*CancelMemberDeletionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{65}
+}
+
+func (m *CancelMemberDeletionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelMemberDeletionResponse.Unmarshal(m, b)
+}
+func (m *CancelMemberDeletionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelMemberDeletionResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelMemberDeletionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelMemberDeletionResponse.Merge(m, src)
+}
+func (m *CancelMemberDeletionResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelMemberDeletionResponse.Size(m)
+}
+func (m *CancelMemberDeletionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelMemberDeletionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelMemberDeletionResponse proto.InternalMessageInfo
+
+type ExportMemberDataRequest struct {
+	MemberId string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	// zip exports a zip archive with the json export and the profile image
+	Zip                  bool     `protobuf:"varint,2,opt,name=zip,proto3" json:"zip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportMemberDataRequest) Reset()         { *m = ExportMemberDataRequest{} }
+func (m *ExportMemberDataRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportMemberDataRequest) ProtoMessage()    {}
+func (*ExportMemberDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{66}
+}
+
+func (m *ExportMemberDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportMemberDataRequest.Unmarshal(m, b)
+}
+func (m *ExportMemberDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportMemberDataRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportMemberDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMemberDataRequest.Merge(m, src)
+}
+func (m *ExportMemberDataRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportMemberDataRequest.Size(m)
+}
+func (m *ExportMemberDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMemberDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportMemberDataRequest proto.InternalMessageInfo
+
+func (m *ExportMemberDataRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *ExportMemberDataRequest) GetZip() bool {
+	if m != nil {
+		return m.Zip
+	}
+	return false
+}
+
+type MemberDataExport struct {
+	// either application/json or application/zip
+	ContentType          string   `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MemberDataExport) Reset()         { *m = MemberDataExport{} }
+func (m *MemberDataExport) String() string { return proto.CompactTextString(m) }
+func (*MemberDataExport) ProtoMessage()    {}
+func (*MemberDataExport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{67}
+}
+
+func (m *MemberDataExport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MemberDataExport.Unmarshal(m, b)
+}
+func (m *MemberDataExport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MemberDataExport.Marshal(b, m, deterministic)
+}
+func (m *MemberDataExport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberDataExport.Merge(m, src)
+}
+func (m *MemberDataExport) XXX_Size() int {
+	return xxx_messageInfo_MemberDataExport.Size(m)
+}
+func (m *MemberDataExport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberDataExport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberDataExport proto.InternalMessageInfo
+
+func (m *MemberDataExport) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *MemberDataExport) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type Sanction struct {
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId     string `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	EmailAddress string `protobuf:"bytes,3,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	Type         string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Reason       string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	IssuedBy     string `protobuf:"bytes,6,opt,name=issued_by,json=issuedBy,proto3" json:"issued_by,omitempty"`
+	IssuedAt     int64  `protobuf:"varint,7,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// expires_at is 0 for bans
+	ExpiresAt            int64    `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LiftedAt             int64    `protobuf:"varint,9,opt,name=lifted_at,json=liftedAt,proto3" json:"lifted_at,omitempty"`
+	LiftedBy             string   `protobuf:"bytes,10,opt,name=lifted_by,json=liftedBy,proto3" json:"lifted_by,omitempty"`
+	LiftReason           string   `protobuf:"bytes,11,opt,name=lift_reason,json=liftReason,proto3" json:"lift_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Sanction) Reset()         { *m = Sanction{} }
+func (m *Sanction) String() string { return proto.CompactTextString(m) }
+func (*Sanction) ProtoMessage()    {}
+func (*Sanction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{68}
+}
+
+func (m *Sanction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Sanction.Unmarshal(m, b)
+}
+func (m *Sanction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Sanction.Marshal(b, m, deterministic)
+}
+func (m *Sanction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sanction.Merge(m, src)
+}
+func (m *Sanction) XXX_Size() int {
+	return xxx_messageInfo_Sanction.Size(m)
+}
+func (m *Sanction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sanction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sanction proto.InternalMessageInfo
+
+func (m *Sanction) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Sanction) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *Sanction) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+func (m *Sanction) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Sanction) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Sanction) GetIssuedBy() string {
+	if m != nil {
+		return m.IssuedBy
+	}
+	return ""
+}
+
+func (m *Sanction) GetIssuedAt() int64 {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return 0
+}
+
+func (m *Sanction) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *Sanction) GetLiftedAt() int64 {
+	if m != nil {
+		return m.LiftedAt
+	}
+	return 0
+}
+
+func (m *Sanction) GetLiftedBy() string {
+	if m != nil {
+		return m.LiftedBy
+	}
+	return ""
+}
+
+func (m *Sanction) GetLiftReason() string {
+	if m != nil {
+		return m.LiftReason
+	}
+	return ""
+}
+
+type SuspendMemberRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Until                int64    `protobuf:"varint,3,opt,name=until,proto3" json:"until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuspendMemberRequest) Reset()         { *m = SuspendMemberRequest{} }
+func (m *SuspendMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*SuspendMemberRequest) ProtoMessage()    {}
+func (*SuspendMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{69}
+}
+
+func (m *SuspendMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuspendMemberRequest.Unmarshal(m, b)
+}
+func (m *SuspendMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuspendMemberRequest.Marshal(b, m, deterministic)
+}
+func (m *SuspendMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendMemberRequest.Merge(m, src)
+}
+func (m *SuspendMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_SuspendMemberRequest.Size(m)
+}
+func (m *SuspendMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuspendMemberRequest proto.InternalMessageInfo
+
+func (m *SuspendMemberRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *SuspendMemberRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SuspendMemberRequest) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+type BanMemberRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BanMemberRequest) Reset()         { *m = BanMemberRequest{} }
+func (m *BanMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*BanMemberRequest) ProtoMessage()    {}
+func (*BanMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{70}
+}
+
+func (m *BanMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BanMemberRequest.Unmarshal(m, b)
+}
+func (m *BanMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BanMemberRequest.Marshal(b, m, deterministic)
+}
+func (m *BanMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanMemberRequest.Merge(m, src)
+}
+func (m *BanMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_BanMemberRequest.Size(m)
+}
+func (m *BanMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BanMemberRequest proto.InternalMessageInfo
+
+func (m *BanMemberRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *BanMemberRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type UnsuspendRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnsuspendRequest) Reset()         { *m = UnsuspendRequest{} }
+func (m *UnsuspendRequest) String() string { return proto.CompactTextString(m) }
+func (*UnsuspendRequest) ProtoMessage()    {}
+func (*UnsuspendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{71}
+}
+
+func (m *UnsuspendRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsuspendRequest.Unmarshal(m, b)
+}
+func (m *UnsuspendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsuspendRequest.Marshal(b, m, deterministic)
+}
+func (m *UnsuspendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsuspendRequest.Merge(m, src)
+}
+func (m *UnsuspendRequest) XXX_Size() int {
+	return xxx_messageInfo_UnsuspendRequest.Size(m)
+}
+func (m *UnsuspendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsuspendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsuspendRequest proto.InternalMessageInfo
+
+func (m *UnsuspendRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *UnsuspendRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type UnsuspendResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnsuspendResponse) Reset()         { *m = UnsuspendResponse{} }
+func (m *UnsuspendResponse) String() string { return proto.CompactTextString(m) }
+func (*UnsuspendResponse) ProtoMessage()    {}
+func (*UnsuspendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{72}
+}
+
+func (m *UnsuspendResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsuspendResponse.Unmarshal(m, b)
+}
+func (m *UnsuspendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsuspendResponse.Marshal(b, m, deterministic)
+}
+func (m *UnsuspendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsuspendResponse.Merge(m, src)
+}
+func (m *UnsuspendResponse) XXX_Size() int {
+	return xxx_messageInfo_UnsuspendResponse.Size(m)
+}
+func (m *UnsuspendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsuspendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsuspendResponse proto.InternalMessageInfo
+
+type LiftBanRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LiftBanRequest) Reset()         { *m = LiftBanRequest{} }
+func (m *LiftBanRequest) String() string { return proto.CompactTextString(m) }
+func (*LiftBanRequest) ProtoMessage()    {}
+func (*LiftBanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{73}
+}
+
+func (m *LiftBanRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LiftBanRequest.Unmarshal(m, b)
+}
+func (m *LiftBanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LiftBanRequest.Marshal(b, m, deterministic)
+}
+func (m *LiftBanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiftBanRequest.Merge(m, src)
+}
+func (m *LiftBanRequest) XXX_Size() int {
+	return xxx_messageInfo_LiftBanRequest.Size(m)
+}
+func (m *LiftBanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiftBanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiftBanRequest proto.InternalMessageInfo
+
+func (m *LiftBanRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *LiftBanRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type LiftBanResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LiftBanResponse) Reset()         { *m = LiftBanResponse{} }
+func (m *LiftBanResponse) String() string { return proto.CompactTextString(m) }
+func (*LiftBanResponse) ProtoMessage()    {}
+func (*LiftBanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{74}
+}
+
+func (m *LiftBanResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LiftBanResponse.Unmarshal(m, b)
+}
+func (m *LiftBanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LiftBanResponse.Marshal(b, m, deterministic)
+}
+func (m *LiftBanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiftBanResponse.Merge(m, src)
+}
+func (m *LiftBanResponse) XXX_Size() int {
+	return xxx_messageInfo_LiftBanResponse.Size(m)
+}
+func (m *LiftBanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiftBanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiftBanResponse proto.InternalMessageInfo
+
+type SanctionsRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SanctionsRequest) Reset()         { *m = SanctionsRequest{} }
+func (m *SanctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*SanctionsRequest) ProtoMessage()    {}
+func (*SanctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{75}
+}
+
+func (m *SanctionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SanctionsRequest.Unmarshal(m, b)
+}
+func (m *SanctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SanctionsRequest.Marshal(b, m, deterministic)
+}
+func (m *SanctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SanctionsRequest.Merge(m, src)
+}
+func (m *SanctionsRequest) XXX_Size() int {
+	return xxx_messageInfo_SanctionsRequest.Size(m)
+}
+func (m *SanctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SanctionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SanctionsRequest proto.InternalMessageInfo
+
+func (m *SanctionsRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+type SanctionsResponse struct {
+	Sanctions            []*Sanction `protobuf:"bytes,1,rep,name=sanctions,proto3" json:"sanctions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SanctionsResponse) Reset()         { *m = SanctionsResponse{} }
+func (m *SanctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*SanctionsResponse) ProtoMessage()    {}
+func (*SanctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{76}
+}
+
+func (m *SanctionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SanctionsResponse.Unmarshal(m, b)
+}
+func (m *SanctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SanctionsResponse.Marshal(b, m, deterministic)
+}
+func (m *SanctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SanctionsResponse.Merge(m, src)
+}
+func (m *SanctionsResponse) XXX_Size() int {
+	return xxx_messageInfo_SanctionsResponse.Size(m)
+}
+func (m *SanctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SanctionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SanctionsResponse proto.InternalMessageInfo
+
+func (m *SanctionsResponse) GetSanctions() []*Sanction {
+	if m != nil {
+		return m.Sanctions
+	}
+	return nil
+}
+
+// the verified, verified_email_address, banned and applied filters are
+// either "true", "false" or empty to not filter by them
+type MembersRequest struct {
+	// position is the id of the last member of the previous response
+	Position             string   `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Next                 uint32   `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+	Verified             string   `protobuf:"bytes,3,opt,name=verified,proto3" json:"verified,omitempty"`
+	VerifiedEmailAddress string   `protobuf:"bytes,4,opt,name=verified_email_address,json=verifiedEmailAddress,proto3" json:"verified_email_address,omitempty"`
+	Role                 string   `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Banned               string   `protobuf:"bytes,6,opt,name=banned,proto3" json:"banned,omitempty"`
+	InvitedBy            string   `protobuf:"bytes,7,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	CreatedAfter         int64    `protobuf:"varint,8,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore        int64    `protobuf:"varint,9,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	Applied              string   `protobuf:"bytes,10,opt,name=applied,proto3" json:"applied,omitempty"`
+	Search               string   `protobuf:"bytes,11,opt,name=search,proto3" json:"search,omitempty"`
+	Sort                 string   `protobuf:"bytes,12,opt,name=sort,proto3" json:"sort,omitempty"`
+	SortDescending       bool     `protobuf:"varint,13,opt,name=sort_descending,json=sortDescending,proto3" json:"sort_descending,omitempty"`
+	IncludeDeleted       bool     `protobuf:"varint,14,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MembersRequest) Reset()         { *m = MembersRequest{} }
+func (m *MembersRequest) String() string { return proto.CompactTextString(m) }
+func (*MembersRequest) ProtoMessage()    {}
+func (*MembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{77}
+}
+
+func (m *MembersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MembersRequest.Unmarshal(m, b)
+}
+func (m *MembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MembersRequest.Marshal(b, m, deterministic)
+}
+func (m *MembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MembersRequest.Merge(m, src)
+}
+func (m *MembersRequest) XXX_Size() int {
+	return xxx_messageInfo_MembersRequest.Size(m)
+}
+func (m *MembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MembersRequest proto.InternalMessageInfo
+
+func (m *MembersRequest) GetPosition() string {
+	if m != nil {
+		return m.Position
+	}
+	return ""
+}
+
+func (m *MembersRequest) GetNext() uint32 {
+	if m != nil {
+		return m.Next
+	}
+	return 0
+}
+
+func (m *MembersRequest) GetVerified() string {
+	if m != nil {
+		return m.Verified
+	}
+	return ""
+}
+
+func (m *MembersRequest) GetVerifiedEmailAddress() string {
+	if m != nil {
+		return m.VerifiedEmailAddress
+	}
+	return ""
+}
+
+func (m *MembersRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *MembersRequest) GetBanned() string {
+	if m != nil {
+		return m.Banned
+	}
+	return ""
+}
+
+func (m *MembersRequest) GetInvitedBy() string {
+	if m != nil {
+		return m.InvitedBy
+	}
+	return ""
+}
+
+func (m *MembersRequest) GetCreatedAfter() int64 {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return 0
+}
+
+func (m *MembersRequest) GetCreatedBefore() int64 {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return 0
+}
+
+func (m *MembersRequest) GetApplied() string {
+	if m != nil {
+		return m.Applied
+	}
+	return ""
+}
+
+func (m *MembersRequest) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *MembersRequest) GetSort() string {
+	if m != nil {
+		return m.Sort
+	}
+	return ""
+}
+
+func (m *MembersRequest) GetSortDescending() bool {
+	if m != nil {
+		return m.SortDescending
+	}
+	return false
+}
+
+func (m *MembersRequest) GetIncludeDeleted() bool {
+	if m != nil {
+		return m.IncludeDeleted
+	}
+	return false
+}
+
+type MembersResponse struct {
+	Members              []*Member `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *MembersResponse) Reset()         { *m = MembersResponse{} }
+func (m *MembersResponse) String() string { return proto.CompactTextString(m) }
+func (*MembersResponse) ProtoMessage()    {}
+func (*MembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{78}
+}
+
+func (m *MembersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MembersResponse.Unmarshal(m, b)
+}
+func (m *MembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MembersResponse.Marshal(b, m, deterministic)
+}
+func (m *MembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MembersResponse.Merge(m, src)
+}
+func (m *MembersResponse) XXX_Size() int {
+	return xxx_messageInfo_MembersResponse.Size(m)
+}
+func (m *MembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MembersResponse proto.InternalMessageInfo
+
+func (m *MembersResponse) GetMembers() []*Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type DirectoryEntry struct {
+	Id                   string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username             string    `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Metadata             *Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Verified             bool      `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`
+	Roles                []string  `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`
+	MemberSince          int64     `protobuf:"varint,6,opt,name=member_since,json=memberSince,proto3" json:"member_since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *DirectoryEntry) Reset()         { *m = DirectoryEntry{} }
+func (m *DirectoryEntry) String() string { return proto.CompactTextString(m) }
+func (*DirectoryEntry) ProtoMessage()    {}
+func (*DirectoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{79}
+}
+
+func (m *DirectoryEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DirectoryEntry.Unmarshal(m, b)
+}
+func (m *DirectoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DirectoryEntry.Marshal(b, m, deterministic)
+}
+func (m *DirectoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DirectoryEntry.Merge(m, src)
+}
+func (m *DirectoryEntry) XXX_Size() int {
+	return xxx_messageInfo_DirectoryEntry.Size(m)
+}
+func (m *DirectoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DirectoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DirectoryEntry proto.InternalMessageInfo
+
+func (m *DirectoryEntry) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DirectoryEntry) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *DirectoryEntry) GetMetadata() *Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DirectoryEntry) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *DirectoryEntry) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *DirectoryEntry) GetMemberSince() int64 {
+	if m != nil {
+		return m.MemberSince
+	}
+	return 0
+}
+
+type DirectoryResponse struct {
+	Entries              []*DirectoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DirectoryResponse) Reset()         { *m = DirectoryResponse{} }
+func (m *DirectoryResponse) String() string { return proto.CompactTextString(m) }
+func (*DirectoryResponse) ProtoMessage()    {}
+func (*DirectoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{80}
+}
+
+func (m *DirectoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DirectoryResponse.Unmarshal(m, b)
+}
+func (m *DirectoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DirectoryResponse.Marshal(b, m, deterministic)
+}
+func (m *DirectoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DirectoryResponse.Merge(m, src)
+}
+func (m *DirectoryResponse) XXX_Size() int {
+	return xxx_messageInfo_DirectoryResponse.Size(m)
+}
+func (m *DirectoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DirectoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DirectoryResponse proto.InternalMessageInfo
+
+func (m *DirectoryResponse) GetEntries() []*DirectoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type Invitation struct {
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code      string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	CreatedBy string `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt int64  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// max_uses is 0 for unlimited invitations
+	MaxUses              uint32   `protobuf:"varint,6,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Uses                 uint32   `protobuf:"varint,7,opt,name=uses,proto3" json:"uses,omitempty"`
+	EmailAddress         string   `protobuf:"bytes,8,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	SkipVerification     bool     `protobuf:"varint,9,opt,name=skip_verification,json=skipVerification,proto3" json:"skip_verification,omitempty"`
+	RevokedAt            int64    `protobuf:"varint,10,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Invitation) Reset()         { *m = Invitation{} }
+func (m *Invitation) String() string { return proto.CompactTextString(m) }
+func (*Invitation) ProtoMessage()    {}
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{81}
+}
+
+func (m *Invitation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Invitation.Unmarshal(m, b)
+}
+func (m *Invitation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Invitation.Marshal(b, m, deterministic)
+}
+func (m *Invitation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Invitation.Merge(m, src)
+}
+func (m *Invitation) XXX_Size() int {
+	return xxx_messageInfo_Invitation.Size(m)
+}
+func (m *Invitation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Invitation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Invitation proto.InternalMessageInfo
+
+func (m *Invitation) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Invitation) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Invitation) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+func (m *Invitation) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Invitation) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *Invitation) GetMaxUses() uint32 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+func (m *Invitation) GetUses() uint32 {
+	if m != nil {
+		return m.Uses
+	}
+	return 0
+}
+
+func (m *Invitation) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+func (m *Invitation) GetSkipVerification() bool {
+	if m != nil {
+		return m.SkipVerification
+	}
+	return false
+}
+
+func (m *Invitation) GetRevokedAt() int64 {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return 0
+}
+
+type CreateInvitationRequest struct {
+	MaxUses              uint32   `protobuf:"varint,1,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	EmailAddress         string   `protobuf:"bytes,3,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	SkipVerification     bool     `protobuf:"varint,4,opt,name=skip_verification,json=skipVerification,proto3" json:"skip_verification,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateInvitationRequest) Reset()         { *m = CreateInvitationRequest{} }
+func (m *CreateInvitationRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateInvitationRequest) ProtoMessage()    {}
+func (*CreateInvitationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{82}
+}
+
+func (m *CreateInvitationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateInvitationRequest.Unmarshal(m, b)
+}
+func (m *CreateInvitationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateInvitationRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateInvitationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateInvitationRequest.Merge(m, src)
+}
+func (m *CreateInvitationRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateInvitationRequest.Size(m)
+}
+func (m *CreateInvitationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateInvitationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateInvitationRequest proto.InternalMessageInfo
+
+func (m *CreateInvitationRequest) GetMaxUses() uint32 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+func (m *CreateInvitationRequest) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *CreateInvitationRequest) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+func (m *CreateInvitationRequest) GetSkipVerification() bool {
+	if m != nil {
+		return m.SkipVerification
+	}
+	return false
+}
+
+type RevokeInvitationRequest struct {
+	InvitationId         string   `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeInvitationRequest) Reset()         { *m = RevokeInvitationRequest{} }
+func (m *RevokeInvitationRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeInvitationRequest) ProtoMessage()    {}
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{83}
+}
+
+func (m *RevokeInvitationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeInvitationRequest.Unmarshal(m, b)
+}
+func (m *RevokeInvitationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeInvitationRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeInvitationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeInvitationRequest.Merge(m, src)
+}
+func (m *RevokeInvitationRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeInvitationRequest.Size(m)
+}
+func (m *RevokeInvitationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeInvitationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeInvitationRequest proto.InternalMessageInfo
+
+func (m *RevokeInvitationRequest) GetInvitationId() string {
+	if m != nil {
+		return m.InvitationId
+	}
+	return ""
+}
+
+type RevokeInvitationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeInvitationResponse) Reset()         { *m = RevokeInvitationResponse{} }
+func (m *RevokeInvitationResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeInvitationResponse) ProtoMessage()    {}
+func (*RevokeInvitationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{84}
+}
+
+func (m *RevokeInvitationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeInvitationResponse.Unmarshal(m, b)
+}
+func (m *RevokeInvitationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeInvitationResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeInvitationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeInvitationResponse.Merge(m, src)
+}
+func (m *RevokeInvitationResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeInvitationResponse.Size(m)
+}
+func (m *RevokeInvitationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeInvitationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeInvitationResponse proto.InternalMessageInfo
+
+type InvitationsRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvitationsRequest) Reset()         { *m = InvitationsRequest{} }
+func (m *InvitationsRequest) String() string { return proto.CompactTextString(m) }
+func (*InvitationsRequest) ProtoMessage()    {}
+func (*InvitationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{85}
+}
+
+func (m *InvitationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvitationsRequest.Unmarshal(m, b)
+}
+func (m *InvitationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvitationsRequest.Marshal(b, m, deterministic)
+}
+func (m *InvitationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvitationsRequest.Merge(m, src)
+}
+func (m *InvitationsRequest) XXX_Size() int {
+	return xxx_messageInfo_InvitationsRequest.Size(m)
+}
+func (m *InvitationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvitationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvitationsRequest proto.InternalMessageInfo
+
+func (m *InvitationsRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+type InvitationsResponse struct {
+	Invitations          []*Invitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *InvitationsResponse) Reset()         { *m = InvitationsResponse{} }
+func (m *InvitationsResponse) String() string { return proto.CompactTextString(m) }
+func (*InvitationsResponse) ProtoMessage()    {}
+func (*InvitationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{86}
+}
+
+func (m *InvitationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvitationsResponse.Unmarshal(m, b)
+}
+func (m *InvitationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvitationsResponse.Marshal(b, m, deterministic)
+}
+func (m *InvitationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvitationsResponse.Merge(m, src)
+}
+func (m *InvitationsResponse) XXX_Size() int {
+	return xxx_messageInfo_InvitationsResponse.Size(m)
+}
+func (m *InvitationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvitationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvitationsResponse proto.InternalMessageInfo
+
+func (m *InvitationsResponse) GetInvitations() []*Invitation {
+	if m != nil {
+		return m.Invitations
+	}
+	return nil
+}
+
+type Vouch struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId        string   `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	VoucherId            string   `protobuf:"bytes,3,opt,name=voucher_id,json=voucherId,proto3" json:"voucher_id,omitempty"`
+	VouchedAt            int64    `protobuf:"varint,4,opt,name=vouched_at,json=vouchedAt,proto3" json:"vouched_at,omitempty"`
+	RevokedAt            int64    `protobuf:"varint,5,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Vouch) Reset()         { *m = Vouch{} }
+func (m *Vouch) String() string { return proto.CompactTextString(m) }
+func (*Vouch) ProtoMessage()    {}
+func (*Vouch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{87}
+}
+
+func (m *Vouch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Vouch.Unmarshal(m, b)
+}
+func (m *Vouch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Vouch.Marshal(b, m, deterministic)
+}
+func (m *Vouch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vouch.Merge(m, src)
+}
+func (m *Vouch) XXX_Size() int {
+	return xxx_messageInfo_Vouch.Size(m)
+}
+func (m *Vouch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vouch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vouch proto.InternalMessageInfo
+
+func (m *Vouch) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Vouch) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+func (m *Vouch) GetVoucherId() string {
+	if m != nil {
+		return m.VoucherId
+	}
+	return ""
+}
+
+func (m *Vouch) GetVouchedAt() int64 {
+	if m != nil {
+		return m.VouchedAt
+	}
+	return 0
+}
+
+func (m *Vouch) GetRevokedAt() int64 {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return 0
+}
+
+type VouchRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VouchRequest) Reset()         { *m = VouchRequest{} }
+func (m *VouchRequest) String() string { return proto.CompactTextString(m) }
+func (*VouchRequest) ProtoMessage()    {}
+func (*VouchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{88}
+}
+
+func (m *VouchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VouchRequest.Unmarshal(m, b)
+}
+func (m *VouchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VouchRequest.Marshal(b, m, deterministic)
+}
+func (m *VouchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VouchRequest.Merge(m, src)
+}
+func (m *VouchRequest) XXX_Size() int {
+	return xxx_messageInfo_VouchRequest.Size(m)
+}
+func (m *VouchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VouchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VouchRequest proto.InternalMessageInfo
+
+func (m *VouchRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+type RevokeVouchRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeVouchRequest) Reset()         { *m = RevokeVouchRequest{} }
+func (m *RevokeVouchRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeVouchRequest) ProtoMessage()    {}
+func (*RevokeVouchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{89}
+}
+
+func (m *RevokeVouchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeVouchRequest.Unmarshal(m, b)
+}
+func (m *RevokeVouchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeVouchRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeVouchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeVouchRequest.Merge(m, src)
+}
+func (m *RevokeVouchRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeVouchRequest.Size(m)
+}
+func (m *RevokeVouchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeVouchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeVouchRequest proto.InternalMessageInfo
+
+func (m *RevokeVouchRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+type RevokeVouchResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeVouchResponse) Reset()         { *m = RevokeVouchResponse{} }
+func (m *RevokeVouchResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeVouchResponse) ProtoMessage()    {}
+func (*RevokeVouchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{90}
+}
+
+func (m *RevokeVouchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeVouchResponse.Unmarshal(m, b)
+}
+func (m *RevokeVouchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeVouchResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeVouchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeVouchResponse.Merge(m, src)
+}
+func (m *RevokeVouchResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeVouchResponse.Size(m)
+}
+func (m *RevokeVouchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeVouchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeVouchResponse proto.InternalMessageInfo
+
+type VouchesRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VouchesRequest) Reset()         { *m = VouchesRequest{} }
+func (m *VouchesRequest) String() string { return proto.CompactTextString(m) }
+func (*VouchesRequest) ProtoMessage()    {}
+func (*VouchesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{91}
+}
+
+func (m *VouchesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VouchesRequest.Unmarshal(m, b)
+}
+func (m *VouchesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VouchesRequest.Marshal(b, m, deterministic)
+}
+func (m *VouchesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VouchesRequest.Merge(m, src)
+}
+func (m *VouchesRequest) XXX_Size() int {
+	return xxx_messageInfo_VouchesRequest.Size(m)
+}
+func (m *VouchesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VouchesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VouchesRequest proto.InternalMessageInfo
+
+func (m *VouchesRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+type VouchesResponse struct {
+	Vouches              []*Vouch `protobuf:"bytes,1,rep,name=vouches,proto3" json:"vouches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VouchesResponse) Reset()         { *m = VouchesResponse{} }
+func (m *VouchesResponse) String() string { return proto.CompactTextString(m) }
+func (*VouchesResponse) ProtoMessage()    {}
+func (*VouchesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{92}
+}
+
+func (m *VouchesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VouchesResponse.Unmarshal(m, b)
+}
+func (m *VouchesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VouchesResponse.Marshal(b, m, deterministic)
+}
+func (m *VouchesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VouchesResponse.Merge(m, src)
+}
+func (m *VouchesResponse) XXX_Size() int {
+	return xxx_messageInfo_VouchesResponse.Size(m)
+}
+func (m *VouchesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VouchesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VouchesResponse proto.InternalMessageInfo
+
+func (m *VouchesResponse) GetVouches() []*Vouch {
+	if m != nil {
+		return m.Vouches
+	}
+	return nil
+}
+
+type CastVoteRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Decision             string   `protobuf:"bytes,2,opt,name=decision,proto3" json:"decision,omitempty"`
+	Comment              string   `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CastVoteRequest) Reset()         { *m = CastVoteRequest{} }
+func (m *CastVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*CastVoteRequest) ProtoMessage()    {}
+func (*CastVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{93}
+}
+
+func (m *CastVoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CastVoteRequest.Unmarshal(m, b)
+}
+func (m *CastVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CastVoteRequest.Marshal(b, m, deterministic)
+}
+func (m *CastVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CastVoteRequest.Merge(m, src)
+}
+func (m *CastVoteRequest) XXX_Size() int {
+	return xxx_messageInfo_CastVoteRequest.Size(m)
+}
+func (m *CastVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CastVoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CastVoteRequest proto.InternalMessageInfo
+
+func (m *CastVoteRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+func (m *CastVoteRequest) GetDecision() string {
+	if m != nil {
+		return m.Decision
+	}
+	return ""
+}
+
+func (m *CastVoteRequest) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+type VotesRequest struct {
+	ApplicationId        string   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VotesRequest) Reset()         { *m = VotesRequest{} }
+func (m *VotesRequest) String() string { return proto.CompactTextString(m) }
+func (*VotesRequest) ProtoMessage()    {}
+func (*VotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{94}
+}
+
+func (m *VotesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VotesRequest.Unmarshal(m, b)
+}
+func (m *VotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VotesRequest.Marshal(b, m, deterministic)
+}
+func (m *VotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotesRequest.Merge(m, src)
+}
+func (m *VotesRequest) XXX_Size() int {
+	return xxx_messageInfo_VotesRequest.Size(m)
+}
+func (m *VotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotesRequest proto.InternalMessageInfo
+
+func (m *VotesRequest) GetApplicationId() string {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return ""
+}
+
+type VotesResponse struct {
+	Votes                []*ReviewVote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *VotesResponse) Reset()         { *m = VotesResponse{} }
+func (m *VotesResponse) String() string { return proto.CompactTextString(m) }
+func (*VotesResponse) ProtoMessage()    {}
+func (*VotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{95}
+}
+
+func (m *VotesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VotesResponse.Unmarshal(m, b)
+}
+func (m *VotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VotesResponse.Marshal(b, m, deterministic)
+}
+func (m *VotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotesResponse.Merge(m, src)
+}
+func (m *VotesResponse) XXX_Size() int {
+	return xxx_messageInfo_VotesResponse.Size(m)
+}
+func (m *VotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotesResponse proto.InternalMessageInfo
+
+func (m *VotesResponse) GetVotes() []*ReviewVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+type PromoteRequest struct {
+	EmailAddress         string   `protobuf:"bytes,1,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PromoteRequest) Reset()         { *m = PromoteRequest{} }
+func (m *PromoteRequest) String() string { return proto.CompactTextString(m) }
+func (*PromoteRequest) ProtoMessage()    {}
+func (*PromoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{96}
+}
+
+func (m *PromoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PromoteRequest.Unmarshal(m, b)
+}
+func (m *PromoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PromoteRequest.Marshal(b, m, deterministic)
+}
+func (m *PromoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoteRequest.Merge(m, src)
+}
+func (m *PromoteRequest) XXX_Size() int {
+	return xxx_messageInfo_PromoteRequest.Size(m)
+}
+func (m *PromoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromoteRequest proto.InternalMessageInfo
+
+func (m *PromoteRequest) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+type PromoteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PromoteResponse) Reset()         { *m = PromoteResponse{} }
+func (m *PromoteResponse) String() string { return proto.CompactTextString(m) }
+func (*PromoteResponse) ProtoMessage()    {}
+func (*PromoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{97}
+}
+
+func (m *PromoteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PromoteResponse.Unmarshal(m, b)
+}
+func (m *PromoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PromoteResponse.Marshal(b, m, deterministic)
+}
+func (m *PromoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoteResponse.Merge(m, src)
+}
+func (m *PromoteResponse) XXX_Size() int {
+	return xxx_messageInfo_PromoteResponse.Size(m)
+}
+func (m *PromoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromoteResponse proto.InternalMessageInfo
+
+type DemoteRequest struct {
+	EmailAddress         string   `protobuf:"bytes,1,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DemoteRequest) Reset()         { *m = DemoteRequest{} }
+func (m *DemoteRequest) String() string { return proto.CompactTextString(m) }
+func (*DemoteRequest) ProtoMessage()    {}
+func (*DemoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{98}
+}
+
+func (m *DemoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DemoteRequest.Unmarshal(m, b)
+}
+func (m *DemoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DemoteRequest.Marshal(b, m, deterministic)
+}
+func (m *DemoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DemoteRequest.Merge(m, src)
+}
+func (m *DemoteRequest) XXX_Size() int {
+	return xxx_messageInfo_DemoteRequest.Size(m)
+}
+func (m *DemoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DemoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DemoteRequest proto.InternalMessageInfo
+
+func (m *DemoteRequest) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+type DemoteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DemoteResponse) Reset()         { *m = DemoteResponse{} }
+func (m *DemoteResponse) String() string { return proto.CompactTextString(m) }
+func (*DemoteResponse) ProtoMessage()    {}
+func (*DemoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{99}
+}
+
+func (m *DemoteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DemoteResponse.Unmarshal(m, b)
+}
+func (m *DemoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DemoteResponse.Marshal(b, m, deterministic)
+}
+func (m *DemoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DemoteResponse.Merge(m, src)
+}
+func (m *DemoteResponse) XXX_Size() int {
+	return xxx_messageInfo_DemoteResponse.Size(m)
+}
+func (m *DemoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DemoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DemoteResponse proto.InternalMessageInfo
+
+type GrantRoleRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GrantRoleRequest) Reset()         { *m = GrantRoleRequest{} }
+func (m *GrantRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*GrantRoleRequest) ProtoMessage()    {}
+func (*GrantRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{100}
+}
+
+func (m *GrantRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantRoleRequest.Unmarshal(m, b)
+}
+func (m *GrantRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *GrantRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantRoleRequest.Merge(m, src)
+}
+func (m *GrantRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_GrantRoleRequest.Size(m)
+}
+func (m *GrantRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantRoleRequest proto.InternalMessageInfo
+
+func (m *GrantRoleRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *GrantRoleRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type GrantRoleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GrantRoleResponse) Reset()         { *m = GrantRoleResponse{} }
+func (m *GrantRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*GrantRoleResponse) ProtoMessage()    {}
+func (*GrantRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{101}
+}
+
+func (m *GrantRoleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantRoleResponse.Unmarshal(m, b)
+}
+func (m *GrantRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantRoleResponse.Marshal(b, m, deterministic)
+}
+func (m *GrantRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantRoleResponse.Merge(m, src)
+}
+func (m *GrantRoleResponse) XXX_Size() int {
+	return xxx_messageInfo_GrantRoleResponse.Size(m)
+}
+func (m *GrantRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantRoleResponse proto.InternalMessageInfo
+
+type RevokeRoleRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeRoleRequest) Reset()         { *m = RevokeRoleRequest{} }
+func (m *RevokeRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeRoleRequest) ProtoMessage()    {}
+func (*RevokeRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{102}
+}
+
+func (m *RevokeRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeRoleRequest.Unmarshal(m, b)
+}
+func (m *RevokeRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeRoleRequest.Merge(m, src)
+}
+func (m *RevokeRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeRoleRequest.Size(m)
+}
+func (m *RevokeRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeRoleRequest proto.InternalMessageInfo
+
+func (m *RevokeRoleRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *RevokeRoleRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type RevokeRoleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeRoleResponse) Reset()         { *m = RevokeRoleResponse{} }
+func (m *RevokeRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeRoleResponse) ProtoMessage()    {}
+func (*RevokeRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{103}
+}
+
+func (m *RevokeRoleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeRoleResponse.Unmarshal(m, b)
+}
+func (m *RevokeRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeRoleResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeRoleResponse.Merge(m, src)
+}
+func (m *RevokeRoleResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeRoleResponse.Size(m)
+}
+func (m *RevokeRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeRoleResponse proto.InternalMessageInfo
+
+type RoleChange struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId             string   `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Role                 string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Granted              bool     `protobuf:"varint,4,opt,name=granted,proto3" json:"granted,omitempty"`
+	ChangedBy            string   `protobuf:"bytes,5,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	ChangedAt            int64    `protobuf:"varint,6,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoleChange) Reset()         { *m = RoleChange{} }
+func (m *RoleChange) String() string { return proto.CompactTextString(m) }
+func (*RoleChange) ProtoMessage()    {}
+func (*RoleChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{104}
+}
+
+func (m *RoleChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleChange.Unmarshal(m, b)
+}
+func (m *RoleChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleChange.Marshal(b, m, deterministic)
+}
+func (m *RoleChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleChange.Merge(m, src)
+}
+func (m *RoleChange) XXX_Size() int {
+	return xxx_messageInfo_RoleChange.Size(m)
+}
+func (m *RoleChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleChange proto.InternalMessageInfo
+
+func (m *RoleChange) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RoleChange) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *RoleChange) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *RoleChange) GetGranted() bool {
+	if m != nil {
+		return m.Granted
+	}
+	return false
+}
+
+func (m *RoleChange) GetChangedBy() string {
+	if m != nil {
+		return m.ChangedBy
+	}
+	return ""
+}
+
+func (m *RoleChange) GetChangedAt() int64 {
+	if m != nil {
+		return m.ChangedAt
+	}
+	return 0
+}
+
+type RoleChangesRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoleChangesRequest) Reset()         { *m = RoleChangesRequest{} }
+func (m *RoleChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*RoleChangesRequest) ProtoMessage()    {}
+func (*RoleChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{105}
+}
+
+func (m *RoleChangesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleChangesRequest.Unmarshal(m, b)
+}
+func (m *RoleChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleChangesRequest.Marshal(b, m, deterministic)
+}
+func (m *RoleChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleChangesRequest.Merge(m, src)
+}
+func (m *RoleChangesRequest) XXX_Size() int {
+	return xxx_messageInfo_RoleChangesRequest.Size(m)
+}
+func (m *RoleChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleChangesRequest proto.InternalMessageInfo
+
+func (m *RoleChangesRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+type RoleChangesResponse struct {
+	RoleChanges          []*RoleChange `protobuf:"bytes,1,rep,name=role_changes,json=roleChanges,proto3" json:"role_changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *RoleChangesResponse) Reset()         { *m = RoleChangesResponse{} }
+func (m *RoleChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*RoleChangesResponse) ProtoMessage()    {}
+func (*RoleChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{106}
+}
+
+func (m *RoleChangesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleChangesResponse.Unmarshal(m, b)
+}
+func (m *RoleChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleChangesResponse.Marshal(b, m, deterministic)
+}
+func (m *RoleChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleChangesResponse.Merge(m, src)
+}
+func (m *RoleChangesResponse) XXX_Size() int {
+	return xxx_messageInfo_RoleChangesResponse.Size(m)
+}
+func (m *RoleChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleChangesResponse proto.InternalMessageInfo
+
+func (m *RoleChangesResponse) GetRoleChanges() []*RoleChange {
+	if m != nil {
+		return m.RoleChanges
+	}
+	return nil
+}
+
+type VerificationRevocation struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId             string   `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RevokedBy            string   `protobuf:"bytes,4,opt,name=revoked_by,json=revokedBy,proto3" json:"revoked_by,omitempty"`
+	RevokedAt            int64    `protobuf:"varint,5,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerificationRevocation) Reset()         { *m = VerificationRevocation{} }
+func (m *VerificationRevocation) String() string { return proto.CompactTextString(m) }
+func (*VerificationRevocation) ProtoMessage()    {}
+func (*VerificationRevocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{107}
+}
+
+func (m *VerificationRevocation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerificationRevocation.Unmarshal(m, b)
+}
+func (m *VerificationRevocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerificationRevocation.Marshal(b, m, deterministic)
+}
+func (m *VerificationRevocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationRevocation.Merge(m, src)
+}
+func (m *VerificationRevocation) XXX_Size() int {
+	return xxx_messageInfo_VerificationRevocation.Size(m)
+}
+func (m *VerificationRevocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationRevocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationRevocation proto.InternalMessageInfo
+
+func (m *VerificationRevocation) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *VerificationRevocation) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *VerificationRevocation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *VerificationRevocation) GetRevokedBy() string {
+	if m != nil {
+		return m.RevokedBy
+	}
+	return ""
+}
+
+func (m *VerificationRevocation) GetRevokedAt() int64 {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return 0
+}
+
+type RevokeVerificationRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeVerificationRequest) Reset()         { *m = RevokeVerificationRequest{} }
+func (m *RevokeVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeVerificationRequest) ProtoMessage()    {}
+func (*RevokeVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{108}
+}
+
+func (m *RevokeVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeVerificationRequest.Unmarshal(m, b)
+}
+func (m *RevokeVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeVerificationRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeVerificationRequest.Merge(m, src)
+}
+func (m *RevokeVerificationRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeVerificationRequest.Size(m)
+}
+func (m *RevokeVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeVerificationRequest proto.InternalMessageInfo
+
+func (m *RevokeVerificationRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *RevokeVerificationRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type VerificationRevocationsRequest struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerificationRevocationsRequest) Reset()         { *m = VerificationRevocationsRequest{} }
+func (m *VerificationRevocationsRequest) String() string { return proto.CompactTextString(m) }
+func (*VerificationRevocationsRequest) ProtoMessage()    {}
+func (*VerificationRevocationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{109}
+}
+
+func (m *VerificationRevocationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerificationRevocationsRequest.Unmarshal(m, b)
+}
+func (m *VerificationRevocationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerificationRevocationsRequest.Marshal(b, m, deterministic)
+}
+func (m *VerificationRevocationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationRevocationsRequest.Merge(m, src)
+}
+func (m *VerificationRevocationsRequest) XXX_Size() int {
+	return xxx_messageInfo_VerificationRevocationsRequest.Size(m)
+}
+func (m *VerificationRevocationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationRevocationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationRevocationsRequest proto.InternalMessageInfo
+
+func (m *VerificationRevocationsRequest) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+type VerificationRevocationsResponse struct {
+	Revocations          []*VerificationRevocation `protobuf:"bytes,1,rep,name=revocations,proto3" json:"revocations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *VerificationRevocationsResponse) Reset()         { *m = VerificationRevocationsResponse{} }
+func (m *VerificationRevocationsResponse) String() string { return proto.CompactTextString(m) }
+func (*VerificationRevocationsResponse) ProtoMessage()    {}
+func (*VerificationRevocationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{110}
+}
+
+func (m *VerificationRevocationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerificationRevocationsResponse.Unmarshal(m, b)
+}
+func (m *VerificationRevocationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerificationRevocationsResponse.Marshal(b, m, deterministic)
+}
+func (m *VerificationRevocationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationRevocationsResponse.Merge(m, src)
+}
+func (m *VerificationRevocationsResponse) XXX_Size() int {
+	return xxx_messageInfo_VerificationRevocationsResponse.Size(m)
+}
+func (m *VerificationRevocationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationRevocationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationRevocationsResponse proto.InternalMessageInfo
+
+func (m *VerificationRevocationsResponse) GetRevocations() []*VerificationRevocation {
+	if m != nil {
+		return m.Revocations
+	}
+	return nil
+}
+
+type StatsRequest struct {
+	Interval             string   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	From                 int64    `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	Until                int64    `protobuf:"varint,3,opt,name=until,proto3" json:"until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatsRequest) Reset()         { *m = StatsRequest{} }
+func (m *StatsRequest) String() string { return proto.CompactTextString(m) }
+func (*StatsRequest) ProtoMessage()    {}
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{111}
+}
+
+func (m *StatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatsRequest.Unmarshal(m, b)
+}
+func (m *StatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatsRequest.Marshal(b, m, deterministic)
+}
+func (m *StatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsRequest.Merge(m, src)
+}
+func (m *StatsRequest) XXX_Size() int {
+	return xxx_messageInfo_StatsRequest.Size(m)
+}
+func (m *StatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsRequest proto.InternalMessageInfo
+
+func (m *StatsRequest) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *StatsRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *StatsRequest) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+type StatsPeriod struct {
+	From                 int64    `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	Until                int64    `protobuf:"varint,2,opt,name=until,proto3" json:"until,omitempty"`
+	SignUps              uint32   `protobuf:"varint,3,opt,name=sign_ups,json=signUps,proto3" json:"sign_ups,omitempty"`
+	Logins               uint32   `protobuf:"varint,4,opt,name=logins,proto3" json:"logins,omitempty"`
+	ActiveMembers        uint32   `protobuf:"varint,5,opt,name=active_members,json=activeMembers,proto3" json:"active_members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatsPeriod) Reset()         { *m = StatsPeriod{} }
+func (m *StatsPeriod) String() string { return proto.CompactTextString(m) }
+func (*StatsPeriod) ProtoMessage()    {}
+func (*StatsPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{112}
+}
+
+func (m *StatsPeriod) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatsPeriod.Unmarshal(m, b)
+}
+func (m *StatsPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatsPeriod.Marshal(b, m, deterministic)
+}
+func (m *StatsPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsPeriod.Merge(m, src)
+}
+func (m *StatsPeriod) XXX_Size() int {
+	return xxx_messageInfo_StatsPeriod.Size(m)
+}
+func (m *StatsPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsPeriod proto.InternalMessageInfo
+
+func (m *StatsPeriod) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *StatsPeriod) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+func (m *StatsPeriod) GetSignUps() uint32 {
+	if m != nil {
+		return m.SignUps
+	}
+	return 0
+}
+
+func (m *StatsPeriod) GetLogins() uint32 {
+	if m != nil {
+		return m.Logins
+	}
+	return 0
+}
+
+func (m *StatsPeriod) GetActiveMembers() uint32 {
+	if m != nil {
+		return m.ActiveMembers
+	}
+	return 0
+}
+
+type SignUpFunnel struct {
+	SignedUp             uint32   `protobuf:"varint,1,opt,name=signed_up,json=signedUp,proto3" json:"signed_up,omitempty"`
+	VerifiedEmailAddress uint32   `protobuf:"varint,2,opt,name=verified_email_address,json=verifiedEmailAddress,proto3" json:"verified_email_address,omitempty"`
+	Applied              uint32   `protobuf:"varint,3,opt,name=applied,proto3" json:"applied,omitempty"`
+	Verified             uint32   `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignUpFunnel) Reset()         { *m = SignUpFunnel{} }
+func (m *SignUpFunnel) String() string { return proto.CompactTextString(m) }
+func (*SignUpFunnel) ProtoMessage()    {}
+func (*SignUpFunnel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{113}
+}
+
+func (m *SignUpFunnel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignUpFunnel.Unmarshal(m, b)
+}
+func (m *SignUpFunnel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignUpFunnel.Marshal(b, m, deterministic)
+}
+func (m *SignUpFunnel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignUpFunnel.Merge(m, src)
+}
+func (m *SignUpFunnel) XXX_Size() int {
+	return xxx_messageInfo_SignUpFunnel.Size(m)
+}
+func (m *SignUpFunnel) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignUpFunnel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignUpFunnel proto.InternalMessageInfo
+
+func (m *SignUpFunnel) GetSignedUp() uint32 {
+	if m != nil {
+		return m.SignedUp
+	}
+	return 0
+}
+
+func (m *SignUpFunnel) GetVerifiedEmailAddress() uint32 {
+	if m != nil {
+		return m.VerifiedEmailAddress
+	}
+	return 0
+}
+
+func (m *SignUpFunnel) GetApplied() uint32 {
+	if m != nil {
+		return m.Applied
+	}
+	return 0
+}
+
+func (m *SignUpFunnel) GetVerified() uint32 {
+	if m != nil {
+		return m.Verified
+	}
+	return 0
+}
+
+type ApplicationStateCount struct {
+	State                string   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Count                uint32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationStateCount) Reset()         { *m = ApplicationStateCount{} }
+func (m *ApplicationStateCount) String() string { return proto.CompactTextString(m) }
+func (*ApplicationStateCount) ProtoMessage()    {}
+func (*ApplicationStateCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{114}
+}
+
+func (m *ApplicationStateCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationStateCount.Unmarshal(m, b)
+}
+func (m *ApplicationStateCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationStateCount.Marshal(b, m, deterministic)
+}
+func (m *ApplicationStateCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationStateCount.Merge(m, src)
+}
+func (m *ApplicationStateCount) XXX_Size() int {
+	return xxx_messageInfo_ApplicationStateCount.Size(m)
+}
+func (m *ApplicationStateCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationStateCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationStateCount proto.InternalMessageInfo
+
+func (m *ApplicationStateCount) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ApplicationStateCount) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReviewerStats struct {
+	Reviewer             string   `protobuf:"bytes,1,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	Approved             uint32   `protobuf:"varint,2,opt,name=approved,proto3" json:"approved,omitempty"`
+	Rejected             uint32   `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	ApprovalRate         float64  `protobuf:"fixed64,4,opt,name=approval_rate,json=approvalRate,proto3" json:"approval_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReviewerStats) Reset()         { *m = ReviewerStats{} }
+func (m *ReviewerStats) String() string { return proto.CompactTextString(m) }
+func (*ReviewerStats) ProtoMessage()    {}
+func (*ReviewerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{115}
+}
+
+func (m *ReviewerStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReviewerStats.Unmarshal(m, b)
+}
+func (m *ReviewerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReviewerStats.Marshal(b, m, deterministic)
+}
+func (m *ReviewerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewerStats.Merge(m, src)
+}
+func (m *ReviewerStats) XXX_Size() int {
+	return xxx_messageInfo_ReviewerStats.Size(m)
+}
+func (m *ReviewerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReviewerStats proto.InternalMessageInfo
+
+func (m *ReviewerStats) GetReviewer() string {
+	if m != nil {
+		return m.Reviewer
+	}
+	return ""
+}
+
+func (m *ReviewerStats) GetApproved() uint32 {
+	if m != nil {
+		return m.Approved
+	}
+	return 0
+}
+
+func (m *ReviewerStats) GetRejected() uint32 {
+	if m != nil {
+		return m.Rejected
+	}
+	return 0
+}
+
+func (m *ReviewerStats) GetApprovalRate() float64 {
+	if m != nil {
+		return m.ApprovalRate
+	}
+	return 0
+}
+
+type Stats struct {
+	Interval              string                   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	From                  int64                    `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	Until                 int64                    `protobuf:"varint,3,opt,name=until,proto3" json:"until,omitempty"`
+	Periods               []*StatsPeriod           `protobuf:"bytes,4,rep,name=periods,proto3" json:"periods,omitempty"`
+	Funnel                *SignUpFunnel            `protobuf:"bytes,5,opt,name=funnel,proto3" json:"funnel,omitempty"`
+	EmailVerificationRate float64                  `protobuf:"fixed64,6,opt,name=email_verification_rate,json=emailVerificationRate,proto3" json:"email_verification_rate,omitempty"`
+	ApplicationsByState   []*ApplicationStateCount `protobuf:"bytes,7,rep,name=applications_by_state,json=applicationsByState,proto3" json:"applications_by_state,omitempty"`
+	// median_review_time is in seconds. It's only set if an application has been decided.
+	MedianReviewTime     int64            `protobuf:"varint,8,opt,name=median_review_time,json=medianReviewTime,proto3" json:"median_review_time,omitempty"`
+	HasMedianReviewTime  bool             `protobuf:"varint,9,opt,name=has_median_review_time,json=hasMedianReviewTime,proto3" json:"has_median_review_time,omitempty"`
+	Reviewers            []*ReviewerStats `protobuf:"bytes,10,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Stats) Reset()         { *m = Stats{} }
+func (m *Stats) String() string { return proto.CompactTextString(m) }
+func (*Stats) ProtoMessage()    {}
+func (*Stats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{116}
+}
+
+func (m *Stats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Stats.Unmarshal(m, b)
+}
+func (m *Stats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Stats.Marshal(b, m, deterministic)
+}
+func (m *Stats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stats.Merge(m, src)
+}
+func (m *Stats) XXX_Size() int {
+	return xxx_messageInfo_Stats.Size(m)
+}
+func (m *Stats) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stats proto.InternalMessageInfo
+
+func (m *Stats) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *Stats) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *Stats) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+func (m *Stats) GetPeriods() []*StatsPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+func (m *Stats) GetFunnel() *SignUpFunnel {
+	if m != nil {
+		return m.Funnel
+	}
+	return nil
+}
+
+func (m *Stats) GetEmailVerificationRate() float64 {
+	if m != nil {
+		return m.EmailVerificationRate
+	}
+	return 0
+}
+
+func (m *Stats) GetApplicationsByState() []*ApplicationStateCount {
+	if m != nil {
+		return m.ApplicationsByState
+	}
+	return nil
+}
+
+func (m *Stats) GetMedianReviewTime() int64 {
+	if m != nil {
+		return m.MedianReviewTime
+	}
+	return 0
+}
+
+func (m *Stats) GetHasMedianReviewTime() bool {
+	if m != nil {
+		return m.HasMedianReviewTime
+	}
+	return false
+}
+
+func (m *Stats) GetReviewers() []*ReviewerStats {
+	if m != nil {
+		return m.Reviewers
+	}
+	return nil
+}
+
+type AuditEntry struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sequence             uint64   `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Action               string   `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Actor                string   `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	Target               string   `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	Outcome              string   `protobuf:"bytes,6,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Detail               string   `protobuf:"bytes,7,opt,name=detail,proto3" json:"detail,omitempty"`
+	OccurredAt           int64    `protobuf:"varint,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	PreviousHash         string   `protobuf:"bytes,9,opt,name=previous_hash,json=previousHash,proto3" json:"previous_hash,omitempty"`
+	Hash                 string   `protobuf:"bytes,10,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEntry) Reset()         { *m = AuditEntry{} }
+func (m *AuditEntry) String() string { return proto.CompactTextString(m) }
+func (*AuditEntry) ProtoMessage()    {}
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{117}
+}
+
+func (m *AuditEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEntry.Unmarshal(m, b)
+}
+func (m *AuditEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEntry.Marshal(b, m, deterministic)
+}
+func (m *AuditEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEntry.Merge(m, src)
+}
+func (m *AuditEntry) XXX_Size() int {
+	return xxx_messageInfo_AuditEntry.Size(m)
+}
+func (m *AuditEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEntry proto.InternalMessageInfo
+
+func (m *AuditEntry) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AuditEntry) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetOutcome() string {
+	if m != nil {
+		return m.Outcome
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetOccurredAt() int64 {
+	if m != nil {
+		return m.OccurredAt
+	}
+	return 0
+}
+
+func (m *AuditEntry) GetPreviousHash() string {
+	if m != nil {
+		return m.PreviousHash
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type AuditLogRequest struct {
+	// position is the sequence of the entry to start after
+	Position             uint64   `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	Next                 uint32   `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+	Actor                string   `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Target               string   `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	Action               string   `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	Outcome              string   `protobuf:"bytes,6,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Since                int64    `protobuf:"varint,7,opt,name=since,proto3" json:"since,omitempty"`
+	Until                int64    `protobuf:"varint,8,opt,name=until,proto3" json:"until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditLogRequest) Reset()         { *m = AuditLogRequest{} }
+func (m *AuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*AuditLogRequest) ProtoMessage()    {}
+func (*AuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{118}
+}
+
+func (m *AuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLogRequest.Unmarshal(m, b)
+}
+func (m *AuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLogRequest.Marshal(b, m, deterministic)
+}
+func (m *AuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogRequest.Merge(m, src)
+}
+func (m *AuditLogRequest) XXX_Size() int {
+	return xxx_messageInfo_AuditLogRequest.Size(m)
+}
+func (m *AuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLogRequest proto.InternalMessageInfo
+
+func (m *AuditLogRequest) GetPosition() uint64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *AuditLogRequest) GetNext() uint32 {
+	if m != nil {
+		return m.Next
+	}
+	return 0
+}
+
+func (m *AuditLogRequest) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditLogRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *AuditLogRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *AuditLogRequest) GetOutcome() string {
+	if m != nil {
+		return m.Outcome
+	}
+	return ""
+}
+
+func (m *AuditLogRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *AuditLogRequest) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+type AuditLogResponse struct {
+	Entries              []*AuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AuditLogResponse) Reset()         { *m = AuditLogResponse{} }
+func (m *AuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*AuditLogResponse) ProtoMessage()    {}
+func (*AuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{119}
+}
+
+func (m *AuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLogResponse.Unmarshal(m, b)
+}
+func (m *AuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLogResponse.Marshal(b, m, deterministic)
+}
+func (m *AuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogResponse.Merge(m, src)
+}
+func (m *AuditLogResponse) XXX_Size() int {
+	return xxx_messageInfo_AuditLogResponse.Size(m)
+}
+func (m *AuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLogResponse proto.InternalMessageInfo
+
+func (m *AuditLogResponse) GetEntries() []*AuditEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type VerifyAuditLogRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyAuditLogRequest) Reset()         { *m = VerifyAuditLogRequest{} }
+func (m *VerifyAuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyAuditLogRequest) ProtoMessage()    {}
+func (*VerifyAuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{120}
+}
+
+func (m *VerifyAuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyAuditLogRequest.Unmarshal(m, b)
+}
+func (m *VerifyAuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyAuditLogRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyAuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyAuditLogRequest.Merge(m, src)
+}
+func (m *VerifyAuditLogRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyAuditLogRequest.Size(m)
+}
+func (m *VerifyAuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyAuditLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyAuditLogRequest proto.InternalMessageInfo
+
+type VerifyAuditLogResponse struct {
+	Tampered bool `protobuf:"varint,1,opt,name=tampered,proto3" json:"tampered,omitempty"`
+	// tampered_sequence is the first entry that doesn't match the chain
+	TamperedSequence     uint64   `protobuf:"varint,2,opt,name=tampered_sequence,json=tamperedSequence,proto3" json:"tampered_sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyAuditLogResponse) Reset()         { *m = VerifyAuditLogResponse{} }
+func (m *VerifyAuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyAuditLogResponse) ProtoMessage()    {}
+func (*VerifyAuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{121}
+}
+
+func (m *VerifyAuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyAuditLogResponse.Unmarshal(m, b)
+}
+func (m *VerifyAuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyAuditLogResponse.Marshal(b, m, deterministic)
+}
+func (m *VerifyAuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyAuditLogResponse.Merge(m, src)
+}
+func (m *VerifyAuditLogResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyAuditLogResponse.Size(m)
+}
+func (m *VerifyAuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyAuditLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyAuditLogResponse proto.InternalMessageInfo
+
+func (m *VerifyAuditLogResponse) GetTampered() bool {
+	if m != nil {
+		return m.Tampered
+	}
+	return false
+}
+
+func (m *VerifyAuditLogResponse) GetTamperedSequence() uint64 {
+	if m != nil {
+		return m.TamperedSequence
+	}
+	return 0
+}
+
+type EventsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventsRequest) Reset()         { *m = EventsRequest{} }
+func (m *EventsRequest) String() string { return proto.CompactTextString(m) }
+func (*EventsRequest) ProtoMessage()    {}
+func (*EventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{122}
+}
+
+func (m *EventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventsRequest.Unmarshal(m, b)
+}
+func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventsRequest.Marshal(b, m, deterministic)
+}
+func (m *EventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsRequest.Merge(m, src)
+}
+func (m *EventsRequest) XXX_Size() int {
+	return xxx_messageInfo_EventsRequest.Size(m)
+}
+func (m *EventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventsRequest proto.InternalMessageInfo
+
+type Event struct {
+	Type                 Event_Type   `protobuf:"varint,1,opt,name=type,proto3,enum=community.Event_Type" json:"type,omitempty"`
+	OccurredAt           int64        `protobuf:"varint,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Member               *Member      `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`
+	Application          *Application `protobuf:"bytes,4,opt,name=application,proto3" json:"application,omitempty"`
+	RoleChange           *RoleChange  `protobuf:"bytes,5,opt,name=role_change,json=roleChange,proto3" json:"role_change,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_857922b7acda88b9, []int{123}
+}
+
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetType() Event_Type {
+	if m != nil {
+		return m.Type
+	}
+	return Event_UNKNOWN
+}
+
+func (m *Event) GetOccurredAt() int64 {
+	if m != nil {
+		return m.OccurredAt
+	}
+	return 0
+}
+
+func (m *Event) GetMember() *Member {
+	if m != nil {
+		return m.Member
+	}
+	return nil
+}
+
+func (m *Event) GetApplication() *Application {
+	if m != nil {
+		return m.Application
+	}
+	return nil
+}
+
+func (m *Event) GetRoleChange() *RoleChange {
+	if m != nil {
+		return m.RoleChange
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("community.Event_Type", Event_Type_name, Event_Type_value)
+	proto.RegisterType((*Metadata)(nil), "community.Metadata")
+	proto.RegisterType((*Member)(nil), "community.Member")
+	proto.RegisterType((*Application)(nil), "community.Application")
+	proto.RegisterType((*Appeal)(nil), "community.Appeal")
+	proto.RegisterType((*FileAppealRequest)(nil), "community.FileAppealRequest")
+	proto.RegisterType((*ApplicationAppealRequest)(nil), "community.ApplicationAppealRequest")
+	proto.RegisterType((*DecideAppealRequest)(nil), "community.DecideAppealRequest")
+	proto.RegisterType((*DecideAppealResponse)(nil), "community.DecideAppealResponse")
+	proto.RegisterType((*AppealsRequest)(nil), "community.AppealsRequest")
+	proto.RegisterType((*AppealsResponse)(nil), "community.AppealsResponse")
+	proto.RegisterType((*ApplicationRevision)(nil), "community.ApplicationRevision")
+	proto.RegisterType((*EditApplicationRequest)(nil), "community.EditApplicationRequest")
+	proto.RegisterType((*WithdrawApplicationRequest)(nil), "community.WithdrawApplicationRequest")
+	proto.RegisterType((*WithdrawApplicationResponse)(nil), "community.WithdrawApplicationResponse")
+	proto.RegisterType((*ApplicationRevisionsRequest)(nil), "community.ApplicationRevisionsRequest")
+	proto.RegisterType((*ApplicationRevisionsResponse)(nil), "community.ApplicationRevisionsResponse")
+	proto.RegisterType((*ApplicationAnswer)(nil), "community.ApplicationAnswer")
+	proto.RegisterType((*FormQuestion)(nil), "community.FormQuestion")
+	proto.RegisterType((*ApplicationForm)(nil), "community.ApplicationForm")
+	proto.RegisterType((*ApplicationFormRequest)(nil), "community.ApplicationFormRequest")
+	proto.RegisterType((*PublishApplicationFormRequest)(nil), "community.PublishApplicationFormRequest")
+	proto.RegisterType((*ReviewVote)(nil), "community.ReviewVote")
+	proto.RegisterType((*AccessToken)(nil), "community.AccessToken")
+	proto.RegisterType((*SignUpRequest)(nil), "community.SignUpRequest")
+	proto.RegisterType((*SignUpWithInvitationRequest)(nil), "community.SignUpWithInvitationRequest")
+	proto.RegisterType((*RequestLoginRequest)(nil), "community.RequestLoginRequest")
+	proto.RegisterType((*RequestLoginResponse)(nil), "community.RequestLoginResponse")
+	proto.RegisterType((*LoginRequest)(nil), "community.LoginRequest")
+	proto.RegisterType((*ApplyForVerificationRequest)(nil), "community.ApplyForVerificationRequest")
+	proto.RegisterType((*ApproveApplicationRequest)(nil), "community.ApproveApplicationRequest")
+	proto.RegisterType((*ApproveApplicationResponse)(nil), "community.ApproveApplicationResponse")
+	proto.RegisterType((*RejectApplicationRequest)(nil), "community.RejectApplicationRequest")
+	proto.RegisterType((*RejectApplicationResponse)(nil), "community.RejectApplicationResponse")
+	proto.RegisterType((*ApplicationsRequest)(nil), "community.ApplicationsRequest")
+	proto.RegisterType((*ApplicationsResponse)(nil), "community.ApplicationsResponse")
+	proto.RegisterType((*ClaimApplicationRequest)(nil), "community.ClaimApplicationRequest")
+	proto.RegisterType((*UnclaimApplicationRequest)(nil), "community.UnclaimApplicationRequest")
+	proto.RegisterType((*UnclaimApplicationResponse)(nil), "community.UnclaimApplicationResponse")
+	proto.RegisterType((*ReviewQueueRequest)(nil), "community.ReviewQueueRequest")
+	proto.RegisterType((*ApplicationRequest)(nil), "community.ApplicationRequest")
+	proto.RegisterType((*GetLastApplicationRequest)(nil), "community.GetLastApplicationRequest")
+	proto.RegisterType((*ApplicationTransition)(nil), "community.ApplicationTransition")
+	proto.RegisterType((*ApplicationHistoryEntry)(nil), "community.ApplicationHistoryEntry")
+	proto.RegisterType((*ApplicationHistoryRequest)(nil), "community.ApplicationHistoryRequest")
+	proto.RegisterType((*ApplicationHistoryResponse)(nil), "community.ApplicationHistoryResponse")
+	proto.RegisterType((*ApplicationComment)(nil), "community.ApplicationComment")
+	proto.RegisterType((*RequestInformationRequest)(nil), "community.RequestInformationRequest")
+	proto.RegisterType((*AnswerApplicationRequest)(nil), "community.AnswerApplicationRequest")
+	proto.RegisterType((*AddInternalNoteRequest)(nil), "community.AddInternalNoteRequest")
+	proto.RegisterType((*ApplicationCommentsRequest)(nil), "community.ApplicationCommentsRequest")
+	proto.RegisterType((*ApplicationCommentsResponse)(nil), "community.ApplicationCommentsResponse")
+	proto.RegisterType((*MeRequest)(nil), "community.MeRequest")
+	proto.RegisterType((*GetMemberRequest)(nil), "community.GetMemberRequest")
+	proto.RegisterType((*UpdateProfileRequest)(nil), "community.UpdateProfileRequest")
+	proto.RegisterType((*RequestEmailChangeRequest)(nil), "community.RequestEmailChangeRequest")
+	proto.RegisterType((*RequestEmailChangeResponse)(nil), "community.RequestEmailChangeResponse")
+	proto.RegisterType((*ConfirmEmailChangeRequest)(nil), "community.ConfirmEmailChangeRequest")
+	proto.RegisterType((*ChangeUsernameRequest)(nil), "community.ChangeUsernameRequest")
+	proto.RegisterType((*UsernameChange)(nil), "community.UsernameChange")
+	proto.RegisterType((*UsernameHistoryRequest)(nil), "community.UsernameHistoryRequest")
+	proto.RegisterType((*UsernameHistoryResponse)(nil), "community.UsernameHistoryResponse")
+	proto.RegisterType((*ResolveUsernameRequest)(nil), "community.ResolveUsernameRequest")
+	proto.RegisterType((*MemberDeletion)(nil), "community.MemberDeletion")
+	proto.RegisterType((*DeleteMemberRequest)(nil), "community.DeleteMemberRequest")
+	proto.RegisterType((*CancelMemberDeletionRequest)(nil), "community.CancelMemberDeletionRequest")
+	proto.RegisterType((*CancelMemberDeletionResponse)(nil), "community.CancelMemberDeletionResponse")
+	proto.RegisterType((*ExportMemberDataRequest)(nil), "community.ExportMemberDataRequest")
+	proto.RegisterType((*MemberDataExport)(nil), "community.MemberDataExport")
+	proto.RegisterType((*Sanction)(nil), "community.Sanction")
+	proto.RegisterType((*SuspendMemberRequest)(nil), "community.SuspendMemberRequest")
+	proto.RegisterType((*BanMemberRequest)(nil), "community.BanMemberRequest")
+	proto.RegisterType((*UnsuspendRequest)(nil), "community.UnsuspendRequest")
+	proto.RegisterType((*UnsuspendResponse)(nil), "community.UnsuspendResponse")
+	proto.RegisterType((*LiftBanRequest)(nil), "community.LiftBanRequest")
+	proto.RegisterType((*LiftBanResponse)(nil), "community.LiftBanResponse")
+	proto.RegisterType((*SanctionsRequest)(nil), "community.SanctionsRequest")
+	proto.RegisterType((*SanctionsResponse)(nil), "community.SanctionsResponse")
+	proto.RegisterType((*MembersRequest)(nil), "community.MembersRequest")
+	proto.RegisterType((*MembersResponse)(nil), "community.MembersResponse")
+	proto.RegisterType((*DirectoryEntry)(nil), "community.DirectoryEntry")
+	proto.RegisterType((*DirectoryResponse)(nil), "community.DirectoryResponse")
+	proto.RegisterType((*Invitation)(nil), "community.Invitation")
+	proto.RegisterType((*CreateInvitationRequest)(nil), "community.CreateInvitationRequest")
+	proto.RegisterType((*RevokeInvitationRequest)(nil), "community.RevokeInvitationRequest")
+	proto.RegisterType((*RevokeInvitationResponse)(nil), "community.RevokeInvitationResponse")
+	proto.RegisterType((*InvitationsRequest)(nil), "community.InvitationsRequest")
+	proto.RegisterType((*InvitationsResponse)(nil), "community.InvitationsResponse")
+	proto.RegisterType((*Vouch)(nil), "community.Vouch")
+	proto.RegisterType((*VouchRequest)(nil), "community.VouchRequest")
+	proto.RegisterType((*RevokeVouchRequest)(nil), "community.RevokeVouchRequest")
+	proto.RegisterType((*RevokeVouchResponse)(nil), "community.RevokeVouchResponse")
+	proto.RegisterType((*VouchesRequest)(nil), "community.VouchesRequest")
+	proto.RegisterType((*VouchesResponse)(nil), "community.VouchesResponse")
+	proto.RegisterType((*CastVoteRequest)(nil), "community.CastVoteRequest")
+	proto.RegisterType((*VotesRequest)(nil), "community.VotesRequest")
+	proto.RegisterType((*VotesResponse)(nil), "community.VotesResponse")
+	proto.RegisterType((*PromoteRequest)(nil), "community.PromoteRequest")
+	proto.RegisterType((*PromoteResponse)(nil), "community.PromoteResponse")
+	proto.RegisterType((*DemoteRequest)(nil), "community.DemoteRequest")
+	proto.RegisterType((*DemoteResponse)(nil), "community.DemoteResponse")
+	proto.RegisterType((*GrantRoleRequest)(nil), "community.GrantRoleRequest")
+	proto.RegisterType((*GrantRoleResponse)(nil), "community.GrantRoleResponse")
+	proto.RegisterType((*RevokeRoleRequest)(nil), "community.RevokeRoleRequest")
+	proto.RegisterType((*RevokeRoleResponse)(nil), "community.RevokeRoleResponse")
+	proto.RegisterType((*RoleChange)(nil), "community.RoleChange")
+	proto.RegisterType((*RoleChangesRequest)(nil), "community.RoleChangesRequest")
+	proto.RegisterType((*RoleChangesResponse)(nil), "community.RoleChangesResponse")
+	proto.RegisterType((*VerificationRevocation)(nil), "community.VerificationRevocation")
+	proto.RegisterType((*RevokeVerificationRequest)(nil), "community.RevokeVerificationRequest")
+	proto.RegisterType((*VerificationRevocationsRequest)(nil), "community.VerificationRevocationsRequest")
+	proto.RegisterType((*VerificationRevocationsResponse)(nil), "community.VerificationRevocationsResponse")
+	proto.RegisterType((*StatsRequest)(nil), "community.StatsRequest")
+	proto.RegisterType((*StatsPeriod)(nil), "community.StatsPeriod")
+	proto.RegisterType((*SignUpFunnel)(nil), "community.SignUpFunnel")
+	proto.RegisterType((*ApplicationStateCount)(nil), "community.ApplicationStateCount")
+	proto.RegisterType((*ReviewerStats)(nil), "community.ReviewerStats")
+	proto.RegisterType((*Stats)(nil), "community.Stats")
+	proto.RegisterType((*AuditEntry)(nil), "community.AuditEntry")
+	proto.RegisterType((*AuditLogRequest)(nil), "community.AuditLogRequest")
+	proto.RegisterType((*AuditLogResponse)(nil), "community.AuditLogResponse")
+	proto.RegisterType((*VerifyAuditLogRequest)(nil), "community.VerifyAuditLogRequest")
+	proto.RegisterType((*VerifyAuditLogResponse)(nil), "community.VerifyAuditLogResponse")
+	proto.RegisterType((*EventsRequest)(nil), "community.EventsRequest")
+	proto.RegisterType((*Event)(nil), "community.Event")
+}
+
+func init() { proto.RegisterFile("community.proto", fileDescriptor_857922b7acda88b9) }
+
+var fileDescriptor_857922b7acda88b9 = []byte{
+	// 5207 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0xcd, 0x73, 0x1b, 0x47,
+	0x76, 0xf8, 0x0f, 0x1f, 0x24, 0x80, 0x87, 0x0f, 0x82, 0x4d, 0x8a, 0x04, 0x87, 0x94, 0x2c, 0x8d,
+	0x2d, 0x4b, 0xb6, 0x7f, 0xb6, 0x6c, 0xad, 0x2d, 0x3b, 0xf6, 0x7a, 0xcb, 0x20, 0x08, 0x49, 0xb4,
+	0x29, 0x92, 0x1e, 0x92, 0xb2, 0x77, 0x93, 0x78, 0x6a, 0x08, 0x34, 0xc9, 0x89, 0x80, 0x19, 0xec,
+	0xcc, 0x80, 0x12, 0x7c, 0xca, 0xde, 0x92, 0x54, 0x2e, 0x39, 0xa4, 0xb6, 0x52, 0xa9, 0x4a, 0x55,
+	0x3e, 0xf6, 0x98, 0x53, 0x2e, 0x39, 0xe4, 0x92, 0x1c, 0x72, 0x48, 0x55, 0x4e, 0x39, 0xe5, 0x96,
+	0x4b, 0xfe, 0x83, 0x9c, 0x72, 0x4a, 0xf5, 0xd7, 0x4c, 0xf7, 0x4c, 0x03, 0xfc, 0x50, 0x2a, 0x37,
+	0xf4, 0xeb, 0xee, 0xd7, 0xaf, 0x5f, 0xbf, 0x7e, 0xfd, 0xbe, 0x06, 0xb0, 0xd0, 0xf3, 0x87, 0xc3,
+	0xb1, 0xe7, 0x46, 0x93, 0x0f, 0x46, 0x81, 0x1f, 0xf9, 0xa8, 0x12, 0x03, 0xcc, 0x17, 0x50, 0x7e,
+	0x86, 0x23, 0xa7, 0xef, 0x44, 0x0e, 0xba, 0x09, 0x70, 0xe2, 0x06, 0x61, 0x64, 0x7b, 0xce, 0x10,
+	0xb7, 0x72, 0xb7, 0x73, 0xf7, 0x2b, 0x56, 0x85, 0x42, 0x76, 0x9d, 0x21, 0x46, 0xeb, 0x50, 0x19,
+	0x38, 0xa2, 0x37, 0x4f, 0x7b, 0xcb, 0x03, 0x87, 0x77, 0xbe, 0x09, 0xf5, 0x51, 0xe0, 0x9f, 0xb8,
+	0x03, 0x6c, 0xbb, 0x43, 0xe7, 0x14, 0xb7, 0x0a, 0x74, 0x40, 0x8d, 0x03, 0xb7, 0x09, 0xcc, 0xfc,
+	0x75, 0x1e, 0xe6, 0x9f, 0xe1, 0xe1, 0x31, 0x0e, 0x50, 0x03, 0xf2, 0x6e, 0x9f, 0xaf, 0x91, 0x77,
+	0xfb, 0x64, 0xed, 0x5e, 0x80, 0x9d, 0x08, 0xf7, 0x6d, 0x27, 0xa2, 0xd8, 0x0b, 0x56, 0x85, 0x43,
+	0xda, 0x11, 0xfa, 0x18, 0x56, 0xce, 0x71, 0xe0, 0x9e, 0xb8, 0xb8, 0x6f, 0xe3, 0xa1, 0xe3, 0x0e,
+	0x6c, 0xa7, 0xdf, 0x0f, 0x70, 0x18, 0xd2, 0x75, 0xca, 0xd6, 0xb2, 0xe8, 0xed, 0x92, 0xce, 0x36,
+	0xeb, 0x43, 0x06, 0x94, 0xc7, 0x21, 0x0e, 0x28, 0xc1, 0x45, 0x46, 0xb0, 0x68, 0x13, 0x82, 0x55,
+	0x44, 0x73, 0x8c, 0x60, 0x2c, 0x23, 0x78, 0x00, 0xe5, 0x21, 0xe7, 0x4e, 0x6b, 0xfe, 0x76, 0xee,
+	0x7e, 0xf5, 0xe1, 0xd2, 0x07, 0x09, 0x33, 0x05, 0xe3, 0xac, 0x78, 0x10, 0x59, 0x51, 0x50, 0xd2,
+	0x2a, 0x53, 0xca, 0xe2, 0x36, 0x5a, 0x86, 0xb9, 0xc0, 0x1f, 0xe0, 0xb0, 0x55, 0xb9, 0x5d, 0xb8,
+	0x5f, 0xb1, 0x58, 0xe3, 0xeb, 0x62, 0xb9, 0xd4, 0x2c, 0x9b, 0xff, 0x3d, 0x07, 0xd5, 0xf6, 0x68,
+	0x34, 0x70, 0x7b, 0x4e, 0xe4, 0xfa, 0x5e, 0x86, 0x3d, 0xeb, 0x50, 0x19, 0x52, 0xc6, 0xd9, 0x6e,
+	0x5f, 0xf0, 0x9e, 0x01, 0xb6, 0xfb, 0xe8, 0x1d, 0x68, 0x3a, 0xc9, 0x5c, 0x3b, 0xc2, 0xaf, 0x22,
+	0xce, 0xfe, 0x05, 0x09, 0x7e, 0x88, 0x5f, 0x45, 0x84, 0x86, 0x30, 0x72, 0x22, 0xc1, 0x0e, 0xd6,
+	0x20, 0x08, 0x02, 0xfc, 0x7b, 0xb8, 0x47, 0xa7, 0x07, 0xd8, 0x09, 0x7d, 0x8f, 0xb3, 0x63, 0x21,
+	0x86, 0x5b, 0x14, 0x9c, 0x3a, 0xa7, 0xf9, 0xf4, 0x39, 0xbd, 0x01, 0x55, 0x36, 0x83, 0xf5, 0x97,
+	0x68, 0x3f, 0x08, 0x10, 0x1b, 0xe0, 0x8c, 0x46, 0x81, 0x7f, 0xce, 0x06, 0x94, 0xd9, 0x00, 0x01,
+	0x4a, 0x61, 0x38, 0x9e, 0x70, 0x5e, 0xc5, 0x18, 0x36, 0x27, 0x0a, 0x86, 0xe3, 0x49, 0x0b, 0xd8,
+	0x00, 0x01, 0xda, 0x9c, 0xa0, 0xf7, 0x60, 0xee, 0xdc, 0x8f, 0x70, 0xd8, 0xaa, 0xde, 0x2e, 0xdc,
+	0xaf, 0x3e, 0xbc, 0x21, 0x9d, 0x98, 0x85, 0xcf, 0x5d, 0xfc, 0xf2, 0xb9, 0x1f, 0x61, 0x8b, 0x8d,
+	0x41, 0x77, 0xa0, 0x76, 0xe2, 0x07, 0x43, 0xfb, 0x1c, 0x07, 0xa1, 0xeb, 0x7b, 0xad, 0xda, 0xed,
+	0xdc, 0xfd, 0xba, 0x55, 0x25, 0xb0, 0xe7, 0x0c, 0x84, 0x1e, 0x41, 0xc9, 0xf1, 0xc2, 0x97, 0x38,
+	0x08, 0x5b, 0x75, 0x8a, 0x71, 0x43, 0xc2, 0x28, 0x1d, 0x5a, 0x9b, 0x0e, 0xb2, 0xc4, 0x60, 0x82,
+	0xfa, 0xa5, 0x1b, 0x9d, 0xf5, 0x03, 0xe7, 0xa5, 0x47, 0xf6, 0xda, 0xa0, 0x7b, 0xad, 0xc6, 0xb0,
+	0x76, 0x44, 0x84, 0x30, 0xc0, 0xe4, 0x8c, 0x26, 0xb6, 0x73, 0x12, 0xe1, 0xa0, 0xb5, 0x40, 0xc7,
+	0xd4, 0x38, 0xb0, 0x4d, 0x60, 0xe8, 0x23, 0x58, 0x1e, 0xe1, 0x60, 0xe8, 0x78, 0xd8, 0x8b, 0x06,
+	0x13, 0x5b, 0xb0, 0xa2, 0xd5, 0xa4, 0xf2, 0xb5, 0x24, 0xf5, 0x59, 0xbc, 0x8b, 0xf2, 0x28, 0x0c,
+	0xdd, 0x53, 0x0f, 0xf7, 0xed, 0xc8, 0x6f, 0x2d, 0xd2, 0xb3, 0x04, 0x01, 0x3a, 0xf4, 0x95, 0x01,
+	0x4e, 0xd4, 0x42, 0xfc, 0x18, 0x38, 0xa8, 0x1d, 0xa1, 0xfb, 0xd0, 0xec, 0x0d, 0x1c, 0x77, 0x68,
+	0xe3, 0x57, 0x23, 0x37, 0xc0, 0x21, 0x19, 0xb5, 0x44, 0x47, 0x35, 0x28, 0xbc, 0xcb, 0xc0, 0xed,
+	0x88, 0x6c, 0x13, 0x87, 0x3d, 0x67, 0x20, 0x64, 0x62, 0x99, 0x6d, 0x33, 0x86, 0xb5, 0x23, 0x22,
+	0x34, 0x0c, 0x0d, 0x1d, 0x70, 0x83, 0x09, 0x0d, 0x87, 0xb4, 0x23, 0xf3, 0x0f, 0xf3, 0x30, 0xdf,
+	0x1e, 0x8d, 0xb0, 0x33, 0xc8, 0xc8, 0xfd, 0x5d, 0x68, 0xc8, 0xa2, 0x1d, 0x0b, 0x7f, 0x5d, 0x82,
+	0x6e, 0xa7, 0xae, 0x47, 0x21, 0x75, 0x3d, 0x36, 0xa0, 0x42, 0xc5, 0x7c, 0x88, 0xbd, 0x88, 0xcb,
+	0x7d, 0x02, 0x48, 0x6e, 0xc4, 0x9c, 0x7c, 0x23, 0x2e, 0x10, 0xf3, 0x9b, 0x00, 0x7d, 0xdc, 0x73,
+	0xfb, 0x4c, 0x04, 0x4b, 0x0c, 0x27, 0x87, 0x6c, 0x4e, 0xe4, 0xee, 0x58, 0xc6, 0x45, 0x77, 0x3b,
+	0x22, 0x4a, 0x82, 0x34, 0xa8, 0xbc, 0x55, 0x18, 0xb1, 0xa2, 0x6d, 0x7e, 0x0f, 0x8b, 0x8f, 0xdd,
+	0x01, 0x66, 0xec, 0xb0, 0xf0, 0x2f, 0xc7, 0x38, 0x8c, 0x34, 0x5c, 0xc8, 0xe9, 0xb8, 0xa0, 0x6c,
+	0x34, 0x9f, 0xda, 0xa8, 0xd9, 0x86, 0x96, 0x2c, 0xac, 0xd7, 0x58, 0xc0, 0xdc, 0x85, 0xa5, 0x2d,
+	0xba, 0x0b, 0x75, 0xf6, 0x3a, 0x54, 0x1c, 0x0a, 0x48, 0x26, 0x96, 0x19, 0x60, 0xbb, 0xaf, 0x6c,
+	0x36, 0x9f, 0xda, 0xec, 0x0a, 0x2c, 0xab, 0xf8, 0xc2, 0x91, 0xef, 0x85, 0xd8, 0x7c, 0x1b, 0x1a,
+	0x0c, 0x12, 0x8a, 0x25, 0xe2, 0x53, 0xca, 0x49, 0xa7, 0x64, 0xfe, 0x0c, 0x16, 0xe2, 0x71, 0x6c,
+	0x2a, 0x7a, 0x0f, 0x4a, 0x6c, 0xe9, 0xb0, 0x95, 0xa3, 0x97, 0x75, 0x51, 0xbd, 0xac, 0x64, 0x19,
+	0x31, 0xc2, 0xfc, 0x97, 0x1c, 0x2c, 0x49, 0x3c, 0x21, 0xda, 0x21, 0xd4, 0x69, 0x5f, 0x9d, 0x82,
+	0xcd, 0xeb, 0x15, 0x6c, 0x5a, 0x9f, 0x14, 0x66, 0xea, 0x93, 0xe2, 0x55, 0xf4, 0xc9, 0x4d, 0x80,
+	0x80, 0x50, 0xc8, 0xa4, 0x6a, 0x8e, 0x49, 0x15, 0x87, 0xb4, 0x23, 0xf3, 0xaf, 0x72, 0xb0, 0xd2,
+	0xed, 0xbb, 0x91, 0xb2, 0xa1, 0x2b, 0xc9, 0xcf, 0x15, 0xb6, 0x29, 0xed, 0xa1, 0x70, 0x85, 0x3d,
+	0x98, 0x1d, 0x30, 0xbe, 0xe3, 0xfa, 0xef, 0xda, 0x74, 0x9a, 0x37, 0x61, 0x5d, 0x8b, 0x84, 0x4b,
+	0xcf, 0x16, 0xac, 0x6b, 0x0e, 0x35, 0xbc, 0xe2, 0x22, 0xbf, 0x03, 0x1b, 0x7a, 0x2c, 0x5c, 0xd0,
+	0x7e, 0x0a, 0x95, 0x40, 0x00, 0xb9, 0xa8, 0xdd, 0xd2, 0xf3, 0x40, 0xcc, 0xb5, 0x92, 0x09, 0xe6,
+	0x0f, 0xb0, 0x98, 0xe1, 0x12, 0x51, 0xca, 0x94, 0x44, 0x85, 0x2c, 0x10, 0xa0, 0x6d, 0x6a, 0x41,
+	0x9c, 0x3b, 0x83, 0xb1, 0xb0, 0xbe, 0x58, 0x03, 0x21, 0x28, 0x12, 0x13, 0x8b, 0xeb, 0x3d, 0xfa,
+	0xdb, 0xfc, 0x8f, 0x1c, 0xd4, 0x1e, 0xfb, 0xc1, 0xf0, 0x5b, 0x3e, 0x39, 0x23, 0xd2, 0xcb, 0x30,
+	0x37, 0x70, 0x8e, 0xf1, 0x40, 0xa0, 0xa2, 0x0d, 0x82, 0x2a, 0x9a, 0x8c, 0x62, 0x54, 0xe4, 0x37,
+	0xb9, 0xc0, 0x01, 0xfe, 0xe5, 0x98, 0xe8, 0x6a, 0xaa, 0x3d, 0xcb, 0x56, 0xdc, 0x26, 0x22, 0x39,
+	0x74, 0x3d, 0x7b, 0x80, 0xbd, 0xd3, 0xe8, 0x8c, 0x8a, 0x64, 0xdd, 0xaa, 0x0c, 0x5d, 0x6f, 0x87,
+	0x02, 0x68, 0xb7, 0xf3, 0x4a, 0x74, 0xcf, 0xf3, 0x6e, 0xe7, 0x15, 0xef, 0x6e, 0x41, 0xa9, 0x77,
+	0xe6, 0xbb, 0x3d, 0x1c, 0xb6, 0x4a, 0xf4, 0x15, 0x17, 0x4d, 0x64, 0x42, 0x9d, 0x4c, 0xa4, 0xe6,
+	0x64, 0xe8, 0xfe, 0x88, 0xa9, 0x0e, 0xad, 0x5b, 0xd5, 0xa1, 0xf3, 0x8a, 0x68, 0xc7, 0x03, 0xf7,
+	0x47, 0x6c, 0xfe, 0x69, 0x0e, 0x16, 0x24, 0x1e, 0x92, 0xdd, 0x66, 0x76, 0xd9, 0x82, 0x92, 0xb8,
+	0x88, 0x79, 0x8a, 0x41, 0x34, 0xd1, 0x27, 0x50, 0x11, 0x8c, 0x15, 0x22, 0xbc, 0x2a, 0x1d, 0x9f,
+	0xcc, 0x3b, 0x2b, 0x19, 0x49, 0xae, 0xf7, 0x68, 0x7c, 0x3c, 0x70, 0xc3, 0x33, 0x76, 0x0b, 0x8b,
+	0xec, 0xb1, 0x8b, 0x61, 0xed, 0xc8, 0x7c, 0x08, 0x2b, 0x29, 0xb2, 0x84, 0xe4, 0x49, 0xd4, 0xe4,
+	0x14, 0x6a, 0xcc, 0xe7, 0x70, 0x73, 0x9f, 0xa1, 0x98, 0x32, 0x55, 0x21, 0x37, 0x77, 0x59, 0x72,
+	0xcd, 0x3f, 0xce, 0x01, 0x24, 0x36, 0x4f, 0x86, 0x3d, 0xd4, 0xd6, 0x22, 0xbd, 0xb2, 0x5d, 0x09,
+	0x02, 0x94, 0x52, 0xde, 0x05, 0x55, 0x79, 0xd3, 0xd3, 0xf3, 0x87, 0xd2, 0xa3, 0x2a, 0x9a, 0x68,
+	0x15, 0x4a, 0x3d, 0xe2, 0x28, 0xc4, 0x5a, 0x6a, 0x9e, 0x34, 0xdb, 0x91, 0xf9, 0xd7, 0x39, 0xa8,
+	0xb6, 0x7b, 0x3d, 0x1c, 0x86, 0x87, 0xfe, 0x0b, 0xec, 0xe9, 0x8e, 0x2b, 0x1c, 0x1f, 0x13, 0x1b,
+	0x86, 0xd3, 0x22, 0x9a, 0xe4, 0x89, 0x71, 0xc3, 0x70, 0xcc, 0x98, 0x5e, 0xa0, 0x48, 0xcb, 0x0c,
+	0x20, 0x9b, 0x17, 0x61, 0x72, 0x24, 0x15, 0x1c, 0x1b, 0x28, 0x1f, 0xc0, 0x92, 0xb0, 0x74, 0xe8,
+	0xda, 0x76, 0x44, 0x16, 0xe7, 0xef, 0xfd, 0x22, 0xeb, 0x92, 0xa8, 0x32, 0x7f, 0x95, 0x83, 0xfa,
+	0x81, 0x7b, 0xea, 0x1d, 0x8d, 0x04, 0xf7, 0x65, 0x3f, 0x22, 0x77, 0x91, 0x1f, 0x91, 0xbf, 0xc0,
+	0x8f, 0x28, 0x5c, 0xc2, 0x8f, 0x30, 0xff, 0x3e, 0x07, 0xeb, 0x8c, 0x06, 0xa2, 0xe9, 0xb6, 0xbd,
+	0x73, 0x37, 0x52, 0x34, 0xe5, 0xff, 0x39, 0x45, 0xe8, 0x1e, 0x2c, 0xb8, 0x31, 0x19, 0x76, 0xcf,
+	0xef, 0x0b, 0x1f, 0xa2, 0x91, 0x80, 0x3b, 0x7e, 0x1f, 0x9b, 0x9f, 0xc3, 0x12, 0xa7, 0x72, 0xc7,
+	0x3f, 0x75, 0x63, 0x8a, 0x33, 0x54, 0xe5, 0xb2, 0x54, 0x11, 0x83, 0x40, 0x9d, 0xcb, 0x55, 0xfa,
+	0x9f, 0xe5, 0xa0, 0x76, 0x65, 0x6c, 0xe8, 0x53, 0x68, 0x71, 0xab, 0x90, 0x1f, 0x3c, 0xbd, 0xa6,
+	0x3d, 0xfb, 0x05, 0x9e, 0x50, 0x9e, 0xd4, 0xac, 0x1b, 0xac, 0x9f, 0x9d, 0x3e, 0xbd, 0x81, 0xbd,
+	0x6f, 0x30, 0xf1, 0x20, 0x16, 0x7b, 0xbe, 0x77, 0xe2, 0x06, 0x43, 0x69, 0xb7, 0x4c, 0xfe, 0x9b,
+	0x72, 0x07, 0xdd, 0xef, 0xef, 0xe7, 0xd8, 0x7b, 0x33, 0x79, 0xec, 0x07, 0xcf, 0xa9, 0xaf, 0xa7,
+	0x3e, 0x6a, 0xba, 0x57, 0x35, 0x77, 0xe1, 0xab, 0x9a, 0xbf, 0xca, 0xab, 0xfa, 0x14, 0xd6, 0xda,
+	0xcc, 0xff, 0xb9, 0xf6, 0xa3, 0xfa, 0x75, 0xb1, 0x9c, 0x6f, 0x16, 0xcc, 0x0d, 0x30, 0x74, 0x98,
+	0xf8, 0x31, 0xfc, 0x5d, 0x0e, 0x5a, 0xcc, 0xc7, 0xb8, 0xf6, 0x3a, 0x68, 0x05, 0xe6, 0xb9, 0x87,
+	0xc9, 0xc4, 0x92, 0xb7, 0xd0, 0xff, 0x07, 0xe4, 0x9f, 0xe3, 0x20, 0x70, 0xfb, 0xd8, 0xee, 0xf9,
+	0xfe, 0xc0, 0xee, 0xfb, 0x2f, 0x3d, 0xee, 0xdd, 0x37, 0x45, 0x4f, 0xc7, 0xf7, 0x07, 0x5b, 0xfe,
+	0x4b, 0x0f, 0xbd, 0x0b, 0x8b, 0xf1, 0x20, 0x3b, 0xc4, 0x3d, 0xdf, 0xeb, 0x87, 0xfc, 0xe6, 0x2f,
+	0xf4, 0xf8, 0xa0, 0x03, 0x06, 0x36, 0xd7, 0x61, 0x4d, 0x43, 0x34, 0xdf, 0xd2, 0x5f, 0x16, 0x15,
+	0x13, 0x30, 0xb6, 0x12, 0x10, 0x14, 0x3d, 0x61, 0xff, 0xd4, 0x2d, 0xfa, 0x3b, 0x31, 0x42, 0x0b,
+	0xb2, 0xab, 0x90, 0xf2, 0xb5, 0x8a, 0x19, 0x5f, 0xeb, 0x16, 0xc0, 0xd8, 0x13, 0x6d, 0xaa, 0x76,
+	0xca, 0x96, 0x04, 0x21, 0xb2, 0x1c, 0xfb, 0x1a, 0xd4, 0x09, 0x64, 0xee, 0x46, 0x8d, 0x03, 0x99,
+	0x13, 0x78, 0x17, 0x1a, 0x62, 0xd0, 0x31, 0x3e, 0xf1, 0x03, 0xcc, 0x7d, 0x6b, 0x31, 0x75, 0x93,
+	0x02, 0x09, 0x77, 0x7b, 0xe3, 0x20, 0xf4, 0x03, 0xfa, 0x62, 0x56, 0x2c, 0xde, 0x22, 0x70, 0x4a,
+	0xad, 0x08, 0x3e, 0xf0, 0x96, 0xea, 0x38, 0x41, 0xca, 0x71, 0x4a, 0x3d, 0x0f, 0xd5, 0xcc, 0xf3,
+	0xc0, 0x8e, 0x9c, 0x3b, 0xf3, 0x94, 0xf4, 0x1a, 0x23, 0x4a, 0x40, 0x19, 0xed, 0xf7, 0x60, 0x21,
+	0xf1, 0xd8, 0x19, 0xf1, 0x75, 0xe6, 0x4a, 0xc6, 0x5e, 0x3b, 0xa3, 0xfe, 0x2e, 0x34, 0x92, 0xe8,
+	0x01, 0xc5, 0xc7, 0x7c, 0xe6, 0xba, 0x80, 0xc6, 0xf8, 0xe2, 0x61, 0x1c, 0x1f, 0xf3, 0x9b, 0xe3,
+	0xd9, 0x09, 0x37, 0x42, 0xec, 0x04, 0xbd, 0x33, 0xea, 0x2b, 0x57, 0x2c, 0xde, 0x22, 0x08, 0x42,
+	0x3f, 0x88, 0xec, 0x3e, 0x0e, 0x7b, 0xd8, 0xeb, 0xbb, 0xde, 0x29, 0x75, 0x91, 0xcb, 0x56, 0x83,
+	0x80, 0xb7, 0x62, 0xe8, 0xd7, 0xc5, 0x72, 0xae, 0x99, 0x37, 0xff, 0x26, 0x07, 0xcb, 0xaa, 0x8c,
+	0x70, 0x1b, 0xf0, 0x73, 0xa8, 0x49, 0xc2, 0x2d, 0x1e, 0xe6, 0x95, 0x29, 0x66, 0xa0, 0x32, 0x96,
+	0x08, 0x53, 0xe4, 0x47, 0xce, 0x80, 0x4b, 0x18, 0x6b, 0x10, 0x96, 0x13, 0x51, 0xb3, 0xf9, 0x21,
+	0x32, 0x41, 0x03, 0x02, 0xea, 0xb0, 0x83, 0x5c, 0x83, 0xf2, 0x99, 0x13, 0xda, 0x43, 0xb2, 0x69,
+	0x66, 0x8d, 0x95, 0xce, 0x9c, 0xf0, 0x99, 0x1f, 0x60, 0xf3, 0x2b, 0x58, 0xed, 0x10, 0xd7, 0xfc,
+	0xfa, 0x86, 0xf5, 0x26, 0xac, 0x1d, 0x79, 0xbd, 0xd7, 0xc3, 0xb1, 0x01, 0x86, 0x0e, 0x07, 0xbf,
+	0x6e, 0xcb, 0x80, 0x98, 0x3d, 0xf2, 0xed, 0x18, 0x8f, 0x31, 0x47, 0x6d, 0x7e, 0x01, 0xe8, 0xfa,
+	0x0b, 0x7e, 0x06, 0x6b, 0x4f, 0x70, 0xb4, 0x43, 0x2c, 0x8c, 0x2c, 0x0e, 0x45, 0xbe, 0x73, 0xaa,
+	0x7c, 0x13, 0x8f, 0xe9, 0x86, 0x34, 0xe7, 0x30, 0x70, 0xbc, 0xd0, 0xd5, 0x5a, 0xcb, 0xc4, 0xc4,
+	0x0e, 0xfc, 0x21, 0x57, 0x59, 0xf4, 0x37, 0x19, 0x13, 0xf9, 0xfc, 0x84, 0xf2, 0x91, 0x4f, 0x0e,
+	0xd4, 0xe9, 0x45, 0x7e, 0x20, 0x42, 0x6b, 0xb4, 0x21, 0xa9, 0xbb, 0x39, 0x45, 0xdd, 0xdd, 0x83,
+	0x85, 0x28, 0x5e, 0x4f, 0x8e, 0x32, 0x34, 0x64, 0x70, 0x3b, 0x32, 0x7f, 0x9d, 0x83, 0x55, 0x89,
+	0xc8, 0xa7, 0x6e, 0x18, 0xf9, 0xc1, 0xa4, 0xeb, 0x45, 0xc1, 0x04, 0x7d, 0x46, 0x43, 0x61, 0xa2,
+	0x8b, 0xd2, 0x3b, 0x5d, 0xfc, 0xe4, 0xa1, 0x68, 0x13, 0xaa, 0xc9, 0x3a, 0xe2, 0xb5, 0xb9, 0xad,
+	0x9f, 0x99, 0xf0, 0xc5, 0x92, 0x27, 0x11, 0xc6, 0x67, 0x09, 0xbb, 0x14, 0xe3, 0x7f, 0x01, 0x86,
+	0x6e, 0x66, 0xec, 0x59, 0x95, 0xb0, 0x17, 0x05, 0x2e, 0x16, 0x17, 0xca, 0xd4, 0xd3, 0x25, 0xb3,
+	0xc2, 0x12, 0x53, 0xcc, 0x7f, 0xcb, 0x29, 0xc2, 0xd4, 0xe1, 0x36, 0xe9, 0xf5, 0x03, 0x4b, 0xce,
+	0x38, 0x3a, 0xf3, 0xe5, 0xc0, 0x12, 0x03, 0x6c, 0x53, 0xa9, 0xa0, 0xaf, 0x79, 0x91, 0x7b, 0x4b,
+	0xe4, 0x8d, 0x30, 0xa0, 0xec, 0x7a, 0x11, 0xb1, 0xc4, 0x06, 0x5c, 0xd5, 0xc7, 0x6d, 0xd2, 0x27,
+	0x4c, 0x73, 0x7a, 0xd8, 0x65, 0x2b, 0x6e, 0xa7, 0x02, 0x4e, 0xa5, 0x54, 0xc0, 0xc9, 0xfc, 0x81,
+	0xbc, 0x61, 0x74, 0xf0, 0xb6, 0x47, 0x62, 0x09, 0xd7, 0x79, 0x79, 0xe5, 0xe5, 0x79, 0x24, 0x46,
+	0xb4, 0xcd, 0x9f, 0x43, 0x8b, 0x19, 0x15, 0xaf, 0xf5, 0xb0, 0x33, 0x7b, 0x44, 0x3c, 0xec, 0xac,
+	0x65, 0x1e, 0xc0, 0x4a, 0xbb, 0xdf, 0xdf, 0xe6, 0x4c, 0xd8, 0xf5, 0x23, 0x7c, 0x45, 0xc4, 0xe4,
+	0x29, 0xf6, 0x23, 0xe1, 0xf4, 0xd2, 0xdf, 0x24, 0x8e, 0x90, 0x3d, 0xe4, 0xab, 0xba, 0xf8, 0xdf,
+	0xc3, 0xba, 0x16, 0x09, 0x97, 0xc3, 0xdf, 0x82, 0x32, 0xf7, 0x68, 0x84, 0x20, 0xde, 0xd4, 0x0b,
+	0x22, 0x9f, 0x69, 0xc5, 0xc3, 0xcd, 0x2a, 0x54, 0x9e, 0xc5, 0xda, 0xed, 0x01, 0x34, 0x9f, 0xe0,
+	0x88, 0xe5, 0x3d, 0x2e, 0x75, 0x3d, 0xfe, 0x39, 0x07, 0xcb, 0x47, 0xa3, 0xbe, 0x13, 0xe1, 0x7d,
+	0x96, 0x3d, 0xb9, 0xcc, 0xac, 0x54, 0xf6, 0x26, 0x3f, 0x33, 0x7b, 0x53, 0xb8, 0x28, 0x7b, 0x53,
+	0xcc, 0x66, 0x6f, 0xd0, 0x87, 0xb0, 0x1c, 0xe0, 0xa1, 0x7f, 0x8e, 0x6d, 0x75, 0x2c, 0x13, 0x73,
+	0xc4, 0xfa, 0xf6, 0xa5, 0x19, 0xe6, 0x57, 0xb1, 0xd4, 0xd2, 0xb4, 0x4c, 0xe7, 0xcc, 0xf1, 0x4e,
+	0xf1, 0x95, 0x1c, 0x82, 0x0d, 0x30, 0x74, 0x18, 0xf8, 0x6b, 0x32, 0x84, 0xb5, 0x0e, 0x33, 0xc7,
+	0xaf, 0x89, 0x5f, 0x6f, 0xe9, 0xe7, 0xa7, 0x58, 0xfa, 0xfb, 0x70, 0x83, 0x2d, 0x71, 0xc4, 0x5d,
+	0xad, 0x4b, 0x9d, 0x8b, 0xec, 0xaa, 0xe5, 0x55, 0x57, 0x8d, 0xbc, 0x40, 0x0d, 0x81, 0x8c, 0xa1,
+	0xbe, 0x5a, 0xe6, 0xe7, 0x0e, 0xd4, 0xfc, 0x41, 0xdf, 0x8e, 0xf1, 0xb3, 0x73, 0xad, 0xfa, 0x83,
+	0xbe, 0xc0, 0x4a, 0x86, 0x78, 0xf8, 0xa5, 0x9d, 0xca, 0x83, 0x55, 0x3d, 0xfc, 0x32, 0x1e, 0x42,
+	0x74, 0x0f, 0x5d, 0x5c, 0x0e, 0x2c, 0x72, 0x48, 0x3b, 0x32, 0x3f, 0x81, 0x15, 0x31, 0xf4, 0x2a,
+	0x4a, 0xde, 0x86, 0xd5, 0xcc, 0x34, 0x7e, 0xb3, 0xb6, 0xa0, 0x29, 0xe8, 0xb1, 0xd9, 0x3a, 0xe2,
+	0x86, 0xad, 0x49, 0x37, 0x4c, 0x65, 0x8c, 0xb5, 0x30, 0x56, 0xda, 0xa1, 0xf9, 0x31, 0xac, 0x58,
+	0x38, 0xf4, 0x07, 0xe7, 0x99, 0xf3, 0x98, 0xe1, 0x1d, 0x9b, 0x7f, 0x91, 0x83, 0x06, 0xbb, 0x8b,
+	0x5b, 0x78, 0x80, 0xaf, 0x9e, 0x6c, 0xbb, 0x03, 0xb5, 0x80, 0x2d, 0xc3, 0x82, 0xff, 0x9c, 0xe5,
+	0x31, 0x6c, 0x73, 0xa2, 0x0e, 0x49, 0x82, 0x44, 0x31, 0xac, 0x1d, 0x11, 0x33, 0x0e, 0x07, 0x4e,
+	0x88, 0x13, 0x86, 0x97, 0x68, 0x9b, 0xc6, 0x8f, 0x96, 0x28, 0x65, 0xf8, 0x0a, 0x1a, 0xe3, 0x73,
+	0x58, 0xef, 0x38, 0x5e, 0x0f, 0x0f, 0xd4, 0x9d, 0x5d, 0x6a, 0xee, 0x2d, 0xd8, 0xd0, 0xcf, 0xe5,
+	0x97, 0xec, 0x29, 0xac, 0x76, 0x5f, 0x8d, 0xfc, 0x80, 0x6b, 0xb0, 0x2d, 0x12, 0x16, 0xb8, 0x8c,
+	0xdc, 0x37, 0xa1, 0xf0, 0xa3, 0x3b, 0xa2, 0xfc, 0x2b, 0x5b, 0xe4, 0xa7, 0xb9, 0x0d, 0xcd, 0x04,
+	0x07, 0xc3, 0x49, 0x78, 0xd5, 0xf3, 0xbd, 0x08, 0x7b, 0x91, 0x4d, 0x23, 0x8f, 0x0c, 0x4b, 0x95,
+	0xc3, 0x0e, 0x49, 0x00, 0x12, 0x41, 0x91, 0x86, 0x29, 0x98, 0xcb, 0x4e, 0x7f, 0x9b, 0xff, 0x90,
+	0x87, 0xf2, 0x81, 0xe3, 0xf5, 0xae, 0x7e, 0x7e, 0x19, 0xb5, 0x50, 0xd0, 0xa8, 0x05, 0x11, 0x07,
+	0x2d, 0x4a, 0x71, 0xd0, 0x69, 0x96, 0x5c, 0x12, 0x9a, 0x3a, 0x9e, 0xd0, 0x67, 0xbd, 0x22, 0x42,
+	0x53, 0x9b, 0x13, 0x35, 0x6e, 0x55, 0x9a, 0x19, 0xb7, 0x2a, 0xa7, 0xe3, 0x56, 0x44, 0x63, 0xbb,
+	0x27, 0x5c, 0x86, 0x2a, 0x6c, 0x2e, 0x03, 0x28, 0x9d, 0x34, 0x07, 0xca, 0xd4, 0xb9, 0x7b, 0x12,
+	0xa7, 0x48, 0xc9, 0x6f, 0x91, 0xca, 0xe5, 0x8e, 0x1b, 0x01, 0xb1, 0x2c, 0xae, 0xe9, 0xc0, 0xf2,
+	0xc1, 0x38, 0x1c, 0x61, 0xaf, 0x7f, 0x79, 0x21, 0x9b, 0xea, 0xb9, 0x2f, 0xc3, 0xdc, 0xd8, 0x8b,
+	0xdc, 0x01, 0x8f, 0xcb, 0xb1, 0x86, 0xf9, 0x04, 0x9a, 0x9b, 0x8e, 0xf7, 0xfa, 0xe8, 0x09, 0xa2,
+	0x23, 0x2f, 0x64, 0xd4, 0xbe, 0x16, 0xa2, 0x25, 0x58, 0x94, 0x10, 0x71, 0xe9, 0xee, 0x42, 0x63,
+	0xc7, 0x3d, 0x89, 0x36, 0x1d, 0xef, 0xb5, 0x70, 0x2f, 0xc2, 0x42, 0x8c, 0x86, 0x63, 0x7e, 0x00,
+	0x4d, 0x21, 0xa1, 0xe1, 0xa5, 0x2e, 0xe2, 0x63, 0x58, 0x94, 0x26, 0x70, 0x55, 0xf9, 0x11, 0x54,
+	0x42, 0x01, 0xe4, 0x3a, 0x52, 0x0e, 0xd4, 0x89, 0x09, 0x56, 0x32, 0xca, 0xfc, 0xc7, 0x82, 0xd0,
+	0x70, 0xa1, 0xa4, 0x10, 0x47, 0x3e, 0xb3, 0xdb, 0xc5, 0xb2, 0xa2, 0xad, 0x8d, 0x74, 0xc8, 0x65,
+	0x0c, 0xdc, 0x56, 0x10, 0xed, 0x19, 0xa5, 0x18, 0xec, 0xb6, 0xe8, 0x4b, 0x31, 0x10, 0x14, 0x03,
+	0x7f, 0x20, 0xb2, 0xac, 0xf4, 0x37, 0x61, 0xe6, 0xb1, 0xe3, 0x91, 0xa0, 0x08, 0xbb, 0x36, 0xbc,
+	0x45, 0xee, 0x05, 0x8d, 0x29, 0x2a, 0xd9, 0x55, 0x0e, 0xd9, 0x9c, 0x64, 0xe3, 0x25, 0xe5, 0x4b,
+	0xc5, 0x4b, 0x2a, 0xba, 0x78, 0x49, 0x8b, 0xa6, 0x0b, 0x07, 0x64, 0x9f, 0xec, 0x12, 0x89, 0xa6,
+	0x14, 0x3b, 0xa8, 0x2a, 0xb1, 0x03, 0x04, 0xc5, 0xd0, 0x0f, 0x22, 0x1a, 0xe9, 0xa8, 0x58, 0xf4,
+	0xb7, 0x2e, 0x9e, 0x50, 0xd7, 0xc5, 0x13, 0x58, 0x10, 0xb5, 0x37, 0x18, 0xf7, 0xb1, 0xdd, 0xa7,
+	0x3a, 0xbe, 0x4f, 0x23, 0x1c, 0x65, 0xab, 0xc1, 0xc1, 0x4c, 0xf3, 0xf7, 0x49, 0x66, 0x33, 0x3e,
+	0xc2, 0x24, 0xb3, 0xc9, 0x44, 0x45, 0x97, 0xd9, 0xe4, 0xf7, 0x4c, 0x8c, 0x30, 0xff, 0x29, 0x07,
+	0x8d, 0x2d, 0x37, 0xc0, 0xbd, 0xc4, 0x59, 0x4c, 0x6b, 0xc9, 0x19, 0x76, 0xc9, 0xd5, 0xa3, 0xc3,
+	0xb2, 0xc0, 0x14, 0xa7, 0xd5, 0xbd, 0xcc, 0x49, 0x75, 0x2f, 0x44, 0xf1, 0xf3, 0xab, 0x10, 0xba,
+	0x5e, 0x0f, 0x73, 0xef, 0xb7, 0xca, 0x60, 0x07, 0x04, 0x64, 0x3e, 0x85, 0xc5, 0x78, 0x0f, 0x31,
+	0x1b, 0x7e, 0x92, 0xf6, 0x0e, 0x65, 0x93, 0x41, 0xdd, 0x72, 0xe2, 0x14, 0xfe, 0x6d, 0x1e, 0x20,
+	0x09, 0xa2, 0xeb, 0xdc, 0x7b, 0xc9, 0xf0, 0xa3, 0xbf, 0x65, 0x87, 0x2c, 0x7e, 0xe5, 0x85, 0x43,
+	0xc6, 0x52, 0xfc, 0x92, 0xbf, 0x56, 0xd4, 0x14, 0x08, 0x48, 0xaa, 0x7d, 0x2e, 0xad, 0xda, 0xd7,
+	0xa0, 0x4c, 0xf2, 0x5b, 0xe3, 0x10, 0x87, 0x3c, 0x2d, 0x56, 0x1a, 0x3a, 0xaf, 0x8e, 0x42, 0x4c,
+	0x2f, 0x0a, 0x05, 0x97, 0xd8, 0x75, 0x24, 0xbf, 0xb3, 0x6f, 0x56, 0x59, 0x6f, 0xca, 0x86, 0x2f,
+	0xdc, 0x91, 0x7d, 0x2e, 0xc5, 0xa0, 0xa9, 0xd0, 0x97, 0xad, 0x26, 0xe9, 0x90, 0x63, 0xd3, 0x3c,
+	0x97, 0xec, 0xbf, 0x60, 0xe4, 0x43, 0x9c, 0x4b, 0x26, 0x90, 0x76, 0x64, 0xfe, 0x26, 0x07, 0xab,
+	0x1d, 0xba, 0x99, 0x6c, 0xea, 0x41, 0xa6, 0x3d, 0xa7, 0xd2, 0xae, 0xee, 0x3a, 0x9f, 0xde, 0xf5,
+	0xa5, 0x9e, 0x5e, 0xed, 0x36, 0x8a, 0xfa, 0x6d, 0x98, 0x3f, 0x83, 0x55, 0x8b, 0x12, 0x9d, 0x25,
+	0xf3, 0x4d, 0xa8, 0x4b, 0xf9, 0x8a, 0xf8, 0xb8, 0x6b, 0x09, 0x70, 0xbb, 0x6f, 0x1a, 0xd0, 0xca,
+	0xce, 0xe7, 0xfa, 0xfb, 0x23, 0x40, 0x09, 0xf4, 0x72, 0x1a, 0x7c, 0x17, 0x96, 0x94, 0x29, 0x5c,
+	0x64, 0x3f, 0x85, 0x6a, 0xb2, 0xaa, 0x10, 0x5b, 0xb9, 0x2c, 0x49, 0x5a, 0x5d, 0x1e, 0x69, 0xfe,
+	0x79, 0x0e, 0xe6, 0x9e, 0xfb, 0xe3, 0xde, 0xd9, 0x75, 0xc3, 0x17, 0x37, 0x01, 0xce, 0xc9, 0x7c,
+	0xb9, 0x30, 0xa6, 0xc2, 0x21, 0x72, 0xb7, 0x2c, 0xc4, 0x1c, 0xc2, 0x84, 0x58, 0x12, 0x92, 0xb9,
+	0xb4, 0x90, 0x7c, 0x02, 0x35, 0x4a, 0xdc, 0x15, 0xbd, 0xee, 0x2f, 0x68, 0x08, 0xd0, 0x7f, 0x81,
+	0xaf, 0x33, 0xf9, 0x06, 0x2c, 0x29, 0x93, 0xf9, 0x59, 0x7d, 0x0a, 0x0d, 0x0a, 0xc0, 0x57, 0x0d,
+	0x01, 0x7c, 0x09, 0x0b, 0xf1, 0x44, 0x7e, 0x5a, 0xef, 0x42, 0x89, 0xb1, 0x40, 0x9c, 0x54, 0x53,
+	0x3a, 0x29, 0xb6, 0xac, 0x18, 0x60, 0x7a, 0xb0, 0xd0, 0x71, 0xc2, 0xe8, 0xf9, 0xd5, 0x83, 0x1a,
+	0x33, 0xca, 0x62, 0xe4, 0xcc, 0x6a, 0x41, 0xc9, 0xac, 0x32, 0x96, 0x47, 0x57, 0xde, 0xe5, 0x4f,
+	0xa1, 0xce, 0xa7, 0xc5, 0x6f, 0x09, 0x2f, 0x91, 0xcb, 0x5d, 0x5c, 0x22, 0x67, 0x7e, 0x02, 0x8d,
+	0xfd, 0xc0, 0x1f, 0x4a, 0x7b, 0xbc, 0x94, 0xeb, 0xbe, 0x08, 0x0b, 0xf1, 0x34, 0x7e, 0x4c, 0x1f,
+	0x43, 0x7d, 0x0b, 0x5f, 0x19, 0x51, 0x13, 0x1a, 0x5b, 0x58, 0xc1, 0xd3, 0x81, 0xe6, 0x93, 0xc0,
+	0xf1, 0x22, 0xcb, 0xbf, 0x64, 0x6c, 0x44, 0x58, 0x1f, 0xf9, 0xc4, 0xfa, 0x20, 0xe6, 0xa0, 0x84,
+	0x24, 0xae, 0x1d, 0x59, 0x64, 0xf2, 0xf5, 0x5a, 0xa8, 0x97, 0x01, 0xc9, 0x58, 0x38, 0xee, 0xdf,
+	0x90, 0x64, 0xbc, 0x3f, 0xb8, 0x96, 0xa3, 0x2f, 0x56, 0x29, 0x24, 0xab, 0x10, 0x31, 0x39, 0x25,
+	0x1b, 0x88, 0x9f, 0x5c, 0xd1, 0x94, 0x1d, 0xfa, 0xe3, 0x09, 0x37, 0xb9, 0x84, 0x43, 0xcf, 0xdf,
+	0xae, 0xc4, 0xdf, 0x9f, 0x4f, 0xfb, 0xfb, 0x1f, 0x01, 0x4a, 0xc8, 0xbc, 0x9c, 0xe2, 0xdb, 0x83,
+	0x25, 0x65, 0x0a, 0x17, 0xb3, 0xcf, 0xa0, 0x46, 0x28, 0x4d, 0xf9, 0xf8, 0x8a, 0xb4, 0xc5, 0xb3,
+	0xac, 0x6a, 0x90, 0x60, 0x20, 0x9a, 0x6f, 0x45, 0x4d, 0xa6, 0x9e, 0xfb, 0xd7, 0x29, 0x8d, 0x4d,
+	0xec, 0xf5, 0x82, 0xe2, 0xb3, 0x48, 0xaa, 0xed, 0x78, 0x22, 0x8a, 0x02, 0x39, 0x84, 0x71, 0x68,
+	0x96, 0xe6, 0xdb, 0x27, 0x71, 0x2d, 0xaa, 0x85, 0x34, 0xf9, 0xde, 0x6b, 0xf9, 0x0f, 0x5f, 0xc2,
+	0x2d, 0xfd, 0x76, 0x2f, 0xc7, 0xff, 0x13, 0x78, 0x63, 0xea, 0x74, 0x7e, 0x16, 0x1d, 0x9a, 0xcc,
+	0xf3, 0xd5, 0x54, 0xd5, 0x1d, 0x59, 0xb5, 0x69, 0x11, 0x58, 0xf2, 0x2c, 0xf3, 0x10, 0x6a, 0x07,
+	0x91, 0x13, 0xc9, 0x7e, 0x05, 0x8d, 0x6e, 0x9f, 0x3b, 0x03, 0x41, 0x93, 0x68, 0x2b, 0x39, 0x93,
+	0x02, 0xcf, 0x99, 0xe8, 0x5d, 0xc5, 0x3f, 0xc9, 0x41, 0x95, 0xa2, 0xdd, 0xc7, 0x81, 0xeb, 0x27,
+	0xd9, 0x96, 0x9c, 0x6e, 0x66, 0x5e, 0x9a, 0x49, 0x6c, 0x11, 0x92, 0x44, 0xb5, 0xc7, 0xa3, 0x90,
+	0x57, 0xda, 0x95, 0x42, 0x5a, 0x35, 0x11, 0x12, 0x4e, 0x0f, 0x48, 0xc5, 0x00, 0x73, 0x4b, 0xea,
+	0x16, 0x6f, 0x51, 0x95, 0xd9, 0x8b, 0xdc, 0x73, 0x6c, 0x0b, 0x6b, 0x9a, 0x95, 0x2d, 0xd5, 0x19,
+	0x94, 0x5b, 0xdd, 0x24, 0xed, 0x52, 0x63, 0x05, 0x18, 0x8f, 0xc7, 0x9e, 0x87, 0x07, 0x84, 0xff,
+	0x3c, 0xc9, 0x3b, 0x1e, 0x71, 0xbb, 0xa7, 0xcc, 0x00, 0x47, 0xa3, 0x19, 0x3e, 0x11, 0xf3, 0xaa,
+	0xf4, 0x3e, 0x91, 0xe4, 0x7c, 0x70, 0xe2, 0x79, 0x33, 0x63, 0x4e, 0xd7, 0x13, 0x73, 0xda, 0xec,
+	0x28, 0x49, 0x2b, 0xc2, 0x37, 0xdc, 0xf1, 0xc7, 0xde, 0x94, 0x1a, 0x49, 0x02, 0xed, 0x91, 0x6e,
+	0x91, 0x67, 0xa4, 0x0d, 0xf3, 0x0f, 0x72, 0x50, 0xb7, 0x78, 0x22, 0x97, 0xb2, 0x9e, 0x95, 0x79,
+	0x31, 0x80, 0x38, 0x4a, 0xd1, 0x26, 0x7d, 0x22, 0x53, 0xcb, 0xd1, 0xc4, 0x6d, 0x36, 0x8f, 0x57,
+	0x24, 0xb3, 0x5d, 0xc4, 0x6d, 0xa2, 0xde, 0xd9, 0x38, 0x67, 0x60, 0x07, 0xa2, 0xea, 0x3c, 0x67,
+	0xd5, 0x04, 0xd0, 0x22, 0x45, 0x9c, 0xff, 0x5e, 0x80, 0xb9, 0x98, 0x84, 0xd7, 0x97, 0x26, 0xf4,
+	0x21, 0x94, 0x46, 0x54, 0x8e, 0x44, 0x79, 0xa5, 0x9c, 0x10, 0x93, 0xc4, 0xcc, 0x12, 0xc3, 0xd0,
+	0x03, 0x98, 0x3f, 0xa1, 0x87, 0x4c, 0x45, 0x41, 0xad, 0xac, 0x92, 0x65, 0xc0, 0xe2, 0xc3, 0xd0,
+	0x23, 0x58, 0x65, 0xa7, 0x2c, 0x1b, 0xa9, 0x6c, 0x87, 0xf3, 0x74, 0x87, 0x37, 0x68, 0xb7, 0x72,
+	0xa3, 0xc8, 0x59, 0x1c, 0xc2, 0x0d, 0x39, 0x07, 0x6c, 0x1f, 0x4f, 0x6c, 0x76, 0x62, 0xa5, 0x59,
+	0xf9, 0xb7, 0xe4, 0x88, 0xad, 0x25, 0x79, 0xfa, 0xe6, 0x84, 0xf6, 0x90, 0xca, 0x89, 0x21, 0xee,
+	0xbb, 0x8e, 0x67, 0xb3, 0x03, 0xb3, 0x23, 0x77, 0x88, 0xb9, 0x53, 0xdc, 0x64, 0x3d, 0xec, 0xa8,
+	0x0f, 0xdd, 0x21, 0xf1, 0x9f, 0x56, 0x68, 0x02, 0x39, 0x3b, 0x83, 0xf9, 0x0a, 0x4b, 0x24, 0x9d,
+	0x9c, 0x9e, 0xf4, 0x08, 0x2a, 0x42, 0x18, 0x42, 0x5a, 0x71, 0x5f, 0x7d, 0xd8, 0xca, 0xd8, 0x0c,
+	0x5c, 0x92, 0xac, 0x64, 0xa8, 0xf9, 0x47, 0x79, 0x80, 0xf6, 0xb8, 0xef, 0x46, 0x53, 0x5d, 0xd0,
+	0x90, 0x68, 0x12, 0xe2, 0xff, 0x91, 0x83, 0x2d, 0x5a, 0x71, 0x9b, 0xa6, 0x93, 0x68, 0x40, 0x43,
+	0x68, 0x6e, 0xd6, 0x9a, 0x9e, 0x66, 0x8d, 0x9c, 0xe0, 0x14, 0x47, 0x22, 0x38, 0xc7, 0x5a, 0xe4,
+	0x8a, 0xf9, 0xe3, 0xa8, 0xe7, 0x0f, 0x31, 0x8f, 0x31, 0x88, 0x26, 0x99, 0xd1, 0xc7, 0x91, 0xe3,
+	0x0e, 0x78, 0x80, 0x81, 0xb7, 0x48, 0xec, 0xcc, 0xef, 0xf5, 0xc6, 0x41, 0xa0, 0x7c, 0xa0, 0x20,
+	0x40, 0xcc, 0x8b, 0x19, 0x91, 0x1d, 0xfa, 0xe3, 0xd0, 0x3e, 0x73, 0xc2, 0x33, 0x5e, 0xc2, 0x5d,
+	0x13, 0xc0, 0xa7, 0x4e, 0x48, 0xa3, 0x04, 0xb4, 0x8f, 0x05, 0x15, 0xe8, 0x6f, 0xf3, 0x5f, 0x49,
+	0xc1, 0x22, 0x61, 0xc6, 0x8e, 0x7f, 0x3a, 0x2d, 0x30, 0x53, 0xbc, 0x20, 0x30, 0x13, 0xef, 0xbe,
+	0xa0, 0xdf, 0x7d, 0x51, 0xd9, 0x7d, 0xc2, 0xc3, 0x39, 0x85, 0x87, 0xd3, 0xb9, 0x42, 0x74, 0x08,
+	0x75, 0xc7, 0x59, 0xac, 0x92, 0x35, 0x92, 0x8b, 0x56, 0x96, 0xd5, 0x76, 0x07, 0x9a, 0xc9, 0x76,
+	0xf8, 0x2b, 0xf3, 0x20, 0xed, 0x9d, 0xcb, 0x8f, 0x7d, 0x22, 0x09, 0x89, 0x67, 0xbe, 0x0a, 0x37,
+	0xe8, 0x35, 0x99, 0xa4, 0x38, 0x63, 0x3a, 0xb0, 0x92, 0xee, 0xe0, 0x6b, 0x18, 0x50, 0x8e, 0x9c,
+	0xe1, 0x08, 0x93, 0x82, 0xd4, 0x1c, 0x8b, 0x35, 0x88, 0x36, 0xf1, 0x1e, 0xc5, 0x6f, 0x3b, 0x25,
+	0x5a, 0x4d, 0xd1, 0x71, 0xc0, 0xe1, 0xe6, 0x02, 0xd4, 0xbb, 0xe7, 0x52, 0xde, 0xd0, 0xfc, 0xaf,
+	0x02, 0xcc, 0x51, 0x08, 0x7a, 0x87, 0x07, 0x80, 0x09, 0xfe, 0x86, 0xb2, 0x09, 0xda, 0xff, 0x01,
+	0x09, 0x4c, 0xf3, 0xb8, 0x70, 0x4a, 0x60, 0xf2, 0x19, 0x81, 0x79, 0x07, 0xe6, 0xd9, 0x53, 0xc3,
+	0x43, 0x29, 0x9a, 0xb8, 0x0d, 0x1f, 0x90, 0x4e, 0xe8, 0x17, 0x2f, 0x9f, 0xd0, 0x7f, 0x04, 0x55,
+	0xc9, 0xd4, 0xe2, 0x8a, 0x6c, 0x8a, 0xa5, 0x05, 0x89, 0xa5, 0x65, 0xfe, 0x2a, 0x0f, 0x45, 0xb2,
+	0x19, 0x54, 0x85, 0xd2, 0xd1, 0xee, 0x37, 0xbb, 0x7b, 0xdf, 0xed, 0x36, 0xff, 0x1f, 0xaa, 0x43,
+	0xe5, 0x60, 0xfb, 0xc9, 0x6e, 0x77, 0xcb, 0x3e, 0xda, 0x6f, 0xe6, 0x48, 0x73, 0x67, 0xef, 0xc9,
+	0x93, 0xee, 0x96, 0xbd, 0xbd, 0xdb, 0xcc, 0xa3, 0x35, 0xb8, 0xd1, 0xde, 0xdf, 0xdf, 0xd9, 0xee,
+	0xb4, 0x0f, 0xb7, 0xf7, 0x76, 0xed, 0x83, 0xa3, 0xcd, 0x67, 0xdb, 0x87, 0x87, 0xdd, 0xad, 0x66,
+	0x01, 0xb5, 0x60, 0x59, 0xee, 0x6a, 0xef, 0xef, 0x5b, 0x7b, 0xcf, 0xbb, 0x5b, 0xcd, 0x62, 0xba,
+	0xc7, 0xea, 0x7e, 0xdd, 0xed, 0x90, 0x39, 0x73, 0xa8, 0x09, 0x35, 0x6b, 0x6f, 0xa7, 0x6b, 0x77,
+	0x9e, 0xb6, 0x77, 0x9f, 0x74, 0xb7, 0x9a, 0xf3, 0x68, 0x09, 0x16, 0xf6, 0xad, 0xbd, 0xc7, 0xdb,
+	0x12, 0xb0, 0x84, 0x10, 0x34, 0x9e, 0x75, 0x9f, 0x6d, 0x76, 0x2d, 0x7b, 0xab, 0xbb, 0xd3, 0x25,
+	0x53, 0xcb, 0x68, 0x11, 0xea, 0x1c, 0xd6, 0xb5, 0xda, 0x07, 0xdd, 0xad, 0x66, 0x85, 0xac, 0xf3,
+	0xbc, 0x6b, 0x6d, 0x3f, 0x4e, 0x16, 0x7a, 0xbe, 0xf7, 0x4d, 0x77, 0xab, 0x09, 0x68, 0x15, 0x96,
+	0x64, 0x0a, 0xba, 0xdf, 0xef, 0x6f, 0x5b, 0xdd, 0xad, 0x66, 0xf5, 0xe1, 0x7f, 0xde, 0x83, 0x4a,
+	0x47, 0x30, 0x0a, 0x7d, 0x02, 0xf3, 0x4c, 0xe9, 0xa3, 0x56, 0xe6, 0x1d, 0xe0, 0x82, 0x62, 0x64,
+	0x8f, 0x10, 0x7d, 0x0b, 0xcb, 0xba, 0x82, 0x4d, 0xf4, 0x76, 0x06, 0x89, 0xb6, 0xa2, 0x53, 0x87,
+	0x72, 0x0f, 0x6a, 0x72, 0x35, 0x24, 0xba, 0xa5, 0xa8, 0xdc, 0x4c, 0x89, 0xa5, 0xf1, 0xc6, 0xd4,
+	0xfe, 0xd8, 0x1e, 0x9f, 0x63, 0x98, 0xe4, 0x17, 0x4e, 0x41, 0xa1, 0xc8, 0x9a, 0x54, 0xa9, 0xfb,
+	0x1c, 0x96, 0x75, 0x35, 0x8e, 0xca, 0xee, 0x66, 0x14, 0x41, 0x1a, 0x53, 0x64, 0x18, 0xed, 0x67,
+	0x6b, 0xb8, 0xef, 0xe8, 0x87, 0x4a, 0xd5, 0xd0, 0x86, 0x31, 0x7d, 0x08, 0xfa, 0x01, 0x56, 0xf4,
+	0xa5, 0xd4, 0xe8, 0xbe, 0x34, 0x6b, 0x66, 0xb5, 0xf5, 0x4c, 0xfc, 0x3b, 0xb0, 0x90, 0xfa, 0xca,
+	0x42, 0xa1, 0x58, 0xff, 0x05, 0xc6, 0xd4, 0xfd, 0xf7, 0x61, 0x49, 0xf3, 0x29, 0x03, 0xba, 0x2b,
+	0x0d, 0x9f, 0xfe, 0xbd, 0x84, 0xf1, 0xf6, 0x45, 0xc3, 0xf8, 0xb9, 0x9f, 0x2a, 0xf5, 0x6b, 0xf1,
+	0xb7, 0x0c, 0x99, 0xd3, 0x9b, 0xf2, 0xc9, 0x84, 0x71, 0xef, 0xc2, 0x71, 0x7c, 0xa1, 0x2f, 0x01,
+	0x92, 0xaf, 0x97, 0x90, 0x5c, 0xbd, 0x9a, 0xf9, 0xa8, 0xc9, 0xc8, 0x7e, 0x98, 0x83, 0xbe, 0x51,
+	0xbf, 0x8a, 0x60, 0xc0, 0x37, 0xf5, 0x8b, 0x5f, 0x88, 0xec, 0x5b, 0x68, 0xec, 0x9d, 0xe3, 0x20,
+	0x1a, 0x07, 0x02, 0x93, 0x7c, 0x7f, 0x34, 0xdf, 0x31, 0x19, 0x6f, 0x4c, 0xed, 0xe7, 0xdb, 0xdb,
+	0x83, 0xda, 0xd1, 0xe8, 0xcc, 0x1f, 0xf4, 0xff, 0xb7, 0x10, 0x7e, 0x05, 0x25, 0x06, 0x09, 0xd1,
+	0x5a, 0x66, 0x07, 0xe1, 0x14, 0x71, 0x54, 0xbe, 0x77, 0x72, 0x68, 0xb5, 0x53, 0xaa, 0x60, 0x17,
+	0xbd, 0xa5, 0xce, 0xd0, 0x57, 0x06, 0x1b, 0x77, 0x2f, 0x18, 0xc5, 0x97, 0xf8, 0x81, 0xc4, 0x44,
+	0x52, 0xf5, 0xb3, 0xca, 0xa9, 0x4c, 0x2b, 0x09, 0x36, 0xde, 0x9a, 0x3d, 0x28, 0xe1, 0xaa, 0x04,
+	0x0e, 0xd1, 0x94, 0xcf, 0x68, 0x42, 0x1d, 0x57, 0xb5, 0x65, 0x99, 0x5b, 0xea, 0xb7, 0xb4, 0x37,
+	0xa7, 0x49, 0xef, 0xec, 0xab, 0xb9, 0x0b, 0xcd, 0x74, 0x39, 0x25, 0x92, 0x2b, 0xd1, 0xa6, 0xd4,
+	0x5a, 0x4e, 0xc5, 0xe7, 0x00, 0xca, 0x16, 0x46, 0x2a, 0x27, 0x35, 0xb5, 0xf6, 0xd2, 0xb8, 0x7b,
+	0xc1, 0x28, 0xbe, 0xf1, 0x67, 0x50, 0x95, 0xaa, 0x2b, 0x95, 0x8d, 0x67, 0xab, 0x2e, 0x2f, 0xe6,
+	0xa3, 0x05, 0x28, 0x5b, 0x59, 0xa9, 0x50, 0x3c, 0xb5, 0xf0, 0x72, 0x16, 0x17, 0xb2, 0x25, 0x7c,
+	0x69, 0x79, 0xd5, 0xd7, 0x14, 0x1a, 0x77, 0x2f, 0x18, 0xc5, 0xc9, 0xfe, 0x39, 0x20, 0x3e, 0x43,
+	0xaa, 0x95, 0x43, 0x6f, 0x65, 0x1f, 0xc7, 0x6c, 0x29, 0x9d, 0x31, 0xbb, 0xc2, 0x0b, 0x7d, 0x07,
+	0x8b, 0x99, 0x32, 0x39, 0x55, 0x41, 0x4d, 0x29, 0xa2, 0xbb, 0x08, 0xf1, 0x01, 0x2c, 0xa4, 0x8a,
+	0xe4, 0xd4, 0x77, 0x50, 0x5b, 0x40, 0x77, 0x11, 0xd2, 0x3e, 0x2c, 0x65, 0xa1, 0x21, 0xba, 0x3b,
+	0x73, 0x56, 0xa8, 0x7b, 0x5c, 0x66, 0x95, 0xc9, 0xbd, 0x0f, 0xf9, 0x67, 0x18, 0x2d, 0x2b, 0xe6,
+	0xcb, 0x0c, 0xa3, 0xe6, 0x0b, 0xa8, 0xc4, 0xd5, 0x70, 0x68, 0x5d, 0x95, 0x25, 0xa5, 0x5a, 0x40,
+	0x37, 0xb9, 0x03, 0x75, 0xa5, 0x30, 0x0e, 0xc9, 0x32, 0xac, 0x2b, 0x99, 0xd3, 0x21, 0x71, 0x62,
+	0xf9, 0x90, 0xaa, 0xc6, 0x74, 0xf2, 0x91, 0x2d, 0x2a, 0x53, 0x44, 0x70, 0x7a, 0x61, 0x1a, 0x7a,
+	0x06, 0x28, 0x5b, 0x98, 0xa6, 0x2c, 0x31, 0xb5, 0x6e, 0x4d, 0x47, 0x71, 0x17, 0x1a, 0x6a, 0xe1,
+	0x19, 0x92, 0x43, 0x05, 0xda, 0x9a, 0x34, 0x1d, 0x9a, 0xef, 0x61, 0x21, 0x55, 0x91, 0xa5, 0x08,
+	0x99, 0xbe, 0xc8, 0xcb, 0x30, 0x67, 0x0d, 0xe1, 0xfb, 0x7d, 0x02, 0x0b, 0xa9, 0x52, 0x2c, 0x05,
+	0xb3, 0xbe, 0x4c, 0x4b, 0x47, 0xe2, 0x36, 0xd4, 0xe4, 0xe2, 0xa7, 0xd4, 0x0b, 0x9b, 0xa9, 0x8a,
+	0x32, 0xd6, 0x32, 0x28, 0xe2, 0xaa, 0xae, 0x53, 0x58, 0xd6, 0xd5, 0x35, 0x29, 0x46, 0xcf, 0x8c,
+	0xa2, 0x29, 0xe3, 0xde, 0x85, 0xe3, 0xf8, 0xe6, 0x0f, 0xa0, 0x99, 0x2e, 0x90, 0x52, 0x1e, 0x8a,
+	0x29, 0xd5, 0x53, 0xc6, 0x7a, 0x96, 0xf6, 0xa4, 0x2e, 0xaa, 0x0b, 0x75, 0xa5, 0x42, 0x47, 0x91,
+	0x74, 0x5d, 0xed, 0x8e, 0xa1, 0x2b, 0x0b, 0x41, 0x5f, 0x42, 0x25, 0xae, 0xc2, 0x51, 0x6e, 0x5b,
+	0xba, 0x36, 0x47, 0x3f, 0xfd, 0x31, 0x54, 0xe2, 0x92, 0x19, 0x65, 0x7a, 0xba, 0x22, 0xc7, 0xd8,
+	0xd0, 0x77, 0x26, 0x76, 0x0e, 0x2f, 0x8f, 0x51, 0xec, 0x1c, 0xb5, 0xf2, 0xc6, 0x30, 0x74, 0x5d,
+	0x1c, 0xc3, 0x63, 0xa8, 0x08, 0xaa, 0x42, 0x85, 0x92, 0x74, 0x8d, 0x8d, 0xb1, 0xa1, 0xef, 0x4c,
+	0x28, 0x61, 0xfb, 0x56, 0x2d, 0x2e, 0xb5, 0x5e, 0xc6, 0x30, 0x74, 0x5d, 0xb1, 0x75, 0x51, 0x89,
+	0xcb, 0x0c, 0x66, 0xe1, 0xd8, 0xd0, 0xd5, 0x25, 0x48, 0x1a, 0xa2, 0x99, 0x4e, 0xb0, 0xab, 0xd6,
+	0x85, 0x3e, 0xfb, 0x6e, 0xe8, 0xd3, 0xc6, 0xe8, 0xb7, 0xa1, 0x99, 0x4e, 0x64, 0x2b, 0xe8, 0xa6,
+	0x64, 0xc9, 0x8d, 0x37, 0x67, 0x8e, 0xe1, 0xb4, 0xee, 0x40, 0x35, 0x81, 0x86, 0x8a, 0x59, 0x91,
+	0xcd, 0x90, 0x1b, 0xb7, 0xa6, 0x75, 0x73, 0x6c, 0x0f, 0x45, 0x4e, 0x7b, 0x35, 0x93, 0x57, 0xe5,
+	0x18, 0x32, 0x09, 0x57, 0x42, 0x81, 0x94, 0xf6, 0x4d, 0x1b, 0x36, 0xa9, 0x5c, 0xb2, 0x71, 0x6b,
+	0x5a, 0x77, 0x22, 0x03, 0x3c, 0xe9, 0xab, 0x9c, 0x9f, 0x9a, 0x41, 0x36, 0x0c, 0x5d, 0x57, 0xec,
+	0xe7, 0x94, 0x45, 0xde, 0x17, 0x19, 0x8a, 0x9e, 0x50, 0x92, 0xc1, 0x86, 0x3e, 0xb1, 0x8a, 0x3e,
+	0x27, 0x2c, 0x88, 0x70, 0x98, 0x62, 0x41, 0x92, 0xd8, 0x35, 0x5a, 0xd9, 0x8e, 0x84, 0x78, 0x9e,
+	0x56, 0x55, 0x88, 0x57, 0x33, 0xb4, 0x86, 0xa1, 0xeb, 0x8a, 0x89, 0x9f, 0x67, 0xf9, 0x54, 0x25,
+	0xc0, 0xa1, 0x24, 0x66, 0x8d, 0x35, 0x4d, 0x4f, 0x72, 0x13, 0xe3, 0xbc, 0xa9, 0xfa, 0x80, 0xa7,
+	0x52, 0xb2, 0xc6, 0x86, 0xbe, 0x93, 0xe3, 0xd9, 0x06, 0x48, 0x92, 0xa4, 0x8a, 0xaf, 0x98, 0xc9,
+	0xc0, 0x1a, 0x37, 0xa7, 0xf4, 0x26, 0x02, 0x2a, 0xa5, 0x1f, 0x55, 0xf1, 0xc8, 0x64, 0x32, 0x8d,
+	0x5b, 0xd3, 0xba, 0x39, 0xb6, 0xdf, 0x8d, 0x0b, 0x14, 0xe4, 0x48, 0xc7, 0x5b, 0x59, 0xa1, 0xd2,
+	0xc4, 0x39, 0x2e, 0x4e, 0xa8, 0xa1, 0x11, 0xac, 0xea, 0x7b, 0x42, 0xf4, 0xce, 0x85, 0xb3, 0xe3,
+	0x4d, 0xbc, 0x7b, 0x99, 0xa1, 0xc9, 0x8d, 0x63, 0x09, 0x96, 0xd5, 0x74, 0x26, 0x44, 0x77, 0xe3,
+	0xd8, 0xd0, 0x0e, 0x94, 0x45, 0xe0, 0x55, 0x91, 0xf0, 0x54, 0x98, 0xd6, 0x58, 0xd7, 0xf6, 0xf1,
+	0x85, 0x8f, 0xa0, 0xa1, 0xc6, 0x70, 0x15, 0xbb, 0x45, 0x1b, 0xf7, 0x35, 0xee, 0xcc, 0x18, 0xc1,
+	0xd1, 0x3e, 0x82, 0x79, 0x16, 0xb7, 0x55, 0x04, 0x58, 0x09, 0xe5, 0x1a, 0xcd, 0x74, 0xcf, 0x87,
+	0xb9, 0xcd, 0xf7, 0x7f, 0xf1, 0xde, 0xa9, 0x1b, 0x9d, 0x8d, 0x8f, 0x49, 0xdf, 0x83, 0x87, 0x1f,
+	0x7d, 0xec, 0x0c, 0x46, 0x67, 0x4e, 0x1f, 0x9f, 0x3f, 0x88, 0xc7, 0xbe, 0x7f, 0x3c, 0x78, 0x10,
+	0x8c, 0x7a, 0x5f, 0x04, 0xa3, 0xde, 0xf1, 0x3c, 0xfd, 0xb3, 0xac, 0x9f, 0xfc, 0xcf, 0x00, 0x4f,
+	0xa8, 0xc8, 0x02, 0x3f, 0x4b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CommunityClient is the client API for Community service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CommunityClient interface {
+	SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*Member, error)
+	SignUpWithInvitation(ctx context.Context, in *SignUpWithInvitationRequest, opts ...grpc.CallOption) (*Member, error)
+	RequestLogin(ctx context.Context, in *RequestLoginRequest, opts ...grpc.CallOption) (*RequestLoginResponse, error)
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*AccessToken, error)
+	ApplyForVerification(ctx context.Context, in *ApplyForVerificationRequest, opts ...grpc.CallOption) (*Application, error)
+	ApplicationForm(ctx context.Context, in *ApplicationFormRequest, opts ...grpc.CallOption) (*ApplicationForm, error)
+	PublishApplicationForm(ctx context.Context, in *PublishApplicationFormRequest, opts ...grpc.CallOption) (*ApplicationForm, error)
+	EditApplication(ctx context.Context, in *EditApplicationRequest, opts ...grpc.CallOption) (*Application, error)
+	WithdrawApplication(ctx context.Context, in *WithdrawApplicationRequest, opts ...grpc.CallOption) (*WithdrawApplicationResponse, error)
+	ApplicationRevisions(ctx context.Context, in *ApplicationRevisionsRequest, opts ...grpc.CallOption) (*ApplicationRevisionsResponse, error)
+	FileAppeal(ctx context.Context, in *FileAppealRequest, opts ...grpc.CallOption) (*Appeal, error)
+	ApplicationAppeal(ctx context.Context, in *ApplicationAppealRequest, opts ...grpc.CallOption) (*Appeal, error)
+	OverturnAppeal(ctx context.Context, in *DecideAppealRequest, opts ...grpc.CallOption) (*DecideAppealResponse, error)
+	UpholdAppeal(ctx context.Context, in *DecideAppealRequest, opts ...grpc.CallOption) (*DecideAppealResponse, error)
+	Appeals(ctx context.Context, in *AppealsRequest, opts ...grpc.CallOption) (*AppealsResponse, error)
+	ApproveApplication(ctx context.Context, in *ApproveApplicationRequest, opts ...grpc.CallOption) (*ApproveApplicationResponse, error)
+	RejectApplication(ctx context.Context, in *RejectApplicationRequest, opts ...grpc.CallOption) (*RejectApplicationResponse, error)
+	Applications(ctx context.Context, in *ApplicationsRequest, opts ...grpc.CallOption) (*ApplicationsResponse, error)
+	Application(ctx context.Context, in *ApplicationRequest, opts ...grpc.CallOption) (*Application, error)
+	ClaimApplication(ctx context.Context, in *ClaimApplicationRequest, opts ...grpc.CallOption) (*Application, error)
+	UnclaimApplication(ctx context.Context, in *UnclaimApplicationRequest, opts ...grpc.CallOption) (*UnclaimApplicationResponse, error)
+	ReviewQueue(ctx context.Context, in *ReviewQueueRequest, opts ...grpc.CallOption) (*ApplicationsResponse, error)
+	GetLastApplication(ctx context.Context, in *GetLastApplicationRequest, opts ...grpc.CallOption) (*Application, error)
+	ApplicationHistory(ctx context.Context, in *ApplicationHistoryRequest, opts ...grpc.CallOption) (*ApplicationHistoryResponse, error)
+	RequestInformation(ctx context.Context, in *RequestInformationRequest, opts ...grpc.CallOption) (*ApplicationComment, error)
+	AnswerApplication(ctx context.Context, in *AnswerApplicationRequest, opts ...grpc.CallOption) (*ApplicationComment, error)
+	AddInternalNote(ctx context.Context, in *AddInternalNoteRequest, opts ...grpc.CallOption) (*ApplicationComment, error)
+	ApplicationComments(ctx context.Context, in *ApplicationCommentsRequest, opts ...grpc.CallOption) (*ApplicationCommentsResponse, error)
+	Me(ctx context.Context, in *MeRequest, opts ...grpc.CallOption) (*Member, error)
+	GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*Member, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*Member, error)
+	RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, opts ...grpc.CallOption) (*RequestEmailChangeResponse, error)
+	ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*Member, error)
+	ChangeUsername(ctx context.Context, in *ChangeUsernameRequest, opts ...grpc.CallOption) (*Member, error)
+	UsernameHistory(ctx context.Context, in *UsernameHistoryRequest, opts ...grpc.CallOption) (*UsernameHistoryResponse, error)
+	ResolveUsername(ctx context.Context, in *ResolveUsernameRequest, opts ...grpc.CallOption) (*Member, error)
+	DeleteMember(ctx context.Context, in *DeleteMemberRequest, opts ...grpc.CallOption) (*MemberDeletion, error)
+	CancelMemberDeletion(ctx context.Context, in *CancelMemberDeletionRequest, opts ...grpc.CallOption) (*CancelMemberDeletionResponse, error)
+	ExportMemberData(ctx context.Context, in *ExportMemberDataRequest, opts ...grpc.CallOption) (*MemberDataExport, error)
+	SuspendMember(ctx context.Context, in *SuspendMemberRequest, opts ...grpc.CallOption) (*Sanction, error)
+	BanMember(ctx context.Context, in *BanMemberRequest, opts ...grpc.CallOption) (*Sanction, error)
+	Unsuspend(ctx context.Context, in *UnsuspendRequest, opts ...grpc.CallOption) (*UnsuspendResponse, error)
+	LiftBan(ctx context.Context, in *LiftBanRequest, opts ...grpc.CallOption) (*LiftBanResponse, error)
+	Sanctions(ctx context.Context, in *SanctionsRequest, opts ...grpc.CallOption) (*SanctionsResponse, error)
+	Members(ctx context.Context, in *MembersRequest, opts ...grpc.CallOption) (*MembersResponse, error)
+	Directory(ctx context.Context, in *MembersRequest, opts ...grpc.CallOption) (*DirectoryResponse, error)
+	CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*Invitation, error)
+	RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*RevokeInvitationResponse, error)
+	Invitations(ctx context.Context, in *InvitationsRequest, opts ...grpc.CallOption) (*InvitationsResponse, error)
+	Vouch(ctx context.Context, in *VouchRequest, opts ...grpc.CallOption) (*Vouch, error)
+	RevokeVouch(ctx context.Context, in *RevokeVouchRequest, opts ...grpc.CallOption) (*RevokeVouchResponse, error)
+	Vouches(ctx context.Context, in *VouchesRequest, opts ...grpc.CallOption) (*VouchesResponse, error)
+	CastVote(ctx context.Context, in *CastVoteRequest, opts ...grpc.CallOption) (*ReviewVote, error)
+	Votes(ctx context.Context, in *VotesRequest, opts ...grpc.CallOption) (*VotesResponse, error)
+	Promote(ctx context.Context, in *PromoteRequest, opts ...grpc.CallOption) (*PromoteResponse, error)
+	Demote(ctx context.Context, in *DemoteRequest, opts ...grpc.CallOption) (*DemoteResponse, error)
+	GrantRole(ctx context.Context, in *GrantRoleRequest, opts ...grpc.CallOption) (*GrantRoleResponse, error)
+	RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*RevokeRoleResponse, error)
+	RoleChanges(ctx context.Context, in *RoleChangesRequest, opts ...grpc.CallOption) (*RoleChangesResponse, error)
+	RevokeVerification(ctx context.Context, in *RevokeVerificationRequest, opts ...grpc.CallOption) (*VerificationRevocation, error)
+	VerificationRevocations(ctx context.Context, in *VerificationRevocationsRequest, opts ...grpc.CallOption) (*VerificationRevocationsResponse, error)
+	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*Stats, error)
+	AuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error)
+	VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error)
+	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Community_EventsClient, error)
+}
+
+type communityClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCommunityClient(cc *grpc.ClientConn) CommunityClient {
+	return &communityClient{cc}
+}
+
+func (c *communityClient) SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := c.cc.Invoke(ctx, "/community.Community/SignUp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) SignUpWithInvitation(ctx context.Context, in *SignUpWithInvitationRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := c.cc.Invoke(ctx, "/community.Community/SignUpWithInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) RequestLogin(ctx context.Context, in *RequestLoginRequest, opts ...grpc.CallOption) (*RequestLoginResponse, error) {
+	out := new(RequestLoginResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/RequestLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*AccessToken, error) {
+	out := new(AccessToken)
+	err := c.cc.Invoke(ctx, "/community.Community/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) ApplyForVerification(ctx context.Context, in *ApplyForVerificationRequest, opts ...grpc.CallOption) (*Application, error) {
+	out := new(Application)
+	err := c.cc.Invoke(ctx, "/community.Community/ApplyForVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) ApplicationForm(ctx context.Context, in *ApplicationFormRequest, opts ...grpc.CallOption) (*ApplicationForm, error) {
+	out := new(ApplicationForm)
+	err := c.cc.Invoke(ctx, "/community.Community/ApplicationForm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) PublishApplicationForm(ctx context.Context, in *PublishApplicationFormRequest, opts ...grpc.CallOption) (*ApplicationForm, error) {
+	out := new(ApplicationForm)
+	err := c.cc.Invoke(ctx, "/community.Community/PublishApplicationForm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) EditApplication(ctx context.Context, in *EditApplicationRequest, opts ...grpc.CallOption) (*Application, error) {
+	out := new(Application)
+	err := c.cc.Invoke(ctx, "/community.Community/EditApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) WithdrawApplication(ctx context.Context, in *WithdrawApplicationRequest, opts ...grpc.CallOption) (*WithdrawApplicationResponse, error) {
+	out := new(WithdrawApplicationResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/WithdrawApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) ApplicationRevisions(ctx context.Context, in *ApplicationRevisionsRequest, opts ...grpc.CallOption) (*ApplicationRevisionsResponse, error) {
+	out := new(ApplicationRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/ApplicationRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) FileAppeal(ctx context.Context, in *FileAppealRequest, opts ...grpc.CallOption) (*Appeal, error) {
+	out := new(Appeal)
+	err := c.cc.Invoke(ctx, "/community.Community/FileAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) ApplicationAppeal(ctx context.Context, in *ApplicationAppealRequest, opts ...grpc.CallOption) (*Appeal, error) {
+	out := new(Appeal)
+	err := c.cc.Invoke(ctx, "/community.Community/ApplicationAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) OverturnAppeal(ctx context.Context, in *DecideAppealRequest, opts ...grpc.CallOption) (*DecideAppealResponse, error) {
+	out := new(DecideAppealResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/OverturnAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) UpholdAppeal(ctx context.Context, in *DecideAppealRequest, opts ...grpc.CallOption) (*DecideAppealResponse, error) {
+	out := new(DecideAppealResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/UpholdAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Appeals(ctx context.Context, in *AppealsRequest, opts ...grpc.CallOption) (*AppealsResponse, error) {
+	out := new(AppealsResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/Appeals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) ApproveApplication(ctx context.Context, in *ApproveApplicationRequest, opts ...grpc.CallOption) (*ApproveApplicationResponse, error) {
+	out := new(ApproveApplicationResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/ApproveApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) RejectApplication(ctx context.Context, in *RejectApplicationRequest, opts ...grpc.CallOption) (*RejectApplicationResponse, error) {
+	out := new(RejectApplicationResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/RejectApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Applications(ctx context.Context, in *ApplicationsRequest, opts ...grpc.CallOption) (*ApplicationsResponse, error) {
+	out := new(ApplicationsResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/Applications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Application(ctx context.Context, in *ApplicationRequest, opts ...grpc.CallOption) (*Application, error) {
+	out := new(Application)
+	err := c.cc.Invoke(ctx, "/community.Community/Application", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) ClaimApplication(ctx context.Context, in *ClaimApplicationRequest, opts ...grpc.CallOption) (*Application, error) {
+	out := new(Application)
+	err := c.cc.Invoke(ctx, "/community.Community/ClaimApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) UnclaimApplication(ctx context.Context, in *UnclaimApplicationRequest, opts ...grpc.CallOption) (*UnclaimApplicationResponse, error) {
+	out := new(UnclaimApplicationResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/UnclaimApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) ReviewQueue(ctx context.Context, in *ReviewQueueRequest, opts ...grpc.CallOption) (*ApplicationsResponse, error) {
+	out := new(ApplicationsResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/ReviewQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) GetLastApplication(ctx context.Context, in *GetLastApplicationRequest, opts ...grpc.CallOption) (*Application, error) {
+	out := new(Application)
+	err := c.cc.Invoke(ctx, "/community.Community/GetLastApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) ApplicationHistory(ctx context.Context, in *ApplicationHistoryRequest, opts ...grpc.CallOption) (*ApplicationHistoryResponse, error) {
+	out := new(ApplicationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/ApplicationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) RequestInformation(ctx context.Context, in *RequestInformationRequest, opts ...grpc.CallOption) (*ApplicationComment, error) {
+	out := new(ApplicationComment)
+	err := c.cc.Invoke(ctx, "/community.Community/RequestInformation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) AnswerApplication(ctx context.Context, in *AnswerApplicationRequest, opts ...grpc.CallOption) (*ApplicationComment, error) {
+	out := new(ApplicationComment)
+	err := c.cc.Invoke(ctx, "/community.Community/AnswerApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) AddInternalNote(ctx context.Context, in *AddInternalNoteRequest, opts ...grpc.CallOption) (*ApplicationComment, error) {
+	out := new(ApplicationComment)
+	err := c.cc.Invoke(ctx, "/community.Community/AddInternalNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) ApplicationComments(ctx context.Context, in *ApplicationCommentsRequest, opts ...grpc.CallOption) (*ApplicationCommentsResponse, error) {
+	out := new(ApplicationCommentsResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/ApplicationComments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Me(ctx context.Context, in *MeRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := c.cc.Invoke(ctx, "/community.Community/Me", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := c.cc.Invoke(ctx, "/community.Community/GetMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := c.cc.Invoke(ctx, "/community.Community/UpdateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, opts ...grpc.CallOption) (*RequestEmailChangeResponse, error) {
+	out := new(RequestEmailChangeResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/RequestEmailChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := c.cc.Invoke(ctx, "/community.Community/ConfirmEmailChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) ChangeUsername(ctx context.Context, in *ChangeUsernameRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := c.cc.Invoke(ctx, "/community.Community/ChangeUsername", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) UsernameHistory(ctx context.Context, in *UsernameHistoryRequest, opts ...grpc.CallOption) (*UsernameHistoryResponse, error) {
+	out := new(UsernameHistoryResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/UsernameHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) ResolveUsername(ctx context.Context, in *ResolveUsernameRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := c.cc.Invoke(ctx, "/community.Community/ResolveUsername", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) DeleteMember(ctx context.Context, in *DeleteMemberRequest, opts ...grpc.CallOption) (*MemberDeletion, error) {
+	out := new(MemberDeletion)
+	err := c.cc.Invoke(ctx, "/community.Community/DeleteMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) CancelMemberDeletion(ctx context.Context, in *CancelMemberDeletionRequest, opts ...grpc.CallOption) (*CancelMemberDeletionResponse, error) {
+	out := new(CancelMemberDeletionResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/CancelMemberDeletion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) ExportMemberData(ctx context.Context, in *ExportMemberDataRequest, opts ...grpc.CallOption) (*MemberDataExport, error) {
+	out := new(MemberDataExport)
+	err := c.cc.Invoke(ctx, "/community.Community/ExportMemberData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) SuspendMember(ctx context.Context, in *SuspendMemberRequest, opts ...grpc.CallOption) (*Sanction, error) {
+	out := new(Sanction)
+	err := c.cc.Invoke(ctx, "/community.Community/SuspendMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) BanMember(ctx context.Context, in *BanMemberRequest, opts ...grpc.CallOption) (*Sanction, error) {
+	out := new(Sanction)
+	err := c.cc.Invoke(ctx, "/community.Community/BanMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Unsuspend(ctx context.Context, in *UnsuspendRequest, opts ...grpc.CallOption) (*UnsuspendResponse, error) {
+	out := new(UnsuspendResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/Unsuspend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) LiftBan(ctx context.Context, in *LiftBanRequest, opts ...grpc.CallOption) (*LiftBanResponse, error) {
+	out := new(LiftBanResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/LiftBan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Sanctions(ctx context.Context, in *SanctionsRequest, opts ...grpc.CallOption) (*SanctionsResponse, error) {
+	out := new(SanctionsResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/Sanctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Members(ctx context.Context, in *MembersRequest, opts ...grpc.CallOption) (*MembersResponse, error) {
+	out := new(MembersResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/Members", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Directory(ctx context.Context, in *MembersRequest, opts ...grpc.CallOption) (*DirectoryResponse, error) {
+	out := new(DirectoryResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/Directory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*Invitation, error) {
+	out := new(Invitation)
+	err := c.cc.Invoke(ctx, "/community.Community/CreateInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*RevokeInvitationResponse, error) {
+	out := new(RevokeInvitationResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/RevokeInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Invitations(ctx context.Context, in *InvitationsRequest, opts ...grpc.CallOption) (*InvitationsResponse, error) {
+	out := new(InvitationsResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/Invitations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Vouch(ctx context.Context, in *VouchRequest, opts ...grpc.CallOption) (*Vouch, error) {
+	out := new(Vouch)
+	err := c.cc.Invoke(ctx, "/community.Community/Vouch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) RevokeVouch(ctx context.Context, in *RevokeVouchRequest, opts ...grpc.CallOption) (*RevokeVouchResponse, error) {
+	out := new(RevokeVouchResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/RevokeVouch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Vouches(ctx context.Context, in *VouchesRequest, opts ...grpc.CallOption) (*VouchesResponse, error) {
+	out := new(VouchesResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/Vouches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) CastVote(ctx context.Context, in *CastVoteRequest, opts ...grpc.CallOption) (*ReviewVote, error) {
+	out := new(ReviewVote)
+	err := c.cc.Invoke(ctx, "/community.Community/CastVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Votes(ctx context.Context, in *VotesRequest, opts ...grpc.CallOption) (*VotesResponse, error) {
+	out := new(VotesResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/Votes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Promote(ctx context.Context, in *PromoteRequest, opts ...grpc.CallOption) (*PromoteResponse, error) {
+	out := new(PromoteResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/Promote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Demote(ctx context.Context, in *DemoteRequest, opts ...grpc.CallOption) (*DemoteResponse, error) {
+	out := new(DemoteResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/Demote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) GrantRole(ctx context.Context, in *GrantRoleRequest, opts ...grpc.CallOption) (*GrantRoleResponse, error) {
+	out := new(GrantRoleResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/GrantRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*RevokeRoleResponse, error) {
+	out := new(RevokeRoleResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/RevokeRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) RoleChanges(ctx context.Context, in *RoleChangesRequest, opts ...grpc.CallOption) (*RoleChangesResponse, error) {
+	out := new(RoleChangesResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/RoleChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) RevokeVerification(ctx context.Context, in *RevokeVerificationRequest, opts ...grpc.CallOption) (*VerificationRevocation, error) {
+	out := new(VerificationRevocation)
+	err := c.cc.Invoke(ctx, "/community.Community/RevokeVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) VerificationRevocations(ctx context.Context, in *VerificationRevocationsRequest, opts ...grpc.CallOption) (*VerificationRevocationsResponse, error) {
+	out := new(VerificationRevocationsResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/VerificationRevocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*Stats, error) {
+	out := new(Stats)
+	err := c.cc.Invoke(ctx, "/community.Community/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) AuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error) {
+	out := new(AuditLogResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/AuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error) {
+	out := new(VerifyAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/community.Community/VerifyAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *communityClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Community_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Community_serviceDesc.Streams[0], "/community.Community/Events", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &communityEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Community_EventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type communityEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *communityEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CommunityServer is the server API for Community service.
+type CommunityServer interface {
+	SignUp(context.Context, *SignUpRequest) (*Member, error)
+	SignUpWithInvitation(context.Context, *SignUpWithInvitationRequest) (*Member, error)
+	RequestLogin(context.Context, *RequestLoginRequest) (*RequestLoginResponse, error)
+	Login(context.Context, *LoginRequest) (*AccessToken, error)
+	ApplyForVerification(context.Context, *ApplyForVerificationRequest) (*Application, error)
+	ApplicationForm(context.Context, *ApplicationFormRequest) (*ApplicationForm, error)
+	PublishApplicationForm(context.Context, *PublishApplicationFormRequest) (*ApplicationForm, error)
+	EditApplication(context.Context, *EditApplicationRequest) (*Application, error)
+	WithdrawApplication(context.Context, *WithdrawApplicationRequest) (*WithdrawApplicationResponse, error)
+	ApplicationRevisions(context.Context, *ApplicationRevisionsRequest) (*ApplicationRevisionsResponse, error)
+	FileAppeal(context.Context, *FileAppealRequest) (*Appeal, error)
+	ApplicationAppeal(context.Context, *ApplicationAppealRequest) (*Appeal, error)
+	OverturnAppeal(context.Context, *DecideAppealRequest) (*DecideAppealResponse, error)
+	UpholdAppeal(context.Context, *DecideAppealRequest) (*DecideAppealResponse, error)
+	Appeals(context.Context, *AppealsRequest) (*AppealsResponse, error)
+	ApproveApplication(context.Context, *ApproveApplicationRequest) (*ApproveApplicationResponse, error)
+	RejectApplication(context.Context, *RejectApplicationRequest) (*RejectApplicationResponse, error)
+	Applications(context.Context, *ApplicationsRequest) (*ApplicationsResponse, error)
+	Application(context.Context, *ApplicationRequest) (*Application, error)
+	ClaimApplication(context.Context, *ClaimApplicationRequest) (*Application, error)
+	UnclaimApplication(context.Context, *UnclaimApplicationRequest) (*UnclaimApplicationResponse, error)
+	ReviewQueue(context.Context, *ReviewQueueRequest) (*ApplicationsResponse, error)
+	GetLastApplication(context.Context, *GetLastApplicationRequest) (*Application, error)
+	ApplicationHistory(context.Context, *ApplicationHistoryRequest) (*ApplicationHistoryResponse, error)
+	RequestInformation(context.Context, *RequestInformationRequest) (*ApplicationComment, error)
+	AnswerApplication(context.Context, *AnswerApplicationRequest) (*ApplicationComment, error)
+	AddInternalNote(context.Context, *AddInternalNoteRequest) (*ApplicationComment, error)
+	ApplicationComments(context.Context, *ApplicationCommentsRequest) (*ApplicationCommentsResponse, error)
+	Me(context.Context, *MeRequest) (*Member, error)
+	GetMember(context.Context, *GetMemberRequest) (*Member, error)
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*Member, error)
+	RequestEmailChange(context.Context, *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error)
+	ConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*Member, error)
+	ChangeUsername(context.Context, *ChangeUsernameRequest) (*Member, error)
+	UsernameHistory(context.Context, *UsernameHistoryRequest) (*UsernameHistoryResponse, error)
+	ResolveUsername(context.Context, *ResolveUsernameRequest) (*Member, error)
+	DeleteMember(context.Context, *DeleteMemberRequest) (*MemberDeletion, error)
+	CancelMemberDeletion(context.Context, *CancelMemberDeletionRequest) (*CancelMemberDeletionResponse, error)
+	ExportMemberData(context.Context, *ExportMemberDataRequest) (*MemberDataExport, error)
+	SuspendMember(context.Context, *SuspendMemberRequest) (*Sanction, error)
+	BanMember(context.Context, *BanMemberRequest) (*Sanction, error)
+	Unsuspend(context.Context, *UnsuspendRequest) (*UnsuspendResponse, error)
+	LiftBan(context.Context, *LiftBanRequest) (*LiftBanResponse, error)
+	Sanctions(context.Context, *SanctionsRequest) (*SanctionsResponse, error)
+	Members(context.Context, *MembersRequest) (*MembersResponse, error)
+	Directory(context.Context, *MembersRequest) (*DirectoryResponse, error)
+	CreateInvitation(context.Context, *CreateInvitationRequest) (*Invitation, error)
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*RevokeInvitationResponse, error)
+	Invitations(context.Context, *InvitationsRequest) (*InvitationsResponse, error)
+	Vouch(context.Context, *VouchRequest) (*Vouch, error)
+	RevokeVouch(context.Context, *RevokeVouchRequest) (*RevokeVouchResponse, error)
+	Vouches(context.Context, *VouchesRequest) (*VouchesResponse, error)
+	CastVote(context.Context, *CastVoteRequest) (*ReviewVote, error)
+	Votes(context.Context, *VotesRequest) (*VotesResponse, error)
+	Promote(context.Context, *PromoteRequest) (*PromoteResponse, error)
+	Demote(context.Context, *DemoteRequest) (*DemoteResponse, error)
+	GrantRole(context.Context, *GrantRoleRequest) (*GrantRoleResponse, error)
+	RevokeRole(context.Context, *RevokeRoleRequest) (*RevokeRoleResponse, error)
+	RoleChanges(context.Context, *RoleChangesRequest) (*RoleChangesResponse, error)
+	RevokeVerification(context.Context, *RevokeVerificationRequest) (*VerificationRevocation, error)
+	VerificationRevocations(context.Context, *VerificationRevocationsRequest) (*VerificationRevocationsResponse, error)
+	Stats(context.Context, *StatsRequest) (*Stats, error)
+	AuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error)
+	VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error)
+	Events(*EventsRequest, Community_EventsServer) error
+}
+
+func RegisterCommunityServer(s *grpc.Server, srv CommunityServer) {
+	s.RegisterService(&_Community_serviceDesc, srv)
+}
+
+func _Community_SignUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).SignUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/SignUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).SignUp(ctx, req.(*SignUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_SignUpWithInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUpWithInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).SignUpWithInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/SignUpWithInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).SignUpWithInvitation(ctx, req.(*SignUpWithInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_RequestLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).RequestLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/RequestLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).RequestLogin(ctx, req.(*RequestLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_ApplyForVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyForVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).ApplyForVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/ApplyForVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).ApplyForVerification(ctx, req.(*ApplyForVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_ApplicationForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationFormRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).ApplicationForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/ApplicationForm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).ApplicationForm(ctx, req.(*ApplicationFormRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_PublishApplicationForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishApplicationFormRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).PublishApplicationForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/PublishApplicationForm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).PublishApplicationForm(ctx, req.(*PublishApplicationFormRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_EditApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).EditApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/EditApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).EditApplication(ctx, req.(*EditApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_WithdrawApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).WithdrawApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/WithdrawApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).WithdrawApplication(ctx, req.(*WithdrawApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_ApplicationRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).ApplicationRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/ApplicationRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).ApplicationRevisions(ctx, req.(*ApplicationRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_FileAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).FileAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/FileAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).FileAppeal(ctx, req.(*FileAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_ApplicationAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).ApplicationAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/ApplicationAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).ApplicationAppeal(ctx, req.(*ApplicationAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_OverturnAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).OverturnAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/OverturnAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).OverturnAppeal(ctx, req.(*DecideAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_UpholdAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).UpholdAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/UpholdAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).UpholdAppeal(ctx, req.(*DecideAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Appeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppealsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).Appeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/Appeals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).Appeals(ctx, req.(*AppealsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_ApproveApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).ApproveApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/ApproveApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).ApproveApplication(ctx, req.(*ApproveApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_RejectApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).RejectApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/RejectApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).RejectApplication(ctx, req.(*RejectApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Applications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).Applications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/Applications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).Applications(ctx, req.(*ApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Application_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).Application(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/Application",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).Application(ctx, req.(*ApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_ClaimApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).ClaimApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/ClaimApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).ClaimApplication(ctx, req.(*ClaimApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_UnclaimApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnclaimApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).UnclaimApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/UnclaimApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).UnclaimApplication(ctx, req.(*UnclaimApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_ReviewQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).ReviewQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/ReviewQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).ReviewQueue(ctx, req.(*ReviewQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_GetLastApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLastApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).GetLastApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/GetLastApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).GetLastApplication(ctx, req.(*GetLastApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_ApplicationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).ApplicationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/ApplicationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).ApplicationHistory(ctx, req.(*ApplicationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_RequestInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestInformationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).RequestInformation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/RequestInformation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).RequestInformation(ctx, req.(*RequestInformationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_AnswerApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).AnswerApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/AnswerApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).AnswerApplication(ctx, req.(*AnswerApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_AddInternalNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddInternalNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).AddInternalNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/AddInternalNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).AddInternalNote(ctx, req.(*AddInternalNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_ApplicationComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).ApplicationComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/ApplicationComments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).ApplicationComments(ctx, req.(*ApplicationCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Me_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).Me(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/Me",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).Me(ctx, req.(*MeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_GetMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).GetMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/GetMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).GetMember(ctx, req.(*GetMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/UpdateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_RequestEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).RequestEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/RequestEmailChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).RequestEmailChange(ctx, req.(*RequestEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_ConfirmEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).ConfirmEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/ConfirmEmailChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).ConfirmEmailChange(ctx, req.(*ConfirmEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_ChangeUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUsernameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).ChangeUsername(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/ChangeUsername",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).ChangeUsername(ctx, req.(*ChangeUsernameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_UsernameHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsernameHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).UsernameHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/UsernameHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).UsernameHistory(ctx, req.(*UsernameHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_ResolveUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveUsernameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).ResolveUsername(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/ResolveUsername",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).ResolveUsername(ctx, req.(*ResolveUsernameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_DeleteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).DeleteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/DeleteMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).DeleteMember(ctx, req.(*DeleteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_CancelMemberDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMemberDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).CancelMemberDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/CancelMemberDeletion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).CancelMemberDeletion(ctx, req.(*CancelMemberDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_ExportMemberData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMemberDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).ExportMemberData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/ExportMemberData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).ExportMemberData(ctx, req.(*ExportMemberDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_SuspendMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).SuspendMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/SuspendMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).SuspendMember(ctx, req.(*SuspendMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_BanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).BanMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/BanMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).BanMember(ctx, req.(*BanMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Unsuspend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsuspendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).Unsuspend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/Unsuspend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).Unsuspend(ctx, req.(*UnsuspendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_LiftBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiftBanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).LiftBan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/LiftBan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).LiftBan(ctx, req.(*LiftBanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Sanctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SanctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).Sanctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/Sanctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).Sanctions(ctx, req.(*SanctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Members_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).Members(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/Members",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).Members(ctx, req.(*MembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Directory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).Directory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/Directory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).Directory(ctx, req.(*MembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_CreateInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).CreateInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/CreateInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).CreateInvitation(ctx, req.(*CreateInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).RevokeInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/RevokeInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Invitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).Invitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/Invitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).Invitations(ctx, req.(*InvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Vouch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VouchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).Vouch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/Vouch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).Vouch(ctx, req.(*VouchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_RevokeVouch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeVouchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).RevokeVouch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/RevokeVouch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).RevokeVouch(ctx, req.(*RevokeVouchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Vouches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VouchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).Vouches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/Vouches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).Vouches(ctx, req.(*VouchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_CastVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CastVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).CastVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/CastVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).CastVote(ctx, req.(*CastVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Votes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).Votes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/Votes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).Votes(ctx, req.(*VotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Promote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).Promote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/Promote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).Promote(ctx, req.(*PromoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Demote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DemoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).Demote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/Demote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).Demote(ctx, req.(*DemoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/GrantRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).GrantRole(ctx, req.(*GrantRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/RevokeRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).RevokeRole(ctx, req.(*RevokeRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_RoleChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).RoleChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/RoleChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).RoleChanges(ctx, req.(*RoleChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_RevokeVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).RevokeVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/RevokeVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).RevokeVerification(ctx, req.(*RevokeVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_VerificationRevocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerificationRevocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).VerificationRevocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/VerificationRevocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).VerificationRevocations(ctx, req.(*VerificationRevocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).Stats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_AuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).AuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/AuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).AuditLog(ctx, req.(*AuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_VerifyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunityServer).VerifyAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/community.Community/VerifyAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunityServer).VerifyAuditLog(ctx, req.(*VerifyAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Community_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CommunityServer).Events(m, &communityEventsServer{stream})
+}
+
+type Community_EventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type communityEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *communityEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Community_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "community.Community",
+	HandlerType: (*CommunityServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignUp",
+			Handler:    _Community_SignUp_Handler,
+		},
+		{
+			MethodName: "SignUpWithInvitation",
+			Handler:    _Community_SignUpWithInvitation_Handler,
+		},
+		{
+			MethodName: "RequestLogin",
+			Handler:    _Community_RequestLogin_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _Community_Login_Handler,
+		},
+		{
+			MethodName: "ApplyForVerification",
+			Handler:    _Community_ApplyForVerification_Handler,
+		},
+		{
+			MethodName: "ApplicationForm",
+			Handler:    _Community_ApplicationForm_Handler,
+		},
+		{
+			MethodName: "PublishApplicationForm",
+			Handler:    _Community_PublishApplicationForm_Handler,
+		},
+		{
+			MethodName: "EditApplication",
+			Handler:    _Community_EditApplication_Handler,
+		},
+		{
+			MethodName: "WithdrawApplication",
+			Handler:    _Community_WithdrawApplication_Handler,
+		},
+		{
+			MethodName: "ApplicationRevisions",
+			Handler:    _Community_ApplicationRevisions_Handler,
+		},
+		{
+			MethodName: "FileAppeal",
+			Handler:    _Community_FileAppeal_Handler,
+		},
+		{
+			MethodName: "ApplicationAppeal",
+			Handler:    _Community_ApplicationAppeal_Handler,
+		},
+		{
+			MethodName: "OverturnAppeal",
+			Handler:    _Community_OverturnAppeal_Handler,
+		},
+		{
+			MethodName: "UpholdAppeal",
+			Handler:    _Community_UpholdAppeal_Handler,
+		},
+		{
+			MethodName: "Appeals",
+			Handler:    _Community_Appeals_Handler,
+		},
+		{
+			MethodName: "ApproveApplication",
+			Handler:    _Community_ApproveApplication_Handler,
+		},
+		{
+			MethodName: "RejectApplication",
+			Handler:    _Community_RejectApplication_Handler,
+		},
+		{
+			MethodName: "Applications",
+			Handler:    _Community_Applications_Handler,
+		},
+		{
+			MethodName: "Application",
+			Handler:    _Community_Application_Handler,
+		},
+		{
+			MethodName: "ClaimApplication",
+			Handler:    _Community_ClaimApplication_Handler,
+		},
+		{
+			MethodName: "UnclaimApplication",
+			Handler:    _Community_UnclaimApplication_Handler,
+		},
+		{
+			MethodName: "ReviewQueue",
+			Handler:    _Community_ReviewQueue_Handler,
+		},
+		{
+			MethodName: "GetLastApplication",
+			Handler:    _Community_GetLastApplication_Handler,
+		},
+		{
+			MethodName: "ApplicationHistory",
+			Handler:    _Community_ApplicationHistory_Handler,
+		},
+		{
+			MethodName: "RequestInformation",
+			Handler:    _Community_RequestInformation_Handler,
+		},
+		{
+			MethodName: "AnswerApplication",
+			Handler:    _Community_AnswerApplication_Handler,
+		},
+		{
+			MethodName: "AddInternalNote",
+			Handler:    _Community_AddInternalNote_Handler,
+		},
+		{
+			MethodName: "ApplicationComments",
+			Handler:    _Community_ApplicationComments_Handler,
+		},
+		{
+			MethodName: "Me",
+			Handler:    _Community_Me_Handler,
+		},
+		{
+			MethodName: "GetMember",
+			Handler:    _Community_GetMember_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _Community_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "RequestEmailChange",
+			Handler:    _Community_RequestEmailChange_Handler,
+		},
+		{
+			MethodName: "ConfirmEmailChange",
+			Handler:    _Community_ConfirmEmailChange_Handler,
+		},
+		{
+			MethodName: "ChangeUsername",
+			Handler:    _Community_ChangeUsername_Handler,
+		},
+		{
+			MethodName: "UsernameHistory",
+			Handler:    _Community_UsernameHistory_Handler,
+		},
+		{
+			MethodName: "ResolveUsername",
+			Handler:    _Community_ResolveUsername_Handler,
+		},
+		{
+			MethodName: "DeleteMember",
+			Handler:    _Community_DeleteMember_Handler,
+		},
+		{
+			MethodName: "CancelMemberDeletion",
+			Handler:    _Community_CancelMemberDeletion_Handler,
+		},
+		{
+			MethodName: "ExportMemberData",
+			Handler:    _Community_ExportMemberData_Handler,
+		},
+		{
+			MethodName: "SuspendMember",
+			Handler:    _Community_SuspendMember_Handler,
+		},
+		{
+			MethodName: "BanMember",
+			Handler:    _Community_BanMember_Handler,
+		},
+		{
+			MethodName: "Unsuspend",
+			Handler:    _Community_Unsuspend_Handler,
+		},
+		{
+			MethodName: "LiftBan",
+			Handler:    _Community_LiftBan_Handler,
+		},
+		{
+			MethodName: "Sanctions",
+			Handler:    _Community_Sanctions_Handler,
+		},
+		{
+			MethodName: "Members",
+			Handler:    _Community_Members_Handler,
+		},
+		{
+			MethodName: "Directory",
+			Handler:    _Community_Directory_Handler,
+		},
+		{
+			MethodName: "CreateInvitation",
+			Handler:    _Community_CreateInvitation_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _Community_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "Invitations",
+			Handler:    _Community_Invitations_Handler,
+		},
+		{
+			MethodName: "Vouch",
+			Handler:    _Community_Vouch_Handler,
+		},
+		{
+			MethodName: "RevokeVouch",
+			Handler:    _Community_RevokeVouch_Handler,
+		},
+		{
+			MethodName: "Vouches",
+			Handler:    _Community_Vouches_Handler,
+		},
+		{
+			MethodName: "CastVote",
+			Handler:    _Community_CastVote_Handler,
+		},
+		{
+			MethodName: "Votes",
+			Handler:    _Community_Votes_Handler,
+		},
+		{
+			MethodName: "Promote",
+			Handler:    _Community_Promote_Handler,
+		},
+		{
+			MethodName: "Demote",
+			Handler:    _Community_Demote_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Community_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Community_RevokeRole_Handler,
+		},
+		{
+			MethodName: "RoleChanges",
+			Handler:    _Community_RoleChanges_Handler,
+		},
+		{
+			MethodName: "RevokeVerification",
+			Handler:    _Community_RevokeVerification_Handler,
+		},
+		{
+			MethodName: "VerificationRevocations",
+			Handler:    _Community_VerificationRevocations_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Community_Stats_Handler,
+		},
+		{
+			MethodName: "AuditLog",
+			Handler:    _Community_AuditLog_Handler,
+		},
+		{
+			MethodName: "VerifyAuditLog",
+			Handler:    _Community_VerifyAuditLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Events",
+			Handler:       _Community_Events_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "community.proto",
+}
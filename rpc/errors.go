@@ -0,0 +1,114 @@
+package rpc
+
+import (
+	"strings"
+
+	bl "github.com/214alphadev/community-bl"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errorCodes = map[string]codes.Code{
+	"InsufficientPermissions":       codes.PermissionDenied,
+	"NotAllowedToAccessApplication": codes.PermissionDenied,
+	"RequesterNotFound":             codes.NotFound,
+	"MemberDoesNotExist":            codes.NotFound,
+	"ReviewerDoesNotExist":          codes.NotFound,
+	"ApplicationNotFound":           codes.NotFound,
+	"ApplicationDoesNotExist":       codes.NotFound,
+	"UsernameTaken":                 codes.AlreadyExists,
+	"EmailAddressTaken":             codes.AlreadyExists,
+	"EmailAddressUnchanged":         codes.FailedPrecondition,
+	"UsernameReserved":              codes.AlreadyExists,
+	"UsernameUnchanged":             codes.FailedPrecondition,
+	"PendingApplication":            codes.FailedPrecondition,
+	"AlreadyVerified":               codes.FailedPrecondition,
+	"AlreadyReviewed":               codes.FailedPrecondition,
+	"ApplicationReviewed":           codes.FailedPrecondition,
+	"RoleAlreadyGranted":            codes.AlreadyExists,
+	"RoleNotGranted":                codes.FailedPrecondition,
+	"InvitationDoesNotExist":        codes.NotFound,
+	"InvitationAlreadyRevoked":      codes.FailedPrecondition,
+	"CannotVouchForYourself":        codes.FailedPrecondition,
+	"AlreadyVouched":                codes.AlreadyExists,
+	"VouchDoesNotExist":             codes.NotFound,
+	"VoucherDoesNotExist":           codes.NotFound,
+	"ApplicationFormRequired":       codes.FailedPrecondition,
+	"ApplicationFormDoesNotExist":   codes.NotFound,
+	"AppealDoesNotExist":            codes.NotFound,
+	"AppealDecided":                 codes.FailedPrecondition,
+	"ApplicationNotRejected":        codes.FailedPrecondition,
+	"ApplicationSuperseded":         codes.FailedPrecondition,
+	"ApplicationAlreadyAppealed":    codes.AlreadyExists,
+	"PendingAppeal":                 codes.FailedPrecondition,
+	"CannotDecideOwnRejection":      codes.PermissionDenied,
+	"MemberNotVerified":             codes.FailedPrecondition,
+	"ApplicationClaimed":            codes.FailedPrecondition,
+	"ApplicationNotClaimed":         codes.FailedPrecondition,
+	"InvalidCursor":                 codes.InvalidArgument,
+	"InvalidStatsInterval":          codes.InvalidArgument,
+	"InvalidStatsRange":             codes.InvalidArgument,
+	"StatsRangeTooLarge":            codes.InvalidArgument,
+	"MemberAlreadyDeleted":          codes.FailedPrecondition,
+	"NoPendingDeletion":             codes.FailedPrecondition,
+	"MemberAlreadyBanned":           codes.FailedPrecondition,
+	"MemberNotSuspended":            codes.FailedPrecondition,
+	"MemberNotBanned":               codes.FailedPrecondition,
+}
+
+// statusFromError maps errors returned by the community to grpc status errors
+func statusFromError(err error) error {
+
+	switch err {
+	case bl.LoginErrorConfirmationCodeNotFound,
+		bl.LoginErrorConfirmationCodeExpired,
+		bl.LoginErrorConfirmationCodeAlreadyUsed,
+		bl.LoginErrorConfirmationCodeMemberMismatch:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case bl.LoginErrorMemberAccessKeyHasAlreadyBeenUsed,
+		bl.SignUpErrorEmailAddressBanned,
+		bl.EmailChangeErrorEmailAddressBanned,
+		bl.EmailChangeErrorConfirmationCodeNotFound,
+		bl.EmailChangeErrorConfirmationCodeExpired,
+		bl.EmailChangeErrorConfirmationCodeAlreadyUsed,
+		bl.EmailChangeErrorConfirmationCodeMemberMismatch,
+		bl.SignUpErrorInvitationRequired,
+		bl.SignUpErrorInvitationInvalid,
+		bl.SignUpErrorInvitationEmailAddressMismatch,
+		bl.VouchErrorVouchingDisabled,
+		bl.ApplyForVerificationErrorPermanentlyRejected:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case bl.GetMemberByAccessTokenErrorNoMember, bl.GetMemberByAccessTokenErrorRevoked, bl.MemberErrorDeleted:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case bl.VouchErrorLimitReached:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	switch err.(type) {
+	case bl.RequestLoginCoolDownError, bl.ApplyForVerificationCoolDownError, bl.RequestEmailChangeCoolDownError, bl.ChangeUsernameCoolDownError:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case bl.MemberSuspendedError, bl.MemberBannedError:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case bl.ApplicationFormError, bl.InvalidFormError:
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if code, ok := errorCodes[err.Error()]; ok {
+		return status.Error(code, err.Error())
+	}
+
+	switch err.Error() {
+	case "a reason is required",
+		"suspension must end in the future",
+		"invitation must expire in the future",
+		"decision must not be empty":
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if strings.HasPrefix(err.Error(), "couldn't find member") {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+
+}
@@ -0,0 +1,103 @@
+package rpc
+
+import (
+	"sync"
+	"time"
+
+	bl "github.com/214alphadev/community-bl"
+)
+
+// eventBufferSize is the amount of events buffered per subscriber.
+// Events are dropped for subscribers that don't keep up.
+const eventBufferSize = 64
+
+type eventBroker struct {
+	lock        sync.Mutex
+	subscribers map[chan *Event]struct{}
+}
+
+func newEventBroker(community bl.CommunityInterface) *eventBroker {
+
+	b := &eventBroker{
+		subscribers: map[chan *Event]struct{}{},
+	}
+
+	community.OnSignUp(func(member bl.MemberEntity) {
+		b.publish(&Event{Type: Event_SIGNED_UP, Member: memberToProto(member)})
+	})
+
+	community.OnLogin(func(member bl.MemberEntity) {
+		b.publish(&Event{Type: Event_LOGGED_IN, Member: memberToProto(member)})
+	})
+
+	community.OnApplicationSubmitted(func(application bl.ApplicationEntity) {
+		b.publish(&Event{Type: Event_APPLICATION_SUBMITTED, Application: applicationToProto(application)})
+	})
+
+	community.OnApplicationApproved(func(member bl.MemberEntity) {
+		b.publish(&Event{Type: Event_APPLICATION_APPROVED, Member: memberToProto(member)})
+	})
+
+	community.OnApplicationRejected(func(application bl.ApplicationEntity) {
+		b.publish(&Event{Type: Event_APPLICATION_REJECTED, Application: applicationToProto(application)})
+	})
+
+	community.OnRoleChanged(func(change bl.RoleChangeEntity) {
+		b.publish(&Event{Type: Event_ROLE_CHANGED, RoleChange: roleChangeToProto(change)})
+	})
+
+	community.OnProfileChanged(func(member bl.MemberEntity) {
+		b.publish(&Event{Type: Event_PROFILE_CHANGED, Member: memberToProto(member)})
+	})
+
+	community.OnMemberDeleted(func(member bl.MemberEntity) {
+		b.publish(&Event{Type: Event_MEMBER_DELETED, Member: memberToProto(member)})
+	})
+
+	community.OnMemberErased(func(member bl.MemberEntity) {
+		b.publish(&Event{Type: Event_MEMBER_ERASED, Member: memberToProto(member)})
+	})
+
+	community.OnVerificationRevoked(func(member bl.MemberEntity) {
+		b.publish(&Event{Type: Event_VERIFICATION_REVOKED, Member: memberToProto(member)})
+	})
+
+	community.OnApplicationExpired(func(application bl.ApplicationEntity) {
+		b.publish(&Event{Type: Event_APPLICATION_EXPIRED, Application: applicationToProto(application)})
+	})
+
+	return b
+
+}
+
+func (b *eventBroker) publish(event *Event) {
+
+	event.OccurredAt = time.Now().Unix()
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for subscriber := range b.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+		}
+	}
+
+}
+
+func (b *eventBroker) subscribe() (<-chan *Event, func()) {
+
+	subscriber := make(chan *Event, eventBufferSize)
+
+	b.lock.Lock()
+	b.subscribers[subscriber] = struct{}{}
+	b.lock.Unlock()
+
+	return subscriber, func() {
+		b.lock.Lock()
+		delete(b.subscribers, subscriber)
+		b.lock.Unlock()
+	}
+
+}
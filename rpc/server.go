@@ -0,0 +1,2242 @@
+//go:generate protoc --go_out=plugins=grpc,paths=source_relative:. community.proto
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	bl "github.com/214alphadev/community-bl"
+	vo "github.com/214alphadev/community-bl/value_objects"
+	"github.com/satori/go.uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Server struct {
+	community bl.CommunityInterface
+	events    *eventBroker
+}
+
+func NewServer(community bl.CommunityInterface) *Server {
+	return &Server{
+		community: community,
+		events:    newEventBroker(community),
+	}
+}
+
+// NewGRPCServer creates a grpc server that has the community service and the
+// authentication interceptors registered.
+func NewGRPCServer(community bl.CommunityInterface, opts ...grpc.ServerOption) *grpc.Server {
+
+	opts = append(
+		opts,
+		grpc.UnaryInterceptor(UnaryAuthInterceptor(community)),
+		grpc.StreamInterceptor(StreamAuthInterceptor(community)),
+	)
+
+	server := grpc.NewServer(opts...)
+	RegisterCommunityServer(server, NewServer(community))
+
+	return server
+
+}
+
+func (s *Server) SignUp(ctx context.Context, req *SignUpRequest) (*Member, error) {
+
+	username, err := vo.NewUsername(req.Username)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	emailAddress, err := vo.NewEmailAddress(req.EmailAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	metadata, err := metadataFromProto(req.Metadata)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	member, err := s.community.SignUp(username, emailAddress, metadata)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return memberToProto(member), nil
+
+}
+
+func (s *Server) SignUpWithInvitation(ctx context.Context, req *SignUpWithInvitationRequest) (*Member, error) {
+
+	username, err := vo.NewUsername(req.Username)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	emailAddress, err := vo.NewEmailAddress(req.EmailAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	metadata, err := metadataFromProto(req.Metadata)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	invitationCode, err := vo.NewInvitationCode(req.InvitationCode)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	member, err := s.community.SignUpWithInvitation(username, emailAddress, metadata, invitationCode)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return memberToProto(member), nil
+
+}
+
+func (s *Server) RequestLogin(ctx context.Context, req *RequestLoginRequest) (*RequestLoginResponse, error) {
+
+	emailAddress, err := vo.NewEmailAddress(req.EmailAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.community.RequestLogin(emailAddress); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &RequestLoginResponse{}, nil
+
+}
+
+func (s *Server) Login(ctx context.Context, req *LoginRequest) (*AccessToken, error) {
+
+	emailAddress, err := vo.NewEmailAddress(req.EmailAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	memberAccessPublicKey, err := vo.NewMemberAccessPublicKey(req.MemberAccessPublicKey)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	confirmationCode, err := vo.NewConfirmationCode(req.ConfirmationCode)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := s.community.Login(emailAddress, memberAccessPublicKey, confirmationCode)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &AccessToken{
+		Id:                accessToken.ID.String(),
+		Subject:           accessToken.Subject.String(),
+		IssuedAt:          accessToken.IssuedAt,
+		ExpiresAt:         accessToken.ExpiresAt,
+		SignedAccessToken: accessToken.SignedAccessToken(),
+	}, nil
+
+}
+
+func (s *Server) ApplyForVerification(ctx context.Context, req *ApplyForVerificationRequest) (*Application, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var application bl.ApplicationEntity
+
+	switch len(req.Answers) {
+	case 0:
+		application, err = s.community.ApplyForVerification(req.ApplicationText, member.ID)
+	default:
+		answers, parseErr := answersFromProto(req.Answers)
+		if parseErr != nil {
+			return nil, parseErr
+		}
+		application, err = s.community.SubmitApplication(member.ID, answers)
+	}
+
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return applicationToProto(application), nil
+
+}
+
+func (s *Server) ApplicationForm(ctx context.Context, req *ApplicationFormRequest) (*ApplicationForm, error) {
+
+	if _, err := authenticatedMember(ctx); err != nil {
+		return nil, err
+	}
+
+	form, err := s.community.ApplicationForm(uint(req.Version))
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return applicationFormToProto(form), nil
+
+}
+
+func (s *Server) PublishApplicationForm(ctx context.Context, req *PublishApplicationFormRequest) (*ApplicationForm, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	questions := make([]bl.FormQuestion, 0, len(req.Questions))
+	for _, question := range req.Questions {
+		questions = append(questions, bl.FormQuestion{
+			ID:          question.Id,
+			Label:       question.Label,
+			Type:        bl.FormQuestionType(question.Type),
+			Required:    question.Required,
+			MinLength:   uint(question.MinLength),
+			MaxLength:   uint(question.MaxLength),
+			Choices:     question.Choices,
+			MaxFileSize: uint(question.MaxFileSize),
+		})
+	}
+
+	form, err := s.community.PublishApplicationForm(questions, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return applicationFormToProto(form), nil
+
+}
+
+func (s *Server) EditApplication(ctx context.Context, req *EditApplicationRequest) (*Application, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	answers, err := answersFromProto(req.Answers)
+	if err != nil {
+		return nil, err
+	}
+
+	application, err := s.community.EditApplication(applicationID, req.ApplicationText, answers, member.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return applicationToProto(application), nil
+
+}
+
+func (s *Server) WithdrawApplication(ctx context.Context, req *WithdrawApplicationRequest) (*WithdrawApplicationResponse, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.community.WithdrawApplication(applicationID, member.ID); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &WithdrawApplicationResponse{}, nil
+
+}
+
+func (s *Server) ApplicationRevisions(ctx context.Context, req *ApplicationRevisionsRequest) (*ApplicationRevisionsResponse, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.community.ApplicationRevisions(applicationID, member.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &ApplicationRevisionsResponse{}
+	for _, revision := range revisions {
+		res.Revisions = append(res.Revisions, &ApplicationRevision{
+			Id:              revision.ID.String(),
+			ApplicationText: revision.ApplicationText,
+			FormVersion:     uint32(revision.FormVersion),
+			Answers:         answersToProto(revision.Answers),
+			RevisedAt:       revision.RevisedAt.Unix(),
+		})
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) FileAppeal(ctx context.Context, req *FileAppealRequest) (*Appeal, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	appeal, err := s.community.FileAppeal(applicationID, req.Statement, member.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return appealToProto(appeal), nil
+
+}
+
+func (s *Server) ApplicationAppeal(ctx context.Context, req *ApplicationAppealRequest) (*Appeal, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	appeal, err := s.community.ApplicationAppeal(applicationID, member.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return appealToProto(appeal), nil
+
+}
+
+func (s *Server) OverturnAppeal(ctx context.Context, req *DecideAppealRequest) (*DecideAppealResponse, error) {
+
+	reviewer, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	appealID, err := parseID(req.AppealId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.community.OverturnAppeal(appealID, req.Decision, reviewer.ID); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &DecideAppealResponse{}, nil
+
+}
+
+func (s *Server) UpholdAppeal(ctx context.Context, req *DecideAppealRequest) (*DecideAppealResponse, error) {
+
+	reviewer, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	appealID, err := parseID(req.AppealId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.community.UpholdAppeal(appealID, req.Decision, reviewer.ID); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &DecideAppealResponse{}, nil
+
+}
+
+func (s *Server) Appeals(ctx context.Context, req *AppealsRequest) (*AppealsResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state := bl.AppealStatePending
+	if req.State != "" {
+		state = bl.AppealState(req.State)
+	}
+
+	if !state.Valid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid appeal state: '%s'", req.State)
+	}
+
+	appeals, err := s.community.Appeals(state, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &AppealsResponse{}
+	for _, appeal := range appeals {
+		res.Appeals = append(res.Appeals, appealToProto(appeal))
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) ApproveApplication(ctx context.Context, req *ApproveApplicationRequest) (*ApproveApplicationResponse, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.community.ApproveApplication(applicationID, member.ID); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &ApproveApplicationResponse{}, nil
+
+}
+
+func (s *Server) RejectApplication(ctx context.Context, req *RejectApplicationRequest) (*RejectApplicationResponse, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.OverrideCoolDown {
+	case true:
+		err = s.community.RejectApplicationWithCoolDown(applicationID, req.Reason, time.Duration(req.CoolDownSeconds)*time.Second, member.ID)
+	default:
+		err = s.community.RejectApplication(applicationID, req.Reason, member.ID)
+	}
+
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &RejectApplicationResponse{}, nil
+
+}
+
+func (s *Server) Applications(ctx context.Context, req *ApplicationsRequest) (*ApplicationsResponse, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := bl.ApplicationsQuery{
+		Cursor:         req.Cursor,
+		Next:           uint(req.Next),
+		Unassigned:     req.Unassigned,
+		Search:         req.Search,
+		SortDescending: req.SortDescending,
+		CreatedAfter:   timeFromUnix(req.CreatedAfter),
+		CreatedBefore:  timeFromUnix(req.CreatedBefore),
+		ApprovedAfter:  timeFromUnix(req.ApprovedAfter),
+		ApprovedBefore: timeFromUnix(req.ApprovedBefore),
+		RejectedAfter:  timeFromUnix(req.RejectedAfter),
+		RejectedBefore: timeFromUnix(req.RejectedBefore),
+	}
+
+	states := req.States
+	if req.State != "" {
+		states = append(states, req.State)
+	}
+
+	for _, state := range states {
+		if !bl.ApplicationState(state).Valid() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid application state: '%s'", state)
+		}
+		query.States = append(query.States, bl.ApplicationState(state))
+	}
+
+	if req.MemberId != "" {
+		memberID, err := parseID(req.MemberId)
+		if err != nil {
+			return nil, err
+		}
+		query.Member = &memberID
+	}
+
+	if req.ReviewerId != "" {
+		reviewerID, err := parseID(req.ReviewerId)
+		if err != nil {
+			return nil, err
+		}
+		query.Reviewer = &reviewerID
+	}
+
+	if req.AssignedTo != "" {
+		assignedTo, err := parseID(req.AssignedTo)
+		if err != nil {
+			return nil, err
+		}
+		query.AssignedTo = &assignedTo
+	}
+
+	result, err := s.community.Applications(query, member.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &ApplicationsResponse{
+		Applications: make([]*Application, 0, len(result.Applications)),
+		Total:        uint32(result.Total),
+		NextCursor:   result.NextCursor,
+		HasMore:      result.HasMore,
+	}
+	for _, application := range result.Applications {
+		res.Applications = append(res.Applications, applicationToProto(application))
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) ClaimApplication(ctx context.Context, req *ClaimApplicationRequest) (*Application, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	application, err := s.community.ClaimApplication(applicationID, member.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return applicationToProto(application), nil
+
+}
+
+func (s *Server) UnclaimApplication(ctx context.Context, req *UnclaimApplicationRequest) (*UnclaimApplicationResponse, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.community.UnclaimApplication(applicationID, member.ID); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &UnclaimApplicationResponse{}, nil
+
+}
+
+func (s *Server) ReviewQueue(ctx context.Context, req *ReviewQueueRequest) (*ApplicationsResponse, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applications, err := s.community.ReviewQueue(member.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &ApplicationsResponse{
+		Applications: make([]*Application, 0, len(applications)),
+	}
+	for _, application := range applications {
+		res.Applications = append(res.Applications, applicationToProto(application))
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) Application(ctx context.Context, req *ApplicationRequest) (*Application, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	application, err := s.community.Application(applicationID, member.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	votes, err := s.community.Votes(applicationID, member.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := applicationToProto(application)
+	for _, vote := range votes {
+		res.Votes = append(res.Votes, reviewVoteToProto(vote))
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) GetLastApplication(ctx context.Context, req *GetLastApplicationRequest) (*Application, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	application, err := s.community.GetLastApplication(memberID, member.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return applicationToProto(application), nil
+
+}
+
+func (s *Server) ApplicationHistory(ctx context.Context, req *ApplicationHistoryRequest) (*ApplicationHistoryResponse, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.community.ApplicationHistory(memberID, member.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &ApplicationHistoryResponse{
+		Entries: []*ApplicationHistoryEntry{},
+	}
+
+	for _, entry := range history {
+
+		transitions := []*ApplicationTransition{}
+		for _, transition := range entry.Transitions {
+			transitions = append(transitions, applicationTransitionToProto(transition))
+		}
+
+		res.Entries = append(res.Entries, &ApplicationHistoryEntry{
+			Application: applicationToProto(entry.Application),
+			Transitions: transitions,
+		})
+
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) RequestInformation(ctx context.Context, req *RequestInformationRequest) (*ApplicationComment, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.community.RequestInformation(applicationID, req.Question, member.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return applicationCommentToProto(comment), nil
+
+}
+
+func (s *Server) AnswerApplication(ctx context.Context, req *AnswerApplicationRequest) (*ApplicationComment, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.community.AnswerApplication(applicationID, req.Answer, member.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return applicationCommentToProto(comment), nil
+
+}
+
+func (s *Server) AddInternalNote(ctx context.Context, req *AddInternalNoteRequest) (*ApplicationComment, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.community.AddInternalNote(applicationID, req.Note, member.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return applicationCommentToProto(comment), nil
+
+}
+
+func (s *Server) ApplicationComments(ctx context.Context, req *ApplicationCommentsRequest) (*ApplicationCommentsResponse, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := s.community.ApplicationComments(applicationID, member.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &ApplicationCommentsResponse{}
+	for _, comment := range comments {
+		res.Comments = append(res.Comments, applicationCommentToProto(comment))
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) Me(ctx context.Context, req *MeRequest) (*Member, error) {
+
+	member, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return memberToProto(member), nil
+
+}
+
+func (s *Server) GetMember(ctx context.Context, req *GetMemberRequest) (*Member, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	if memberID != requester.ID && !requester.HasPermission(bl.PermissionMembersRead) {
+		return nil, status.Error(codes.PermissionDenied, "InsufficientPermissions")
+	}
+
+	member, err := s.community.GetMember(memberID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return memberToProto(member), nil
+
+}
+
+func (s *Server) UpdateProfile(ctx context.Context, req *UpdateProfileRequest) (*Member, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := bl.ProfileChanges{
+		RemoveProfileImage: req.RemoveProfileImage,
+	}
+
+	if req.FirstName != "" || req.LastName != "" {
+		properName, err := vo.NewProperName(req.FirstName, req.LastName)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		changes.ProperName = &properName
+	}
+
+	if req.ProfileImage != "" {
+		profileImage, err := vo.NewBase64String(req.ProfileImage)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		changes.ProfileImage = &profileImage
+	}
+
+	member, err := s.community.UpdateProfile(memberID, changes, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return memberToProto(member), nil
+
+}
+
+func (s *Server) RequestEmailChange(ctx context.Context, req *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	emailAddress, err := vo.NewEmailAddress(req.EmailAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.community.RequestEmailChange(requester.ID, emailAddress); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &RequestEmailChangeResponse{}, nil
+
+}
+
+func (s *Server) ConfirmEmailChange(ctx context.Context, req *ConfirmEmailChangeRequest) (*Member, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	emailAddress, err := vo.NewEmailAddress(req.EmailAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	confirmationCode, err := vo.NewConfirmationCode(req.ConfirmationCode)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	member, err := s.community.ConfirmEmailChange(requester.ID, emailAddress, confirmationCode)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return memberToProto(member), nil
+
+}
+
+func (s *Server) ChangeUsername(ctx context.Context, req *ChangeUsernameRequest) (*Member, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	username, err := vo.NewUsername(req.Username)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	member, err := s.community.ChangeUsername(memberID, username, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return memberToProto(member), nil
+
+}
+
+func (s *Server) UsernameHistory(ctx context.Context, req *UsernameHistoryRequest) (*UsernameHistoryResponse, error) {
+
+	if _, err := authenticatedMember(ctx); err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.community.UsernameHistory(memberID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &UsernameHistoryResponse{}
+	for _, change := range changes {
+		res.UsernameChanges = append(res.UsernameChanges, &UsernameChange{
+			Id:          change.ID.String(),
+			MemberId:    change.MemberID.String(),
+			OldUsername: change.OldUsername.String(),
+			NewUsername: change.NewUsername.String(),
+			ChangedAt:   change.ChangedAt.Unix(),
+		})
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) ResolveUsername(ctx context.Context, req *ResolveUsernameRequest) (*Member, error) {
+
+	if _, err := authenticatedMember(ctx); err != nil {
+		return nil, err
+	}
+
+	username, err := vo.NewUsername(req.Username)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	member, err := s.community.ResolveUsername(username)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return memberToProto(member), nil
+
+}
+
+func (s *Server) DeleteMember(ctx context.Context, req *DeleteMemberRequest) (*MemberDeletion, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	deletion, err := s.community.DeleteMember(memberID, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &MemberDeletion{
+		Id:          deletion.ID.String(),
+		MemberId:    deletion.MemberID.String(),
+		RequestedBy: deletion.RequestedBy.String(),
+		RequestedAt: deletion.RequestedAt.Unix(),
+		EraseAt:     deletion.EraseAt.Unix(),
+	}, nil
+
+}
+
+func (s *Server) CancelMemberDeletion(ctx context.Context, req *CancelMemberDeletionRequest) (*CancelMemberDeletionResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.community.CancelMemberDeletion(memberID, requester.ID); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &CancelMemberDeletionResponse{}, nil
+
+}
+
+func (s *Server) ExportMemberData(ctx context.Context, req *ExportMemberDataRequest) (*MemberDataExport, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := s.community.ExportMemberData(memberID, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	if req.Zip {
+		data, err := export.Zip()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &MemberDataExport{ContentType: "application/zip", Data: data}, nil
+	}
+
+	data, err := export.JSON()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &MemberDataExport{ContentType: "application/json", Data: data}, nil
+
+}
+
+func (s *Server) SuspendMember(ctx context.Context, req *SuspendMemberRequest) (*Sanction, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	sanction, err := s.community.SuspendMember(memberID, req.Reason, time.Unix(req.Until, 0), requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return sanctionToProto(sanction), nil
+
+}
+
+func (s *Server) BanMember(ctx context.Context, req *BanMemberRequest) (*Sanction, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	sanction, err := s.community.BanMember(memberID, req.Reason, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return sanctionToProto(sanction), nil
+
+}
+
+func (s *Server) Unsuspend(ctx context.Context, req *UnsuspendRequest) (*UnsuspendResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.community.Unsuspend(memberID, req.Reason, requester.ID); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &UnsuspendResponse{}, nil
+
+}
+
+func (s *Server) LiftBan(ctx context.Context, req *LiftBanRequest) (*LiftBanResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.community.LiftBan(memberID, req.Reason, requester.ID); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &LiftBanResponse{}, nil
+
+}
+
+func (s *Server) Sanctions(ctx context.Context, req *SanctionsRequest) (*SanctionsResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	sanctions, err := s.community.Sanctions(memberID, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &SanctionsResponse{}
+	for _, sanction := range sanctions {
+		res.Sanctions = append(res.Sanctions, sanctionToProto(sanction))
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) Members(ctx context.Context, req *MembersRequest) (*MembersResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := membersQueryFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.community.Members(query, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &MembersResponse{
+		Members: make([]*Member, 0, len(members)),
+	}
+	for _, member := range members {
+		res.Members = append(res.Members, memberToProto(member))
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) Directory(ctx context.Context, req *MembersRequest) (*DirectoryResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := membersQueryFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.community.Directory(query, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &DirectoryResponse{
+		Entries: make([]*DirectoryEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		res.Entries = append(res.Entries, directoryEntryToProto(entry))
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) CreateInvitation(ctx context.Context, req *CreateInvitationRequest) (*Invitation, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	options := bl.InvitationOptions{
+		MaxUses:          uint(req.MaxUses),
+		ExpiresAt:        timeFromUnix(req.ExpiresAt),
+		SkipVerification: req.SkipVerification,
+	}
+
+	if req.EmailAddress != "" {
+		emailAddress, err := vo.NewEmailAddress(req.EmailAddress)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		options.EmailAddress = &emailAddress
+	}
+
+	invitation, err := s.community.CreateInvitation(options, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return invitationToProto(invitation), nil
+
+}
+
+func (s *Server) RevokeInvitation(ctx context.Context, req *RevokeInvitationRequest) (*RevokeInvitationResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitationID, err := parseID(req.InvitationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.community.RevokeInvitation(invitationID, requester.ID); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &RevokeInvitationResponse{}, nil
+
+}
+
+func (s *Server) Invitations(ctx context.Context, req *InvitationsRequest) (*InvitationsResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := s.community.Invitations(memberID, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &InvitationsResponse{}
+	for _, invitation := range invitations {
+		res.Invitations = append(res.Invitations, invitationToProto(invitation))
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) Vouch(ctx context.Context, req *VouchRequest) (*Vouch, error) {
+
+	voucher, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	vouch, err := s.community.Vouch(applicationID, voucher.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return vouchToProto(vouch), nil
+
+}
+
+func (s *Server) RevokeVouch(ctx context.Context, req *RevokeVouchRequest) (*RevokeVouchResponse, error) {
+
+	voucher, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.community.RevokeVouch(applicationID, voucher.ID); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &RevokeVouchResponse{}, nil
+
+}
+
+func (s *Server) Vouches(ctx context.Context, req *VouchesRequest) (*VouchesResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	vouches, err := s.community.Vouches(applicationID, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &VouchesResponse{}
+	for _, vouch := range vouches {
+		res.Vouches = append(res.Vouches, vouchToProto(vouch))
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) CastVote(ctx context.Context, req *CastVoteRequest) (*ReviewVote, error) {
+
+	reviewer, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	decision := bl.ReviewDecision(req.Decision)
+	if !decision.Valid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid review decision: '%s'", req.Decision)
+	}
+
+	vote, err := s.community.CastVote(applicationID, decision, req.Comment, reviewer.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return reviewVoteToProto(vote), nil
+
+}
+
+func (s *Server) Votes(ctx context.Context, req *VotesRequest) (*VotesResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := parseID(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	votes, err := s.community.Votes(applicationID, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &VotesResponse{}
+	for _, vote := range votes {
+		res.Votes = append(res.Votes, reviewVoteToProto(vote))
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) Promote(ctx context.Context, req *PromoteRequest) (*PromoteResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	emailAddress, err := vo.NewEmailAddress(req.EmailAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.community.Promote(emailAddress, requester.ID); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &PromoteResponse{}, nil
+
+}
+
+func (s *Server) Demote(ctx context.Context, req *DemoteRequest) (*DemoteResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	emailAddress, err := vo.NewEmailAddress(req.EmailAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.community.Demote(emailAddress, requester.ID); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &DemoteResponse{}, nil
+
+}
+
+func (s *Server) GrantRole(ctx context.Context, req *GrantRoleRequest) (*GrantRoleResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.community.GrantRole(memberID, bl.Role(req.Role), requester.ID); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &GrantRoleResponse{}, nil
+
+}
+
+func (s *Server) RevokeVerification(ctx context.Context, req *RevokeVerificationRequest) (*VerificationRevocation, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	revocation, err := s.community.RevokeVerification(memberID, req.Reason, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return verificationRevocationToProto(revocation), nil
+
+}
+
+func (s *Server) VerificationRevocations(ctx context.Context, req *VerificationRevocationsRequest) (*VerificationRevocationsResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	revocations, err := s.community.VerificationRevocations(memberID, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &VerificationRevocationsResponse{}
+	for _, revocation := range revocations {
+		res.Revocations = append(res.Revocations, verificationRevocationToProto(revocation))
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) Stats(ctx context.Context, req *StatsRequest) (*Stats, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.community.Stats(bl.StatsQuery{
+		Interval: bl.StatsInterval(req.Interval),
+		From:     timeFromUnix(req.From),
+		Until:    timeFromUnix(req.Until),
+	}, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &Stats{
+		Interval: string(stats.Interval),
+		From:     stats.From.Unix(),
+		Until:    stats.Until.Unix(),
+		Funnel: &SignUpFunnel{
+			SignedUp:             uint32(stats.Funnel.SignedUp),
+			VerifiedEmailAddress: uint32(stats.Funnel.VerifiedEmailAddress),
+			Applied:              uint32(stats.Funnel.Applied),
+			Verified:             uint32(stats.Funnel.Verified),
+		},
+		EmailVerificationRate: stats.EmailVerificationRate,
+	}
+
+	for _, period := range stats.Periods {
+		res.Periods = append(res.Periods, &StatsPeriod{
+			From:          period.From.Unix(),
+			Until:         period.Until.Unix(),
+			SignUps:       uint32(period.SignUps),
+			Logins:        uint32(period.Logins),
+			ActiveMembers: uint32(period.ActiveMembers),
+		})
+	}
+
+	states := make([]string, 0, len(stats.ApplicationsByState))
+	for state := range stats.ApplicationsByState {
+		states = append(states, string(state))
+	}
+	sort.Strings(states)
+
+	for _, state := range states {
+		res.ApplicationsByState = append(res.ApplicationsByState, &ApplicationStateCount{
+			State: state,
+			Count: uint32(stats.ApplicationsByState[bl.ApplicationState(state)]),
+		})
+	}
+
+	if stats.MedianReviewTime != nil {
+		res.MedianReviewTime = int64(stats.MedianReviewTime.Seconds())
+		res.HasMedianReviewTime = true
+	}
+
+	for _, reviewer := range stats.Reviewers {
+		res.Reviewers = append(res.Reviewers, &ReviewerStats{
+			Reviewer:     reviewer.Reviewer.String(),
+			Approved:     uint32(reviewer.Approved),
+			Rejected:     uint32(reviewer.Rejected),
+			ApprovalRate: reviewer.ApprovalRate,
+		})
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) AuditLog(ctx context.Context, req *AuditLogRequest) (*AuditLogResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := bl.AuditLogQuery{
+		Next:    uint(req.Next),
+		Action:  bl.AuditAction(req.Action),
+		Outcome: bl.AuditOutcome(req.Outcome),
+		Since:   timeFromUnix(req.Since),
+		Until:   timeFromUnix(req.Until),
+	}
+
+	if req.Position != 0 {
+		position := req.Position
+		query.Position = &position
+	}
+
+	if req.Actor != "" {
+		actor, err := parseID(req.Actor)
+		if err != nil {
+			return nil, err
+		}
+		query.Actor = &actor
+	}
+
+	if req.Target != "" {
+		target, err := parseID(req.Target)
+		if err != nil {
+			return nil, err
+		}
+		query.Target = &target
+	}
+
+	entries, err := s.community.AuditLog(query, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &AuditLogResponse{
+		Entries: make([]*AuditEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		res.Entries = append(res.Entries, auditEntryToProto(entry))
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) VerifyAuditLog(ctx context.Context, req *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.community.VerifyAuditLog(requester.ID)
+	if tampered, ok := err.(bl.AuditLogTamperedError); ok {
+		return &VerifyAuditLogResponse{Tampered: true, TamperedSequence: tampered.Sequence}, nil
+	}
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &VerifyAuditLogResponse{}, nil
+
+}
+
+func (s *Server) RevokeRole(ctx context.Context, req *RevokeRoleRequest) (*RevokeRoleResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.community.RevokeRole(memberID, bl.Role(req.Role), requester.ID); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &RevokeRoleResponse{}, nil
+
+}
+
+func (s *Server) RoleChanges(ctx context.Context, req *RoleChangesRequest) (*RoleChangesResponse, error) {
+
+	requester, err := authenticatedMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := parseID(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.community.RoleChanges(memberID, requester.ID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &RoleChangesResponse{}
+	for _, change := range changes {
+		res.RoleChanges = append(res.RoleChanges, roleChangeToProto(change))
+	}
+
+	return res, nil
+
+}
+
+func (s *Server) Events(req *EventsRequest, stream Community_EventsServer) error {
+
+	requester, err := authenticatedMember(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	if !requester.HasPermission(bl.PermissionMembersRead) {
+		return status.Error(codes.PermissionDenied, "InsufficientPermissions")
+	}
+
+	events, unsubscribe := s.events.subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+
+}
+
+// timeFromUnix treats zero as unset
+func timeFromUnix(unix int64) *time.Time {
+
+	if unix == 0 {
+		return nil
+	}
+
+	t := time.Unix(unix, 0)
+	return &t
+
+}
+
+func parseID(id string) (uuid.UUID, error) {
+
+	parsed, err := uuid.FromString(id)
+	if err != nil {
+		return uuid.UUID{}, status.Errorf(codes.InvalidArgument, "invalid id: '%s'", id)
+	}
+
+	return parsed, nil
+
+}
+
+// boolFilter parses "true" and "false". Empty filters are ignored.
+func boolFilter(name string, value string) (*bool, error) {
+
+	switch value {
+	case "":
+		return nil, nil
+	case "true", "false":
+		filter := value == "true"
+		return &filter, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s filter: '%s'", name, value)
+	}
+
+}
+
+func membersQueryFromProto(req *MembersRequest) (bl.MembersQuery, error) {
+
+	query := bl.MembersQuery{
+		Next:           uint(req.Next),
+		CreatedAfter:   timeFromUnix(req.CreatedAfter),
+		CreatedBefore:  timeFromUnix(req.CreatedBefore),
+		Search:         req.Search,
+		Sort:           bl.MembersQuerySort(req.Sort),
+		SortDescending: req.SortDescending,
+		IncludeDeleted: req.IncludeDeleted,
+	}
+
+	if req.Position != "" {
+		position, err := parseID(req.Position)
+		if err != nil {
+			return bl.MembersQuery{}, err
+		}
+		query.Position = &position
+	}
+
+	if req.InvitedBy != "" {
+		invitedBy, err := parseID(req.InvitedBy)
+		if err != nil {
+			return bl.MembersQuery{}, err
+		}
+		query.InvitedBy = &invitedBy
+	}
+
+	if query.Sort != "" && !query.Sort.Valid() {
+		return bl.MembersQuery{}, status.Errorf(codes.InvalidArgument, "invalid members query sort: '%s'", req.Sort)
+	}
+
+	if req.Role != "" {
+		role := bl.Role(req.Role)
+		if !role.Valid() {
+			return bl.MembersQuery{}, status.Errorf(codes.InvalidArgument, "invalid role: '%s'", req.Role)
+		}
+		query.Role = &role
+	}
+
+	var err error
+
+	if query.Verified, err = boolFilter("verified", req.Verified); err != nil {
+		return bl.MembersQuery{}, err
+	}
+
+	if query.VerifiedEmailAddress, err = boolFilter("verified email address", req.VerifiedEmailAddress); err != nil {
+		return bl.MembersQuery{}, err
+	}
+
+	if query.Banned, err = boolFilter("banned", req.Banned); err != nil {
+		return bl.MembersQuery{}, err
+	}
+
+	if query.Applied, err = boolFilter("applied", req.Applied); err != nil {
+		return bl.MembersQuery{}, err
+	}
+
+	return query, nil
+
+}
+
+func metadataFromProto(metadata *Metadata) (bl.MetadataEntity, error) {
+
+	if metadata == nil {
+		return bl.MetadataEntity{}, errors.New("metadata is required")
+	}
+
+	properName, err := vo.NewProperName(metadata.FirstName, metadata.LastName)
+	if err != nil {
+		return bl.MetadataEntity{}, err
+	}
+
+	entity := bl.MetadataEntity{
+		ProperName: properName,
+	}
+
+	if metadata.ProfileImage != "" {
+		profileImage, err := vo.NewBase64String(metadata.ProfileImage)
+		if err != nil {
+			return bl.MetadataEntity{}, err
+		}
+		entity.ProfileImage = &profileImage
+	}
+
+	return entity, nil
+
+}
+
+func memberToProto(member bl.MemberEntity) *Member {
+
+	metadata := &Metadata{
+		FirstName: member.Metadata.ProperName.FirstName(),
+		LastName:  member.Metadata.ProperName.LastName(),
+	}
+	if member.Metadata.ProfileImage != nil {
+		metadata.ProfileImage = member.Metadata.ProfileImage.String()
+	}
+
+	roles := make([]string, 0, len(member.Roles))
+	for _, role := range member.Roles {
+		roles = append(roles, string(role))
+	}
+
+	return &Member{
+		Id:                   member.ID.String(),
+		CreatedAt:            member.CreatedAt.Unix(),
+		VerifiedEmailAddress: member.VerifiedEmailAddress,
+		Username:             member.Username.String(),
+		EmailAddress:         member.EmailAddress.String(),
+		Metadata:             metadata,
+		Verified:             member.Verified,
+		Roles:                roles,
+	}
+
+}
+
+func applicationToProto(application bl.ApplicationEntity) *Application {
+
+	res := &Application{
+		Id:                  application.ID.String(),
+		MemberId:            application.MemberID.String(),
+		ApplicationText:     application.ApplicationText,
+		FormVersion:         uint32(application.FormVersion),
+		Answers:             answersToProto(application.Answers),
+		State:               string(application.State),
+		RejectionReason:     application.RejectionReason,
+		PermanentlyRejected: application.PermanentlyRejected,
+		CreatedAt:           application.CreatedAt.Unix(),
+	}
+
+	if application.RejectedAt != nil {
+		res.RejectedAt = application.RejectedAt.Unix()
+	}
+
+	if application.ApprovedAt != nil {
+		res.ApprovedAt = application.ApprovedAt.Unix()
+	}
+
+	if application.WithdrawnAt != nil {
+		res.WithdrawnAt = application.WithdrawnAt.Unix()
+	}
+
+	if application.ReapplyAfter != nil {
+		res.ReapplyAfter = application.ReapplyAfter.Unix()
+	}
+
+	for _, reviewer := range application.RejectedBy {
+		res.RejectedBy = append(res.RejectedBy, reviewer.String())
+	}
+
+	for _, reviewer := range application.ApprovedBy {
+		res.ApprovedBy = append(res.ApprovedBy, reviewer.String())
+	}
+
+	if application.AssignedTo != nil {
+		res.AssignedTo = application.AssignedTo.String()
+	}
+
+	if application.AssignedAt != nil {
+		res.AssignedAt = application.AssignedAt.Unix()
+	}
+
+	if application.ClaimExpiresAt != nil {
+		res.ClaimExpiresAt = application.ClaimExpiresAt.Unix()
+	}
+
+	if application.EscalatedAt != nil {
+		res.EscalatedAt = application.EscalatedAt.Unix()
+	}
+
+	if application.ExpiredAt != nil {
+		res.ExpiredAt = application.ExpiredAt.Unix()
+	}
+
+	return res
+
+}
+
+func applicationFormToProto(form bl.ApplicationFormEntity) *ApplicationForm {
+
+	res := &ApplicationForm{
+		Id:          form.ID.String(),
+		Version:     uint32(form.Version),
+		PublishedAt: form.PublishedAt.Unix(),
+	}
+
+	for _, question := range form.Questions {
+		res.Questions = append(res.Questions, &FormQuestion{
+			Id:          question.ID,
+			Label:       question.Label,
+			Type:        string(question.Type),
+			Required:    question.Required,
+			MinLength:   uint32(question.MinLength),
+			MaxLength:   uint32(question.MaxLength),
+			Choices:     question.Choices,
+			MaxFileSize: uint32(question.MaxFileSize),
+		})
+	}
+
+	return res
+
+}
+
+func answersToProto(answers []bl.ApplicationAnswer) []*ApplicationAnswer {
+
+	res := []*ApplicationAnswer{}
+
+	for _, answer := range answers {
+		a := &ApplicationAnswer{
+			QuestionId: answer.QuestionID,
+			Value:      answer.Value,
+		}
+		if answer.File != nil {
+			a.File = answer.File.String()
+		}
+		res = append(res, a)
+	}
+
+	return res
+
+}
+
+func answersFromProto(answers []*ApplicationAnswer) ([]bl.ApplicationAnswer, error) {
+
+	res := []bl.ApplicationAnswer{}
+
+	for _, answer := range answers {
+		a := bl.ApplicationAnswer{
+			QuestionID: answer.QuestionId,
+			Value:      answer.Value,
+		}
+		if answer.File != "" {
+			file, err := vo.NewBase64String(answer.File)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			a.File = &file
+		}
+		res = append(res, a)
+	}
+
+	return res, nil
+
+}
+
+func appealToProto(appeal bl.AppealEntity) *Appeal {
+
+	res := &Appeal{
+		Id:            appeal.ID.String(),
+		ApplicationId: appeal.ApplicationID.String(),
+		MemberId:      appeal.MemberID.String(),
+		Statement:     appeal.Statement,
+		State:         string(appeal.State),
+		CreatedAt:     appeal.CreatedAt.Unix(),
+		Decision:      appeal.Decision,
+	}
+
+	if appeal.DecidedBy != nil {
+		res.DecidedBy = appeal.DecidedBy.String()
+	}
+
+	if appeal.DecidedAt != nil {
+		res.DecidedAt = appeal.DecidedAt.Unix()
+	}
+
+	return res
+
+}
+
+func reviewVoteToProto(vote bl.ReviewVoteEntity) *ReviewVote {
+	return &ReviewVote{
+		Id:         vote.ID.String(),
+		ReviewerId: vote.ReviewerID.String(),
+		Decision:   string(vote.Decision),
+		Comment:    vote.Comment,
+		CastAt:     vote.CastAt.Unix(),
+	}
+}
+
+func applicationCommentToProto(comment bl.ApplicationCommentEntity) *ApplicationComment {
+	return &ApplicationComment{
+		Id:            comment.ID.String(),
+		ApplicationId: comment.ApplicationID.String(),
+		AuthorId:      comment.AuthorID.String(),
+		Text:          comment.Text,
+		Internal:      comment.Internal,
+		Question:      comment.Question,
+		CreatedAt:     comment.CreatedAt.Unix(),
+	}
+}
+
+func applicationTransitionToProto(transition bl.ApplicationTransitionEntity) *ApplicationTransition {
+
+	res := &ApplicationTransition{
+		Id:             transition.ID.String(),
+		From:           string(transition.From),
+		To:             string(transition.To),
+		Reason:         transition.Reason,
+		TransitionedAt: transition.TransitionedAt.Unix(),
+	}
+
+	if transition.Actor != nil {
+		res.Actor = transition.Actor.String()
+	}
+
+	return res
+
+}
+
+func roleChangeToProto(change bl.RoleChangeEntity) *RoleChange {
+
+	res := &RoleChange{
+		Id:        change.ID.String(),
+		MemberId:  change.MemberID.String(),
+		Role:      string(change.Role),
+		Granted:   change.Granted,
+		ChangedAt: change.ChangedAt.Unix(),
+	}
+
+	if change.ChangedBy != nil {
+		res.ChangedBy = change.ChangedBy.String()
+	}
+
+	return res
+
+}
+
+func sanctionToProto(sanction bl.SanctionEntity) *Sanction {
+
+	res := &Sanction{
+		Id:           sanction.ID.String(),
+		MemberId:     sanction.MemberID.String(),
+		EmailAddress: sanction.EmailAddress.String(),
+		Type:         string(sanction.Type),
+		Reason:       sanction.Reason,
+		IssuedBy:     sanction.IssuedBy.String(),
+		IssuedAt:     sanction.IssuedAt.Unix(),
+		LiftReason:   sanction.LiftReason,
+	}
+
+	if sanction.ExpiresAt != nil {
+		res.ExpiresAt = sanction.ExpiresAt.Unix()
+	}
+
+	if sanction.LiftedAt != nil {
+		res.LiftedAt = sanction.LiftedAt.Unix()
+	}
+
+	if sanction.LiftedBy != nil {
+		res.LiftedBy = sanction.LiftedBy.String()
+	}
+
+	return res
+
+}
+
+func directoryEntryToProto(entry bl.DirectoryEntry) *DirectoryEntry {
+
+	metadata := &Metadata{
+		FirstName: entry.Metadata.ProperName.FirstName(),
+		LastName:  entry.Metadata.ProperName.LastName(),
+	}
+	if entry.Metadata.ProfileImage != nil {
+		metadata.ProfileImage = entry.Metadata.ProfileImage.String()
+	}
+
+	roles := make([]string, 0, len(entry.Roles))
+	for _, role := range entry.Roles {
+		roles = append(roles, string(role))
+	}
+
+	return &DirectoryEntry{
+		Id:          entry.ID.String(),
+		Username:    entry.Username.String(),
+		Metadata:    metadata,
+		Verified:    entry.Verified,
+		Roles:       roles,
+		MemberSince: entry.MemberSince.Unix(),
+	}
+
+}
+
+func invitationToProto(invitation bl.InvitationEntity) *Invitation {
+
+	res := &Invitation{
+		Id:               invitation.ID.String(),
+		Code:             invitation.Code.String(),
+		CreatedBy:        invitation.CreatedBy.String(),
+		CreatedAt:        invitation.CreatedAt.Unix(),
+		MaxUses:          uint32(invitation.MaxUses),
+		Uses:             uint32(invitation.Uses),
+		SkipVerification: invitation.SkipVerification,
+	}
+
+	if invitation.ExpiresAt != nil {
+		res.ExpiresAt = invitation.ExpiresAt.Unix()
+	}
+
+	if invitation.EmailAddress != nil {
+		res.EmailAddress = invitation.EmailAddress.String()
+	}
+
+	if invitation.RevokedAt != nil {
+		res.RevokedAt = invitation.RevokedAt.Unix()
+	}
+
+	return res
+
+}
+
+func vouchToProto(vouch bl.VouchEntity) *Vouch {
+
+	res := &Vouch{
+		Id:            vouch.ID.String(),
+		ApplicationId: vouch.ApplicationID.String(),
+		VoucherId:     vouch.VoucherID.String(),
+		VouchedAt:     vouch.VouchedAt.Unix(),
+	}
+
+	if vouch.RevokedAt != nil {
+		res.RevokedAt = vouch.RevokedAt.Unix()
+	}
+
+	return res
+
+}
+
+func verificationRevocationToProto(revocation bl.VerificationRevocationEntity) *VerificationRevocation {
+	return &VerificationRevocation{
+		Id:        revocation.ID.String(),
+		MemberId:  revocation.MemberID.String(),
+		Reason:    revocation.Reason,
+		RevokedBy: revocation.RevokedBy.String(),
+		RevokedAt: revocation.RevokedAt.Unix(),
+	}
+}
+
+func auditEntryToProto(entry bl.AuditEntryEntity) *AuditEntry {
+
+	res := &AuditEntry{
+		Id:           entry.ID.String(),
+		Sequence:     entry.Sequence,
+		Action:       string(entry.Action),
+		Outcome:      string(entry.Outcome),
+		Detail:       entry.Detail,
+		OccurredAt:   entry.OccurredAt.Unix(),
+		PreviousHash: entry.PreviousHash,
+		Hash:         entry.Hash,
+	}
+
+	if entry.Actor != nil {
+		res.Actor = entry.Actor.String()
+	}
+
+	if entry.Target != nil {
+		res.Target = entry.Target.String()
+	}
+
+	return res
+
+}
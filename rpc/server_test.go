@@ -0,0 +1,1394 @@
+package rpc
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	bl "github.com/214alphadev/community-bl"
+	vo "github.com/214alphadev/community-bl/value_objects"
+	"github.com/satori/go.uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeCommunity implements the parts of the community the tests use.
+// Calling any other method panics.
+type fakeCommunity struct {
+	bl.CommunityInterface
+	// members maps access tokens to the members they belong to
+	members  map[string]bl.MemberEntity
+	onSignUp []func(member bl.MemberEntity)
+	// approved records the applications ApproveApplication has been called for
+	approved []bl.ApplicationID
+	// demoted records the email addresses Demote has been called for
+	demoted []vo.EmailAddress
+	// profileChanges records the changes UpdateProfile has been called with
+	profileChanges []bl.ProfileChanges
+	// emailChanges records the email addresses RequestEmailChange has been called with
+	emailChanges []vo.EmailAddress
+	// usernameChanges records the username changes ChangeUsername has made
+	usernameChanges []bl.UsernameChangeEntity
+	// deletions records the pending deletions of DeleteMember
+	deletions map[bl.MemberIdentifier]bl.MemberDeletionEntity
+	// sanctions records the sanctions SuspendMember and BanMember issued
+	sanctions []bl.SanctionEntity
+	// membersQueries records the queries Members and Directory have been called with
+	membersQueries []bl.MembersQuery
+	// invitations records the invitations CreateInvitation created
+	invitations []bl.InvitationEntity
+	// vouches records the vouches Vouch created
+	vouches []bl.VouchEntity
+	// votes records the votes CastVote created
+	votes []bl.ReviewVoteEntity
+	// appeals are decided by OverturnAppeal and UpholdAppeal
+	appeals []bl.AppealEntity
+	// auditLog is returned by AuditLog and checked by VerifyAuditLog
+	auditLog []bl.AuditEntryEntity
+	// auditLogQueries records the queries AuditLog has been called with
+	auditLogQueries []bl.AuditLogQuery
+}
+
+func (c *fakeCommunity) GetMemberByAccessToken(accessToken string) (bl.MemberEntity, error) {
+
+	member, ok := c.members[accessToken]
+	if !ok {
+		return bl.MemberEntity{}, bl.GetMemberByAccessTokenErrorNoMember
+	}
+
+	return member, nil
+
+}
+
+func (c *fakeCommunity) GetMember(memberID bl.MemberIdentifier) (bl.MemberEntity, error) {
+
+	for _, member := range c.members {
+		if member.ID == memberID {
+			return member, nil
+		}
+	}
+
+	return bl.MemberEntity{}, errors.New("MemberDoesNotExist")
+
+}
+
+func (c *fakeCommunity) ApproveApplication(applicationID bl.ApplicationID, reviewer bl.MemberIdentifier) error {
+	c.approved = append(c.approved, applicationID)
+	return nil
+}
+
+func (c *fakeCommunity) Demote(emailAddress vo.EmailAddress, requester bl.MemberIdentifier) error {
+	c.demoted = append(c.demoted, emailAddress)
+	return nil
+}
+
+func (c *fakeCommunity) UpdateProfile(memberID bl.MemberIdentifier, changes bl.ProfileChanges, requester bl.MemberIdentifier) (bl.MemberEntity, error) {
+
+	c.profileChanges = append(c.profileChanges, changes)
+
+	member, err := c.GetMember(memberID)
+	if err != nil {
+		return bl.MemberEntity{}, err
+	}
+
+	if changes.ProperName != nil {
+		member.Metadata.ProperName = *changes.ProperName
+	}
+
+	return member, nil
+
+}
+
+func (c *fakeCommunity) RequestEmailChange(memberID bl.MemberIdentifier, newEmailAddress vo.EmailAddress) error {
+	c.emailChanges = append(c.emailChanges, newEmailAddress)
+	return nil
+}
+
+func (c *fakeCommunity) ConfirmEmailChange(memberID bl.MemberIdentifier, newEmailAddress vo.EmailAddress, confirmationCode vo.ConfirmationCode) (bl.MemberEntity, error) {
+
+	member, err := c.GetMember(memberID)
+	if err != nil {
+		return bl.MemberEntity{}, err
+	}
+
+	if len(c.emailChanges) == 0 || c.emailChanges[len(c.emailChanges)-1] != newEmailAddress {
+		return bl.MemberEntity{}, bl.EmailChangeErrorConfirmationCodeNotFound
+	}
+
+	member.EmailAddress = newEmailAddress
+
+	return member, nil
+
+}
+
+func (c *fakeCommunity) ChangeUsername(memberID bl.MemberIdentifier, username vo.Username, requester bl.MemberIdentifier) (bl.MemberEntity, error) {
+
+	member, err := c.GetMember(memberID)
+	if err != nil {
+		return bl.MemberEntity{}, err
+	}
+
+	c.usernameChanges = append(c.usernameChanges, bl.UsernameChangeEntity{
+		ID:          uuid.NewV4(),
+		MemberID:    member.ID,
+		OldUsername: member.Username,
+		NewUsername: username,
+		ChangedAt:   time.Now(),
+	})
+	member.Username = username
+
+	return member, nil
+
+}
+
+func (c *fakeCommunity) UsernameHistory(memberID bl.MemberIdentifier) ([]bl.UsernameChangeEntity, error) {
+
+	changes := []bl.UsernameChangeEntity{}
+	for _, change := range c.usernameChanges {
+		if change.MemberID == memberID {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, nil
+
+}
+
+func (c *fakeCommunity) ResolveUsername(username vo.Username) (bl.MemberEntity, error) {
+
+	for _, change := range c.usernameChanges {
+		if change.OldUsername == username || change.NewUsername == username {
+			return c.GetMember(change.MemberID)
+		}
+	}
+
+	return bl.MemberEntity{}, errors.New("MemberDoesNotExist")
+
+}
+
+func (c *fakeCommunity) DeleteMember(memberID bl.MemberIdentifier, requester bl.MemberIdentifier) (bl.MemberDeletionEntity, error) {
+
+	if _, deleted := c.deletions[memberID]; deleted {
+		return bl.MemberDeletionEntity{}, errors.New("MemberAlreadyDeleted")
+	}
+
+	deletion := bl.MemberDeletionEntity{
+		ID:          uuid.NewV4(),
+		MemberID:    memberID,
+		RequestedBy: requester,
+		RequestedAt: time.Now(),
+		EraseAt:     time.Now().Add(30 * 24 * time.Hour),
+	}
+	if c.deletions == nil {
+		c.deletions = map[bl.MemberIdentifier]bl.MemberDeletionEntity{}
+	}
+	c.deletions[memberID] = deletion
+
+	return deletion, nil
+
+}
+
+func (c *fakeCommunity) CancelMemberDeletion(memberID bl.MemberIdentifier, requester bl.MemberIdentifier) error {
+
+	if _, deleted := c.deletions[memberID]; !deleted {
+		return errors.New("NoPendingDeletion")
+	}
+
+	delete(c.deletions, memberID)
+
+	return nil
+
+}
+
+func (c *fakeCommunity) ExportMemberData(memberID bl.MemberIdentifier, requester bl.MemberIdentifier) (bl.MemberDataExport, error) {
+
+	member, err := c.GetMember(memberID)
+	if err != nil {
+		return bl.MemberDataExport{}, err
+	}
+
+	return bl.MemberDataExport{
+		Member: bl.MemberExport{
+			ID:       member.ID.String(),
+			Username: member.Username.String(),
+		},
+	}, nil
+
+}
+
+func (c *fakeCommunity) SuspendMember(memberID bl.MemberIdentifier, reason string, until time.Time, requester bl.MemberIdentifier) (bl.SanctionEntity, error) {
+
+	if !until.After(time.Now()) {
+		return bl.SanctionEntity{}, errors.New("suspension must end in the future")
+	}
+
+	sanction := bl.SanctionEntity{
+		ID:        uuid.NewV4(),
+		MemberID:  memberID,
+		Type:      bl.SanctionTypeSuspension,
+		Reason:    reason,
+		IssuedBy:  requester,
+		IssuedAt:  time.Now(),
+		ExpiresAt: &until,
+	}
+	c.sanctions = append(c.sanctions, sanction)
+
+	return sanction, nil
+
+}
+
+func (c *fakeCommunity) Unsuspend(memberID bl.MemberIdentifier, reason string, requester bl.MemberIdentifier) error {
+
+	for i, sanction := range c.sanctions {
+		if sanction.MemberID == memberID && sanction.Type == bl.SanctionTypeSuspension && sanction.LiftedAt == nil {
+			now := time.Now()
+			c.sanctions[i].LiftedAt = &now
+			c.sanctions[i].LiftedBy = &requester
+			c.sanctions[i].LiftReason = reason
+			return nil
+		}
+	}
+
+	return errors.New("MemberNotSuspended")
+
+}
+
+func (c *fakeCommunity) Sanctions(memberID bl.MemberIdentifier, requester bl.MemberIdentifier) ([]bl.SanctionEntity, error) {
+
+	sanctions := []bl.SanctionEntity{}
+	for _, sanction := range c.sanctions {
+		if sanction.MemberID == memberID {
+			sanctions = append(sanctions, sanction)
+		}
+	}
+
+	return sanctions, nil
+
+}
+
+func (c *fakeCommunity) Members(query bl.MembersQuery, requester bl.MemberIdentifier) ([]bl.MemberEntity, error) {
+
+	c.membersQueries = append(c.membersQueries, query)
+
+	members := []bl.MemberEntity{}
+	for _, member := range c.members {
+		if query.Verified == nil || *query.Verified == member.Verified {
+			members = append(members, member)
+		}
+	}
+
+	return members, nil
+
+}
+
+func (c *fakeCommunity) Directory(query bl.MembersQuery, requester bl.MemberIdentifier) ([]bl.DirectoryEntry, error) {
+
+	members, err := c.Members(query, requester)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []bl.DirectoryEntry{}
+	for _, member := range members {
+		entries = append(entries, bl.DirectoryEntry{
+			ID:       member.ID,
+			Username: member.Username,
+			Metadata: member.Metadata,
+			Verified: member.Verified,
+			Roles:    member.Roles,
+		})
+	}
+
+	return entries, nil
+
+}
+
+func (c *fakeCommunity) CreateInvitation(options bl.InvitationOptions, requester bl.MemberIdentifier) (bl.InvitationEntity, error) {
+
+	code, err := vo.InvitationCodeFactory()
+	if err != nil {
+		return bl.InvitationEntity{}, err
+	}
+
+	invitation := bl.InvitationEntity{
+		ID:               uuid.NewV4(),
+		Code:             code,
+		CreatedBy:        requester,
+		CreatedAt:        time.Now(),
+		ExpiresAt:        options.ExpiresAt,
+		MaxUses:          options.MaxUses,
+		EmailAddress:     options.EmailAddress,
+		SkipVerification: options.SkipVerification,
+	}
+	c.invitations = append(c.invitations, invitation)
+
+	return invitation, nil
+
+}
+
+func (c *fakeCommunity) SignUpWithInvitation(username vo.Username, emailAddress vo.EmailAddress, metadata bl.MetadataEntity, invitationCode vo.InvitationCode) (bl.MemberEntity, error) {
+
+	for i, invitation := range c.invitations {
+		if invitation.Code == invitationCode {
+			c.invitations[i].Uses++
+			return bl.MemberEntity{
+				ID:           uuid.NewV4(),
+				Username:     username,
+				EmailAddress: emailAddress,
+				Metadata:     metadata,
+				Verified:     invitation.SkipVerification,
+			}, nil
+		}
+	}
+
+	return bl.MemberEntity{}, bl.SignUpErrorInvitationInvalid
+
+}
+
+func (c *fakeCommunity) Invitations(memberID bl.MemberIdentifier, requester bl.MemberIdentifier) ([]bl.InvitationEntity, error) {
+
+	invitations := []bl.InvitationEntity{}
+	for _, invitation := range c.invitations {
+		if invitation.CreatedBy == memberID {
+			invitations = append(invitations, invitation)
+		}
+	}
+
+	return invitations, nil
+
+}
+
+func (c *fakeCommunity) Vouch(applicationID bl.ApplicationID, voucher bl.MemberIdentifier) (bl.VouchEntity, error) {
+
+	for _, vouch := range c.vouches {
+		if vouch.ApplicationID == applicationID && vouch.VoucherID == voucher && vouch.RevokedAt == nil {
+			return bl.VouchEntity{}, errors.New("AlreadyVouched")
+		}
+	}
+
+	vouch := bl.VouchEntity{
+		ID:            uuid.NewV4(),
+		ApplicationID: applicationID,
+		VoucherID:     voucher,
+		VouchedAt:     time.Now(),
+	}
+	c.vouches = append(c.vouches, vouch)
+
+	return vouch, nil
+
+}
+
+func (c *fakeCommunity) RevokeVouch(applicationID bl.ApplicationID, voucher bl.MemberIdentifier) error {
+
+	for i, vouch := range c.vouches {
+		if vouch.ApplicationID == applicationID && vouch.VoucherID == voucher && vouch.RevokedAt == nil {
+			now := time.Now()
+			c.vouches[i].RevokedAt = &now
+			return nil
+		}
+	}
+
+	return errors.New("VouchDoesNotExist")
+
+}
+
+func (c *fakeCommunity) Vouches(applicationID bl.ApplicationID, requester bl.MemberIdentifier) ([]bl.VouchEntity, error) {
+
+	vouches := []bl.VouchEntity{}
+	for _, vouch := range c.vouches {
+		if vouch.ApplicationID == applicationID {
+			vouches = append(vouches, vouch)
+		}
+	}
+
+	return vouches, nil
+
+}
+
+func (c *fakeCommunity) CastVote(applicationID bl.ApplicationID, decision bl.ReviewDecision, comment string, reviewer bl.MemberIdentifier) (bl.ReviewVoteEntity, error) {
+
+	vote := bl.ReviewVoteEntity{
+		ID:            uuid.NewV4(),
+		ApplicationID: applicationID,
+		ReviewerID:    reviewer,
+		Decision:      decision,
+		Comment:       comment,
+		CastAt:        time.Now(),
+	}
+	c.votes = append(c.votes, vote)
+
+	return vote, nil
+
+}
+
+func (c *fakeCommunity) Votes(applicationID bl.ApplicationID, requester bl.MemberIdentifier) ([]bl.ReviewVoteEntity, error) {
+
+	votes := []bl.ReviewVoteEntity{}
+	for _, vote := range c.votes {
+		if vote.ApplicationID == applicationID {
+			votes = append(votes, vote)
+		}
+	}
+
+	return votes, nil
+
+}
+
+func (c *fakeCommunity) AddInternalNote(applicationID bl.ApplicationID, note string, reviewer bl.MemberIdentifier) (bl.ApplicationCommentEntity, error) {
+	return bl.ApplicationCommentEntity{
+		ID:            uuid.NewV4(),
+		ApplicationID: applicationID,
+		AuthorID:      reviewer,
+		Text:          note,
+		Internal:      true,
+		CreatedAt:     time.Now(),
+	}, nil
+}
+
+func (c *fakeCommunity) PublishApplicationForm(questions []bl.FormQuestion, requester bl.MemberIdentifier) (bl.ApplicationFormEntity, error) {
+
+	if len(questions) == 0 {
+		return bl.ApplicationFormEntity{}, bl.InvalidFormError{Reason: "application form needs at least one question"}
+	}
+
+	return bl.ApplicationFormEntity{
+		ID:          uuid.NewV4(),
+		Version:     1,
+		Questions:   questions,
+		PublishedAt: time.Now(),
+	}, nil
+
+}
+
+func (c *fakeCommunity) decideAppeal(appealID uuid.UUID, state bl.AppealState, decision string, reviewer bl.MemberIdentifier) error {
+
+	if decision == "" {
+		return errors.New("decision must not be empty")
+	}
+
+	for i, appeal := range c.appeals {
+		if appeal.ID == appealID {
+			if appeal.State != bl.AppealStatePending {
+				return errors.New("AppealDecided")
+			}
+			now := time.Now()
+			c.appeals[i].State = state
+			c.appeals[i].Decision = decision
+			c.appeals[i].DecidedBy = &reviewer
+			c.appeals[i].DecidedAt = &now
+			return nil
+		}
+	}
+
+	return errors.New("AppealDoesNotExist")
+
+}
+
+func (c *fakeCommunity) OverturnAppeal(appealID uuid.UUID, decision string, reviewer bl.MemberIdentifier) error {
+	return c.decideAppeal(appealID, bl.AppealStateOverturned, decision, reviewer)
+}
+
+func (c *fakeCommunity) UpholdAppeal(appealID uuid.UUID, decision string, reviewer bl.MemberIdentifier) error {
+	return c.decideAppeal(appealID, bl.AppealStateUpheld, decision, reviewer)
+}
+
+func (c *fakeCommunity) Appeals(state bl.AppealState, requester bl.MemberIdentifier) ([]bl.AppealEntity, error) {
+
+	appeals := []bl.AppealEntity{}
+	for _, appeal := range c.appeals {
+		if appeal.State == state {
+			appeals = append(appeals, appeal)
+		}
+	}
+
+	return appeals, nil
+
+}
+
+func (c *fakeCommunity) VerificationRevocations(memberID bl.MemberIdentifier, requester bl.MemberIdentifier) ([]bl.VerificationRevocationEntity, error) {
+	return []bl.VerificationRevocationEntity{
+		{ID: uuid.NewV4(), MemberID: memberID, Reason: "fake profile", RevokedBy: requester, RevokedAt: time.Now()},
+	}, nil
+}
+
+func (c *fakeCommunity) AuditLog(query bl.AuditLogQuery, requester bl.MemberIdentifier) ([]bl.AuditEntryEntity, error) {
+	c.auditLogQueries = append(c.auditLogQueries, query)
+	return c.auditLog, nil
+}
+
+func (c *fakeCommunity) VerifyAuditLog(requester bl.MemberIdentifier) error {
+
+	previousHash := ""
+	for _, entry := range c.auditLog {
+		if entry.PreviousHash != previousHash {
+			return bl.AuditLogTamperedError{Sequence: entry.Sequence}
+		}
+		previousHash = entry.Hash
+	}
+
+	return nil
+
+}
+
+func (c *fakeCommunity) OnSignUp(cb func(member bl.MemberEntity)) {
+	c.onSignUp = append(c.onSignUp, cb)
+}
+
+func (c *fakeCommunity) OnLogin(cb func(member bl.MemberEntity))                          {}
+func (c *fakeCommunity) OnApplicationSubmitted(cb func(application bl.ApplicationEntity)) {}
+func (c *fakeCommunity) OnApplicationApproved(cb func(member bl.MemberEntity))            {}
+func (c *fakeCommunity) OnApplicationRejected(cb func(application bl.ApplicationEntity))  {}
+func (c *fakeCommunity) OnRoleChanged(cb func(change bl.RoleChangeEntity))                {}
+func (c *fakeCommunity) OnProfileChanged(cb func(member bl.MemberEntity))                 {}
+func (c *fakeCommunity) OnMemberDeleted(cb func(member bl.MemberEntity))                  {}
+func (c *fakeCommunity) OnMemberErased(cb func(member bl.MemberEntity))                   {}
+func (c *fakeCommunity) OnVerificationRevoked(cb func(member bl.MemberEntity))            {}
+func (c *fakeCommunity) OnApplicationExpired(cb func(application bl.ApplicationEntity))   {}
+
+func newMember(t *testing.T, username string, roles ...bl.Role) bl.MemberEntity {
+
+	t.Helper()
+
+	u, err := vo.NewUsername(username)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	emailAddress, err := vo.NewEmailAddress(username + "@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	properName, err := vo.NewProperName("First", "Last")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return bl.MemberEntity{
+		ID:           uuid.NewV4(),
+		Username:     u,
+		EmailAddress: emailAddress,
+		Metadata:     bl.MetadataEntity{ProperName: properName},
+		Roles:        roles,
+	}
+
+}
+
+// startServer serves the community over an in memory connection
+func startServer(t *testing.T, community *fakeCommunity) (CommunityClient, *Server, func()) {
+
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+
+	server := NewServer(community)
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(UnaryAuthInterceptor(community)),
+		grpc.StreamInterceptor(StreamAuthInterceptor(community)),
+	)
+	RegisterCommunityServer(grpcServer, server)
+
+	go grpcServer.Serve(listener)
+
+	conn, err := grpc.Dial(
+		"bufconn",
+		grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewCommunityClient(conn), server, func() {
+		conn.Close()
+		grpcServer.Stop()
+	}
+
+}
+
+func withAccessToken(ctx context.Context, accessToken string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+accessToken)
+}
+
+func TestUnauthenticatedCallIsRejected(t *testing.T) {
+
+	client, _, stop := startServer(t, &fakeCommunity{members: map[string]bl.MemberEntity{}})
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := client.Me(ctx, &MeRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated without access token, got: %v", err)
+	}
+
+	if _, err := client.Me(withAccessToken(ctx, "unknown"), &MeRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated with unknown access token, got: %v", err)
+	}
+
+}
+
+func TestMissingRoleIsRejected(t *testing.T) {
+
+	member := newMember(t, "member")
+	other := newMember(t, "other")
+	admin := newMember(t, "admin", bl.RoleAdmin)
+
+	client, _, stop := startServer(t, &fakeCommunity{members: map[string]bl.MemberEntity{
+		"member": member,
+		"other":  other,
+		"admin":  admin,
+	}})
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.GetMember(withAccessToken(ctx, "member"), &GetMemberRequest{MemberId: other.ID.String()})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied for a member without members:read, got: %v", err)
+	}
+
+	fetched, err := client.GetMember(withAccessToken(ctx, "admin"), &GetMemberRequest{MemberId: other.ID.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fetched.Id != other.ID.String() {
+		t.Fatalf("expected member %s, got: %s", other.ID.String(), fetched.Id)
+	}
+
+	stream, err := client.Events(withAccessToken(ctx, "member"), &EventsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := stream.Recv(); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied when subscribing without members:read, got: %v", err)
+	}
+
+}
+
+func TestApproveApplicationApproves(t *testing.T) {
+
+	reviewer := newMember(t, "reviewer", bl.RoleReviewer)
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"reviewer": reviewer}}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	applicationID := uuid.NewV4()
+	if _, err := client.ApproveApplication(withAccessToken(ctx, "reviewer"), &ApproveApplicationRequest{ApplicationId: applicationID.String()}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(community.approved) != 1 || community.approved[0] != applicationID {
+		t.Fatalf("expected application %s to be approved, got: %v", applicationID.String(), community.approved)
+	}
+
+}
+
+func TestDemote(t *testing.T) {
+
+	owner := newMember(t, "owner", bl.RoleOwner)
+	admin := newMember(t, "admin", bl.RoleAdmin)
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"owner": owner}}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.Demote(withAccessToken(ctx, "owner"), &DemoteRequest{EmailAddress: "invalid"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an invalid email address, got: %v", err)
+	}
+
+	if _, err := client.Demote(withAccessToken(ctx, "owner"), &DemoteRequest{EmailAddress: admin.EmailAddress.String()}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(community.demoted) != 1 || community.demoted[0] != admin.EmailAddress {
+		t.Fatalf("expected %s to be demoted, got: %v", admin.EmailAddress.String(), community.demoted)
+	}
+
+}
+
+func TestUpdateProfile(t *testing.T) {
+
+	member := newMember(t, "member")
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"member": member}}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.UpdateProfile(withAccessToken(ctx, "member"), &UpdateProfileRequest{MemberId: member.ID.String(), FirstName: "New"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an incomplete proper name, got: %v", err)
+	}
+
+	updated, err := client.UpdateProfile(withAccessToken(ctx, "member"), &UpdateProfileRequest{
+		MemberId:           member.ID.String(),
+		FirstName:          "New",
+		LastName:           "Name",
+		RemoveProfileImage: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updated.Metadata.FirstName != "New" || updated.Metadata.LastName != "Name" {
+		t.Fatalf("expected the changed proper name, got: %v", updated.Metadata)
+	}
+
+	if len(community.profileChanges) != 1 || community.profileChanges[0].ProfileImage != nil || !community.profileChanges[0].RemoveProfileImage {
+		t.Fatalf("expected only the proper name and the removal to be changed, got: %v", community.profileChanges)
+	}
+
+}
+
+func TestEmailChange(t *testing.T) {
+
+	member := newMember(t, "member")
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"member": member}}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.ConfirmEmailChange(withAccessToken(ctx, "member"), &ConfirmEmailChangeRequest{EmailAddress: "new@example.com", ConfirmationCode: "123456"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for an unrequested email change, got: %v", err)
+	}
+
+	if _, err := client.RequestEmailChange(withAccessToken(ctx, "member"), &RequestEmailChangeRequest{EmailAddress: "new@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := client.ConfirmEmailChange(withAccessToken(ctx, "member"), &ConfirmEmailChangeRequest{EmailAddress: "new@example.com", ConfirmationCode: "123456"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updated.Id != member.ID.String() || updated.EmailAddress != "new@example.com" {
+		t.Fatalf("expected the email address of %s to be changed, got: %v", member.ID.String(), updated)
+	}
+
+}
+
+func TestChangeUsername(t *testing.T) {
+
+	member := newMember(t, "member")
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"member": member}}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.ChangeUsername(withAccessToken(ctx, "member"), &ChangeUsernameRequest{MemberId: member.ID.String(), Username: "not a username!"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an invalid username, got: %v", err)
+	}
+
+	updated, err := client.ChangeUsername(withAccessToken(ctx, "member"), &ChangeUsernameRequest{MemberId: member.ID.String(), Username: "renamed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Username != "renamed" {
+		t.Fatalf("expected the username to be changed, got: %s", updated.Username)
+	}
+
+	history, err := client.UsernameHistory(withAccessToken(ctx, "member"), &UsernameHistoryRequest{MemberId: member.ID.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history.UsernameChanges) != 1 || history.UsernameChanges[0].OldUsername != "member" || history.UsernameChanges[0].NewUsername != "renamed" {
+		t.Fatalf("expected a single change from member to renamed, got: %v", history.UsernameChanges)
+	}
+
+	resolved, err := client.ResolveUsername(withAccessToken(ctx, "member"), &ResolveUsernameRequest{Username: "member"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.Id != member.ID.String() {
+		t.Fatalf("expected the old username to resolve to %s, got: %s", member.ID.String(), resolved.Id)
+	}
+
+}
+
+func TestDeleteMember(t *testing.T) {
+
+	member := newMember(t, "member")
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"member": member}}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.CancelMemberDeletion(withAccessToken(ctx, "member"), &CancelMemberDeletionRequest{MemberId: member.ID.String()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition without a pending deletion, got: %v", err)
+	}
+
+	deletion, err := client.DeleteMember(withAccessToken(ctx, "member"), &DeleteMemberRequest{MemberId: member.ID.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deletion.MemberId != member.ID.String() || deletion.RequestedBy != member.ID.String() || deletion.EraseAt <= deletion.RequestedAt {
+		t.Fatalf("expected a pending deletion of %s, got: %v", member.ID.String(), deletion)
+	}
+
+	if _, err := client.CancelMemberDeletion(withAccessToken(ctx, "member"), &CancelMemberDeletionRequest{MemberId: member.ID.String()}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(community.deletions) != 0 {
+		t.Fatalf("expected the deletion to be cancelled, got: %v", community.deletions)
+	}
+
+}
+
+func TestExportMemberData(t *testing.T) {
+
+	member := newMember(t, "member")
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"member": member}}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	export, err := client.ExportMemberData(withAccessToken(ctx, "member"), &ExportMemberDataRequest{MemberId: member.ID.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exported := bl.MemberDataExport{}
+	if err := json.Unmarshal(export.Data, &exported); err != nil {
+		t.Fatal(err)
+	}
+	if export.ContentType != "application/json" || exported.Member.ID != member.ID.String() {
+		t.Fatalf("expected the json export of %s, got: %s", member.ID.String(), string(export.Data))
+	}
+
+	export, err = client.ExportMemberData(withAccessToken(ctx, "member"), &ExportMemberDataRequest{MemberId: member.ID.String(), Zip: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(export.Data), int64(len(export.Data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if export.ContentType != "application/zip" || len(archive.File) != 1 || archive.File[0].Name != "data.json" {
+		t.Fatalf("expected a zip archive with the json export, got: %v", archive.File)
+	}
+
+}
+
+func TestSuspendMember(t *testing.T) {
+
+	moderator := newMember(t, "moderator", bl.RoleModerator)
+	member := newMember(t, "member")
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"moderator": moderator}}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.SuspendMember(withAccessToken(ctx, "moderator"), &SuspendMemberRequest{MemberId: member.ID.String(), Reason: "spam"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for a suspension without an end, got: %v", err)
+	}
+
+	until := time.Now().Add(time.Hour).Unix()
+	sanction, err := client.SuspendMember(withAccessToken(ctx, "moderator"), &SuspendMemberRequest{MemberId: member.ID.String(), Reason: "spam", Until: until})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sanction.Type != string(bl.SanctionTypeSuspension) || sanction.ExpiresAt != until || sanction.IssuedBy != moderator.ID.String() {
+		t.Fatalf("expected a suspension until %d, got: %v", until, sanction)
+	}
+
+	if _, err := client.Unsuspend(withAccessToken(ctx, "moderator"), &UnsuspendRequest{MemberId: member.ID.String(), Reason: "appealed"}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Unsuspend(withAccessToken(ctx, "moderator"), &UnsuspendRequest{MemberId: member.ID.String(), Reason: "appealed"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for a member that isn't suspended, got: %v", err)
+	}
+
+	sanctions, err := client.Sanctions(withAccessToken(ctx, "moderator"), &SanctionsRequest{MemberId: member.ID.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sanctions.Sanctions) != 1 || sanctions.Sanctions[0].LiftedBy != moderator.ID.String() || sanctions.Sanctions[0].LiftReason != "appealed" {
+		t.Fatalf("expected the lifted suspension, got: %v", sanctions.Sanctions)
+	}
+
+}
+
+func TestMembers(t *testing.T) {
+
+	admin := newMember(t, "admin", bl.RoleAdmin)
+	admin.Verified = true
+	member := newMember(t, "member")
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"admin": admin, "member": member}}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.Members(withAccessToken(ctx, "admin"), &MembersRequest{Verified: "yes"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an invalid filter, got: %v", err)
+	}
+
+	_, err = client.Members(withAccessToken(ctx, "admin"), &MembersRequest{Sort: "Email"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an invalid sort, got: %v", err)
+	}
+
+	members, err := client.Members(withAccessToken(ctx, "admin"), &MembersRequest{Verified: "false", Role: string(bl.RoleReviewer), InvitedBy: admin.ID.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(members.Members) != 1 || members.Members[0].Id != member.ID.String() {
+		t.Fatalf("expected only the unverified member, got: %v", members.Members)
+	}
+
+	query := community.membersQueries[0]
+	if query.Banned != nil || query.Role == nil || *query.Role != bl.RoleReviewer || query.InvitedBy == nil || *query.InvitedBy != admin.ID {
+		t.Fatalf("expected the filters to be passed to the community, got: %+v", query)
+	}
+
+	directory, err := client.Directory(withAccessToken(ctx, "member"), &MembersRequest{Verified: "true"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(directory.Entries) != 1 || directory.Entries[0].Username != "admin" {
+		t.Fatalf("expected only the verified member in the directory, got: %v", directory.Entries)
+	}
+
+}
+
+func TestInvitations(t *testing.T) {
+
+	reviewer := newMember(t, "reviewer", bl.RoleReviewer)
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"reviewer": reviewer}}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	invitation, err := client.CreateInvitation(withAccessToken(ctx, "reviewer"), &CreateInvitationRequest{MaxUses: 1, EmailAddress: "invited@example.com", SkipVerification: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invitation.Code == "" || invitation.CreatedBy != reviewer.ID.String() || invitation.EmailAddress != "invited@example.com" || invitation.ExpiresAt != 0 {
+		t.Fatalf("expected an invitation for invited@example.com without expiry, got: %v", invitation)
+	}
+
+	// signing up with an invitation doesn't require an access token
+	member, err := client.SignUpWithInvitation(ctx, &SignUpWithInvitationRequest{
+		Username:       "invited",
+		EmailAddress:   "invited@example.com",
+		Metadata:       &Metadata{FirstName: "First", LastName: "Last"},
+		InvitationCode: invitation.Code,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if member.Username != "invited" || !member.Verified {
+		t.Fatalf("expected the invited member to be verified, got: %v", member)
+	}
+
+	invitations, err := client.Invitations(withAccessToken(ctx, "reviewer"), &InvitationsRequest{MemberId: reviewer.ID.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invitations.Invitations) != 1 || invitations.Invitations[0].Uses != 1 {
+		t.Fatalf("expected the used invitation, got: %v", invitations.Invitations)
+	}
+
+}
+
+func TestVouch(t *testing.T) {
+
+	voucher := newMember(t, "voucher")
+	voucher.Verified = true
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"voucher": voucher}}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	applicationID := uuid.NewV4()
+
+	vouch, err := client.Vouch(withAccessToken(ctx, "voucher"), &VouchRequest{ApplicationId: applicationID.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vouch.ApplicationId != applicationID.String() || vouch.VoucherId != voucher.ID.String() {
+		t.Fatalf("expected a vouch of %s for %s, got: %v", voucher.ID.String(), applicationID.String(), vouch)
+	}
+
+	_, err = client.Vouch(withAccessToken(ctx, "voucher"), &VouchRequest{ApplicationId: applicationID.String()})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected AlreadyExists for a second vouch, got: %v", err)
+	}
+
+	if _, err := client.RevokeVouch(withAccessToken(ctx, "voucher"), &RevokeVouchRequest{ApplicationId: applicationID.String()}); err != nil {
+		t.Fatal(err)
+	}
+
+	vouches, err := client.Vouches(withAccessToken(ctx, "voucher"), &VouchesRequest{ApplicationId: applicationID.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vouches.Vouches) != 1 || vouches.Vouches[0].RevokedAt == 0 {
+		t.Fatalf("expected the revoked vouch, got: %v", vouches.Vouches)
+	}
+
+}
+
+func TestCastVote(t *testing.T) {
+
+	reviewer := newMember(t, "reviewer", bl.RoleReviewer)
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"reviewer": reviewer}}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	applicationID := uuid.NewV4()
+
+	_, err := client.CastVote(withAccessToken(ctx, "reviewer"), &CastVoteRequest{ApplicationId: applicationID.String(), Decision: "Maybe"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an invalid decision, got: %v", err)
+	}
+
+	vote, err := client.CastVote(withAccessToken(ctx, "reviewer"), &CastVoteRequest{ApplicationId: applicationID.String(), Decision: string(bl.ReviewDecisionApprove), Comment: "looks good"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vote.ReviewerId != reviewer.ID.String() || vote.Decision != string(bl.ReviewDecisionApprove) || vote.Comment != "looks good" {
+		t.Fatalf("expected an approving vote of %s, got: %v", reviewer.ID.String(), vote)
+	}
+
+	votes, err := client.Votes(withAccessToken(ctx, "reviewer"), &VotesRequest{ApplicationId: applicationID.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(votes.Votes) != 1 || votes.Votes[0].Id != vote.Id {
+		t.Fatalf("expected the cast vote, got: %v", votes.Votes)
+	}
+
+}
+
+func TestAddInternalNote(t *testing.T) {
+
+	reviewer := newMember(t, "reviewer", bl.RoleReviewer)
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"reviewer": reviewer}}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	applicationID := uuid.NewV4()
+
+	note, err := client.AddInternalNote(withAccessToken(ctx, "reviewer"), &AddInternalNoteRequest{ApplicationId: applicationID.String(), Note: "knows the founder"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if note.ApplicationId != applicationID.String() || note.AuthorId != reviewer.ID.String() || note.Text != "knows the founder" || !note.Internal {
+		t.Fatalf("expected an internal note of %s, got: %v", reviewer.ID.String(), note)
+	}
+
+}
+
+func TestPublishApplicationForm(t *testing.T) {
+
+	admin := newMember(t, "admin", bl.RoleAdmin)
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"admin": admin}}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.PublishApplicationForm(withAccessToken(ctx, "admin"), &PublishApplicationFormRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for a form without questions, got: %v", err)
+	}
+
+	form, err := client.PublishApplicationForm(withAccessToken(ctx, "admin"), &PublishApplicationFormRequest{
+		Questions: []*FormQuestion{
+			{Id: "motivation", Label: "Why do you want to join?", Type: string(bl.FormQuestionTypeText), Required: true, MaxLength: 500},
+			{Id: "referral", Label: "How did you hear about us?", Type: string(bl.FormQuestionTypeChoice), Choices: []string{"Friend", "Search"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if form.Version != 1 || len(form.Questions) != 2 || form.Questions[0].MaxLength != 500 || len(form.Questions[1].Choices) != 2 {
+		t.Fatalf("expected the published questions, got: %v", form)
+	}
+
+}
+
+func TestDecideAppeals(t *testing.T) {
+
+	reviewer := newMember(t, "reviewer", bl.RoleReviewer)
+	overturned := bl.AppealEntity{ID: uuid.NewV4(), ApplicationID: uuid.NewV4(), MemberID: uuid.NewV4(), State: bl.AppealStatePending}
+	upheld := bl.AppealEntity{ID: uuid.NewV4(), ApplicationID: uuid.NewV4(), MemberID: uuid.NewV4(), State: bl.AppealStatePending}
+	community := &fakeCommunity{
+		members: map[string]bl.MemberEntity{"reviewer": reviewer},
+		appeals: []bl.AppealEntity{overturned, upheld},
+	}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	pending, err := client.Appeals(withAccessToken(ctx, "reviewer"), &AppealsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending.Appeals) != 2 {
+		t.Fatalf("expected both pending appeals, got: %v", pending.Appeals)
+	}
+
+	_, err = client.OverturnAppeal(withAccessToken(ctx, "reviewer"), &DecideAppealRequest{AppealId: overturned.ID.String()})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for a missing decision, got: %v", err)
+	}
+
+	if _, err := client.OverturnAppeal(withAccessToken(ctx, "reviewer"), &DecideAppealRequest{AppealId: overturned.ID.String(), Decision: "the rejection was a mistake"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.UpholdAppeal(withAccessToken(ctx, "reviewer"), &DecideAppealRequest{AppealId: upheld.ID.String(), Decision: "the rejection stands"}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.UpholdAppeal(withAccessToken(ctx, "reviewer"), &DecideAppealRequest{AppealId: overturned.ID.String(), Decision: "the rejection stands"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for a decided appeal, got: %v", err)
+	}
+
+	decided, err := client.Appeals(withAccessToken(ctx, "reviewer"), &AppealsRequest{State: string(bl.AppealStateOverturned)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decided.Appeals) != 1 || decided.Appeals[0].Id != overturned.ID.String() || decided.Appeals[0].DecidedBy != reviewer.ID.String() {
+		t.Fatalf("expected the overturned appeal, got: %v", decided.Appeals)
+	}
+
+	_, err = client.Appeals(withAccessToken(ctx, "reviewer"), &AppealsRequest{State: "Open"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an invalid state, got: %v", err)
+	}
+
+}
+
+func TestVerificationRevocations(t *testing.T) {
+
+	admin := newMember(t, "admin", bl.RoleAdmin)
+	member := newMember(t, "member")
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"admin": admin}}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.VerificationRevocations(withAccessToken(ctx, "admin"), &VerificationRevocationsRequest{MemberId: "invalid"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an invalid member id, got: %v", err)
+	}
+
+	revocations, err := client.VerificationRevocations(withAccessToken(ctx, "admin"), &VerificationRevocationsRequest{MemberId: member.ID.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(revocations.Revocations) != 1 || revocations.Revocations[0].MemberId != member.ID.String() || revocations.Revocations[0].Reason != "fake profile" {
+		t.Fatalf("expected the revocation of %s, got: %v", member.ID.String(), revocations.Revocations)
+	}
+
+}
+
+func TestAuditLog(t *testing.T) {
+
+	admin := newMember(t, "admin", bl.RoleAdmin)
+	target := uuid.NewV4()
+	community := &fakeCommunity{
+		members: map[string]bl.MemberEntity{"admin": admin},
+		auditLog: []bl.AuditEntryEntity{
+			{ID: uuid.NewV4(), Sequence: 1, Action: bl.AuditActionLogin, Actor: &admin.ID, Outcome: bl.AuditOutcomeSucceeded, OccurredAt: time.Now(), Hash: "a"},
+			{ID: uuid.NewV4(), Sequence: 2, Action: bl.AuditActionMemberBanned, Actor: &admin.ID, Target: &target, Outcome: bl.AuditOutcomeSucceeded, OccurredAt: time.Now(), PreviousHash: "a", Hash: "b"},
+		},
+	}
+
+	client, _, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	log, err := client.AuditLog(withAccessToken(ctx, "admin"), &AuditLogRequest{Position: 1, Actor: admin.ID.String(), Action: string(bl.AuditActionMemberBanned)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log.Entries) != 2 || log.Entries[0].Target != "" || log.Entries[1].Target != target.String() || log.Entries[1].PreviousHash != "a" {
+		t.Fatalf("expected both entries, got: %v", log.Entries)
+	}
+
+	query := community.auditLogQueries[0]
+	if query.Position == nil || *query.Position != 1 || query.Actor == nil || *query.Actor != admin.ID || query.Target != nil || query.Action != bl.AuditActionMemberBanned {
+		t.Fatalf("expected the filters to be passed to the community, got: %+v", query)
+	}
+
+	verified, err := client.VerifyAuditLog(withAccessToken(ctx, "admin"), &VerifyAuditLogRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verified.Tampered {
+		t.Fatalf("expected the audit log to be intact, got: %v", verified)
+	}
+
+	community.auditLog[1].PreviousHash = "changed"
+
+	verified, err = client.VerifyAuditLog(withAccessToken(ctx, "admin"), &VerifyAuditLogRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !verified.Tampered || verified.TamperedSequence != 2 {
+		t.Fatalf("expected the audit log to be tampered with at entry 2, got: %v", verified)
+	}
+
+}
+
+func TestStatusFromError(t *testing.T) {
+
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{errors.New("InsufficientPermissions"), codes.PermissionDenied},
+		{errors.New("MemberDoesNotExist"), codes.NotFound},
+		{errors.New("UsernameTaken"), codes.AlreadyExists},
+		{errors.New("ApplicationReviewed"), codes.FailedPrecondition},
+		{errors.New("InvalidCursor"), codes.InvalidArgument},
+		{errors.New("couldn't find member"), codes.NotFound},
+		{errors.New("something went wrong"), codes.Internal},
+		{bl.LoginErrorConfirmationCodeExpired, codes.Unauthenticated},
+		{bl.GetMemberByAccessTokenErrorRevoked, codes.Unauthenticated},
+		{bl.SignUpErrorEmailAddressBanned, codes.FailedPrecondition},
+		{bl.EmailChangeErrorEmailAddressBanned, codes.FailedPrecondition},
+		{bl.VouchErrorLimitReached, codes.ResourceExhausted},
+		{bl.EmailChangeErrorConfirmationCodeExpired, codes.FailedPrecondition},
+		{bl.RequestLoginCoolDownError{TryAgainAt: 1}, codes.ResourceExhausted},
+		{bl.RequestEmailChangeCoolDownError{TryAgainAt: 1}, codes.ResourceExhausted},
+		{bl.ChangeUsernameCoolDownError{TryAgainAt: 1}, codes.ResourceExhausted},
+		{bl.MemberSuspendedError{SuspendedUntil: 1}, codes.PermissionDenied},
+		{bl.MemberBannedError{}, codes.PermissionDenied},
+	}
+
+	for _, c := range cases {
+		if code := status.Code(statusFromError(c.err)); code != c.code {
+			t.Errorf("%q: expected %s, got: %s", c.err.Error(), c.code, code)
+		}
+	}
+
+}
+
+func TestEventsStreamReceivesEvents(t *testing.T) {
+
+	admin := newMember(t, "admin", bl.RoleAdmin)
+	community := &fakeCommunity{members: map[string]bl.MemberEntity{"admin": admin}}
+
+	client, server, stop := startServer(t, community)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.Events(withAccessToken(ctx, "admin"), &EventsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the subscription is registered once the handler runs
+	for {
+		server.events.lock.Lock()
+		subscribers := len(server.events.subscribers)
+		server.events.lock.Unlock()
+		if subscribers > 0 {
+			break
+		}
+		if ctx.Err() != nil {
+			t.Fatal("stream didn't subscribe")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	signedUp := newMember(t, "newcomer")
+	for _, onSignUp := range community.onSignUp {
+		onSignUp(signedUp)
+	}
+
+	event, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.Type != Event_SIGNED_UP || event.Member.Id != signedUp.ID.String() {
+		t.Fatalf("expected sign up event of %s, got: %v", signedUp.ID.String(), event)
+	}
+
+}
@@ -0,0 +1,304 @@
+package community_bl
+
+import (
+	"errors"
+	"time"
+)
+
+type StatsInterval string
+
+var StatsIntervalDay = StatsInterval("Day")
+var StatsIntervalWeek = StatsInterval("Week")
+var StatsIntervalMonth = StatsInterval("Month")
+
+func (i StatsInterval) Valid() bool {
+
+	switch i {
+	case StatsIntervalDay:
+		return true
+	case StatsIntervalWeek:
+		return true
+	case StatsIntervalMonth:
+		return true
+	default:
+		return false
+	}
+
+}
+
+// start returns the beginning of the interval t is in. Intervals are aligned to UTC and weeks start on Monday.
+func (i StatsInterval) start(t time.Time) time.Time {
+
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch i {
+	case StatsIntervalWeek:
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case StatsIntervalMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+
+}
+
+func (i StatsInterval) add(t time.Time, n int) time.Time {
+
+	switch i {
+	case StatsIntervalWeek:
+		return t.AddDate(0, 0, 7*n)
+	case StatsIntervalMonth:
+		return t.AddDate(0, n, 0)
+	default:
+		return t.AddDate(0, 0, n)
+	}
+
+}
+
+// MaxStatsPeriods is the maximum amount of periods a single stats query can report
+const MaxStatsPeriods = 400
+
+type StatsQuery struct {
+	// Interval is the length of the reported periods. It defaults to StatsIntervalMonth.
+	Interval StatsInterval
+	// From is moved back to the beginning of its interval. It defaults to eleven
+	// intervals before the interval of Until, so twelve periods are reported.
+	From *time.Time
+	// Until is exclusive and defaults to now
+	Until *time.Time
+}
+
+// StatsPeriod contains the numbers of one interval. Like every range of the stats it's half-open, so [From, Until).
+type StatsPeriod struct {
+	From    time.Time
+	Until   time.Time
+	SignUps uint
+	Logins  uint
+	// ActiveMembers is the amount of distinct members that logged in
+	ActiveMembers uint
+}
+
+// SignUpFunnel follows the members that signed up within the reported range. Every step
+// only counts the members that also reached the previous steps.
+type SignUpFunnel struct {
+	SignedUp             uint
+	VerifiedEmailAddress uint
+	Applied              uint
+	Verified             uint
+}
+
+type ReviewerStats struct {
+	Reviewer MemberIdentifier
+	Approved uint
+	Rejected uint
+	// ApprovalRate is the share of approvals among the decisions of the reviewer
+	ApprovalRate float64
+}
+
+type CommunityStats struct {
+	Interval StatsInterval
+	From     time.Time
+	Until    time.Time
+	Periods  []StatsPeriod
+	Funnel   SignUpFunnel
+	// EmailVerificationRate is the share of the members that signed up within the range and verified their email address
+	EmailVerificationRate float64
+	// ApplicationsByState counts the applications submitted within the range by their current state
+	ApplicationsByState map[ApplicationState]uint
+	// MedianReviewTime is the median time between submission and decision of the applications decided
+	// within the range. It's nil if no application has been decided.
+	MedianReviewTime *time.Duration
+	// Reviewers contains the reviewers that decided applications within the range
+	Reviewers []ReviewerStats
+}
+
+var applicationStates = []ApplicationState{
+	ApplicationStatePending,
+	ApplicationStateInformationRequested,
+	ApplicationStateApproved,
+	ApplicationStateRejected,
+	ApplicationStateWithdrawn,
+	ApplicationStateExpired,
+}
+
+type statsService struct {
+	memberRepository      MemberRepository
+	applicationRepository ApplicationRepository
+	loginRepository       LoginRepository
+}
+
+// Stats computes the community statistics with the aggregate queries of the repositories.
+// Every range is half-open, so a member that signed up at the start of a period only counts towards that period.
+func (s *statsService) Stats(query StatsQuery, requesterID MemberIdentifier) (CommunityStats, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return CommunityStats{}, err
+	}
+
+	if requester == nil {
+		return CommunityStats{}, errors.New("RequesterNotFound")
+	}
+
+	if !requester.HasPermission(PermissionStatsRead) {
+		return CommunityStats{}, AuthorizationErrorInsufficientPermissions
+	}
+
+	if query.Interval == "" {
+		query.Interval = StatsIntervalMonth
+	}
+
+	if !query.Interval.Valid() {
+		return CommunityStats{}, errors.New("InvalidStatsInterval")
+	}
+
+	until := time.Now().UTC()
+	if query.Until != nil {
+		until = query.Until.UTC()
+	}
+
+	from := query.Interval.add(query.Interval.start(until), -11)
+	if query.From != nil {
+		from = query.Interval.start(*query.From)
+	}
+
+	if !from.Before(until) {
+		return CommunityStats{}, errors.New("InvalidStatsRange")
+	}
+
+	if query.Interval.add(from, MaxStatsPeriods).Before(until) {
+		return CommunityStats{}, errors.New("StatsRangeTooLarge")
+	}
+
+	stats := CommunityStats{
+		Interval:            query.Interval,
+		From:                from,
+		Until:               until,
+		Periods:             []StatsPeriod{},
+		ApplicationsByState: map[ApplicationState]uint{},
+		Reviewers:           []ReviewerStats{},
+	}
+
+	for start := from; start.Before(until); start = query.Interval.add(start, 1) {
+
+		end := query.Interval.add(start, 1)
+		if end.After(until) {
+			end = until
+		}
+
+		period, err := s.period(start, end)
+		if err != nil {
+			return CommunityStats{}, err
+		}
+
+		stats.Periods = append(stats.Periods, period)
+
+	}
+
+	if stats.Funnel, err = s.funnel(from, until); err != nil {
+		return CommunityStats{}, err
+	}
+
+	if stats.Funnel.SignedUp > 0 {
+		stats.EmailVerificationRate = float64(stats.Funnel.VerifiedEmailAddress) / float64(stats.Funnel.SignedUp)
+	}
+
+	for _, state := range applicationStates {
+
+		count, err := s.applicationRepository.CountByQuery(ApplicationsQuery{
+			States:        []ApplicationState{state},
+			CreatedAfter:  &from,
+			CreatedBefore: &until,
+		})
+		if err != nil {
+			return CommunityStats{}, err
+		}
+
+		stats.ApplicationsByState[state] = count
+
+	}
+
+	if stats.MedianReviewTime, err = s.applicationRepository.MedianReviewDuration(from, until); err != nil {
+		return CommunityStats{}, err
+	}
+
+	reviewers, err := s.applicationRepository.CountDecisionsByReviewer(from, until)
+	if err != nil {
+		return CommunityStats{}, err
+	}
+
+	for _, reviewer := range reviewers {
+		if decisions := reviewer.Approved + reviewer.Rejected; decisions > 0 {
+			reviewer.ApprovalRate = float64(reviewer.Approved) / float64(decisions)
+		}
+		stats.Reviewers = append(stats.Reviewers, reviewer)
+	}
+
+	return stats, nil
+
+}
+
+func (s *statsService) period(from time.Time, until time.Time) (StatsPeriod, error) {
+
+	period := StatsPeriod{
+		From:  from,
+		Until: until,
+	}
+
+	var err error
+
+	if period.SignUps, err = s.memberRepository.CountByQuery(signUpsQuery(from, until)); err != nil {
+		return StatsPeriod{}, err
+	}
+
+	if period.Logins, err = s.loginRepository.CountLogins(from, until); err != nil {
+		return StatsPeriod{}, err
+	}
+
+	if period.ActiveMembers, err = s.loginRepository.CountActiveMembers(from, until); err != nil {
+		return StatsPeriod{}, err
+	}
+
+	return period, nil
+
+}
+
+func (s *statsService) funnel(from time.Time, until time.Time) (SignUpFunnel, error) {
+
+	funnel := SignUpFunnel{}
+	yes := true
+	var err error
+
+	query := signUpsQuery(from, until)
+	if funnel.SignedUp, err = s.memberRepository.CountByQuery(query); err != nil {
+		return SignUpFunnel{}, err
+	}
+
+	query.VerifiedEmailAddress = &yes
+	if funnel.VerifiedEmailAddress, err = s.memberRepository.CountByQuery(query); err != nil {
+		return SignUpFunnel{}, err
+	}
+
+	query.Applied = &yes
+	if funnel.Applied, err = s.memberRepository.CountByQuery(query); err != nil {
+		return SignUpFunnel{}, err
+	}
+
+	query.Verified = &yes
+	if funnel.Verified, err = s.memberRepository.CountByQuery(query); err != nil {
+		return SignUpFunnel{}, err
+	}
+
+	return funnel, nil
+
+}
+
+// signUpsQuery matches every member that signed up within the period, including deleted members
+func signUpsQuery(from time.Time, until time.Time) MembersQuery {
+	return MembersQuery{
+		CreatedAfter:   &from,
+		CreatedBefore:  &until,
+		IncludeDeleted: true,
+	}
+}
@@ -0,0 +1,88 @@
+package community_bl
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	reviewer := c.signUp("reviewer", RoleReviewer)
+	approved := c.signUp("approved")
+	pending := c.signUp("pending")
+	c.signUp("idle")
+
+	c.login(approved)
+	c.login(pending)
+
+	application := c.apply(approved)
+	c.apply(pending)
+
+	if err := c.ApproveApplication(application.ID, reviewer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := c.Stats(StatsQuery{}, admin.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stats.Interval != StatsIntervalMonth || len(stats.Periods) != 12 {
+		t.Fatalf("expected twelve months by default, got: %d %s", len(stats.Periods), stats.Interval)
+	}
+
+	if signUps := stats.Periods[11].SignUps; signUps != 5 {
+		t.Fatalf("expected five sign ups in the current month, got: %d", signUps)
+	}
+
+	funnel := SignUpFunnel{SignedUp: 5, VerifiedEmailAddress: 2, Applied: 2, Verified: 1}
+	if stats.Funnel != funnel {
+		t.Fatalf("expected funnel %+v, got: %+v", funnel, stats.Funnel)
+	}
+
+	if stats.EmailVerificationRate != 0.4 {
+		t.Fatalf("expected an email verification rate of 0.4, got: %f", stats.EmailVerificationRate)
+	}
+
+	if byState := stats.ApplicationsByState; byState[ApplicationStateApproved] != 1 || byState[ApplicationStatePending] != 1 || byState[ApplicationStateRejected] != 0 {
+		t.Fatalf("unexpected applications by state: %v", byState)
+	}
+
+}
+
+func TestStatsQueryValidation(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	admin := c.signUp("admin", RoleAdmin)
+	reviewer := c.signUp("reviewer", RoleReviewer)
+
+	now := time.Now()
+	past := now.AddDate(-2, 0, 0)
+	future := now.AddDate(0, 0, 1)
+
+	_, err := c.Stats(StatsQuery{}, reviewer.ID)
+	expectError(t, err, AuthorizationErrorInsufficientPermissions.Error())
+
+	_, err = c.Stats(StatsQuery{Interval: "Year"}, admin.ID)
+	expectError(t, err, "InvalidStatsInterval")
+
+	_, err = c.Stats(StatsQuery{Interval: StatsIntervalDay, From: &future, Until: &now}, admin.ID)
+	expectError(t, err, "InvalidStatsRange")
+
+	_, err = c.Stats(StatsQuery{Interval: StatsIntervalDay, From: &past}, admin.ID)
+	expectError(t, err, "StatsRangeTooLarge")
+
+	stats, err := c.Stats(StatsQuery{Interval: StatsIntervalWeek, From: &past}, admin.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stats.From.Weekday() != time.Monday || !stats.From.After(past.AddDate(0, 0, -7)) {
+		t.Fatalf("expected the range to start on the monday of the week, got: %s", stats.From)
+	}
+
+}
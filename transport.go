@@ -1,5 +1,16 @@
 package community_bl
 
+import vo "github.com/214alphadev/community-bl/value_objects"
+
 type Transport interface {
 	SendConfirmationCode(confirmationCode ConfirmationCode) error
+	// SendEmailAddressChangeRequestedNotification notifies the current address of the member that a change to the new address has been requested.
+	// It's sent before the confirmation code so that a hijacked account can't move away from the owner's address unnoticed.
+	SendEmailAddressChangeRequestedNotification(member MemberEntity, newEmailAddress vo.EmailAddress) error
+	SendEmailAddressChangedNotification(oldEmailAddress vo.EmailAddress, member MemberEntity) error
+	// SendApplicationCommentNotification notifies the recipient about a new comment on the application.
+	// Recipients are the applicant for questions of reviewers and the asking reviewers for answers of the applicant.
+	SendApplicationCommentNotification(recipient MemberEntity, application ApplicationEntity, comment ApplicationCommentEntity) error
+	// SendApplicationEscalationNotification notifies the recipient that the application waits longer than the SLA policy allows
+	SendApplicationEscalationNotification(recipient MemberEntity, application ApplicationEntity) error
 }
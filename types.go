@@ -13,15 +13,82 @@ func (s ApplicationState) Valid() bool {
 		return true
 	case ApplicationStatePending:
 		return true
+	case ApplicationStateInformationRequested:
+		return true
+	case ApplicationStateWithdrawn:
+		return true
+	case ApplicationStateExpired:
+		return true
 	default:
 		return false
 	}
 
 }
 
+// open tells if the application still waits for a decision
+func (s ApplicationState) open() bool {
+	return s == ApplicationStatePending || s == ApplicationStateInformationRequested
+}
+
 var ApplicationStateRejected = ApplicationState("Rejected")
 var ApplicationStateApproved = ApplicationState("Approved")
 var ApplicationStatePending = ApplicationState("Pending")
+var ApplicationStateInformationRequested = ApplicationState("InformationRequested")
+var ApplicationStateWithdrawn = ApplicationState("Withdrawn")
+
+// ApplicationStateExpired is the terminal state of applications that waited longer than the SLA policy allows
+var ApplicationStateExpired = ApplicationState("Expired")
+
+// ConfirmationCodePurpose tells for what a confirmation code has been issued.
+// Codes without a purpose have been issued for a login.
+type ConfirmationCodePurpose string
+
+var ConfirmationCodePurposeLogin = ConfirmationCodePurpose("")
+var ConfirmationCodePurposeEmailChange = ConfirmationCodePurpose("EmailChange")
+
+type SanctionType string
+
+var SanctionTypeSuspension = SanctionType("Suspension")
+var SanctionTypeBan = SanctionType("Ban")
+
+type ReviewDecision string
+
+func (d ReviewDecision) Valid() bool {
+
+	switch d {
+	case ReviewDecisionApprove:
+		return true
+	case ReviewDecisionReject:
+		return true
+	default:
+		return false
+	}
+
+}
+
+var ReviewDecisionApprove = ReviewDecision("Approve")
+var ReviewDecisionReject = ReviewDecision("Reject")
+
+type AppealState string
+
+func (s AppealState) Valid() bool {
+
+	switch s {
+	case AppealStatePending:
+		return true
+	case AppealStateUpheld:
+		return true
+	case AppealStateOverturned:
+		return true
+	default:
+		return false
+	}
+
+}
+
+var AppealStatePending = AppealState("Pending")
+var AppealStateUpheld = AppealState("Upheld")
+var AppealStateOverturned = AppealState("Overturned")
 
 type MemberIdentifier = uuid.UUID
 type ApplicationID = uuid.UUID
@@ -1,7 +1,9 @@
 package value_objects
 
 import (
+	"crypto/rand"
 	"errors"
+	"io"
 	"reflect"
 )
 
@@ -27,3 +29,14 @@ func NewAccessTokenSigningKey(key []byte) (AccessTokenSigningKey, error) {
 		bytes: key,
 	}, nil
 }
+
+func AccessTokenSigningKeyFactory() (AccessTokenSigningKey, error) {
+
+	key := make([]byte, 1024)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return AccessTokenSigningKey{}, err
+	}
+
+	return NewAccessTokenSigningKey(key)
+
+}
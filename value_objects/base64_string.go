@@ -10,6 +10,10 @@ func (s Base64String) String() string {
 	return base64.StdEncoding.EncodeToString(s.data)
 }
 
+func (s Base64String) Bytes() []byte {
+	return s.data
+}
+
 func NewBase64String(str string) (Base64String, error) {
 
 	data, err := base64.StdEncoding.DecodeString(str)
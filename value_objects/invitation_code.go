@@ -0,0 +1,42 @@
+package value_objects
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"regexp"
+)
+
+var invitationCodeRegex = regexp.MustCompile("^[a-f0-9]{32}$")
+
+type InvitationCode struct {
+	code string
+}
+
+func (c InvitationCode) String() string {
+	return c.code
+}
+
+func NewInvitationCode(code string) (InvitationCode, error) {
+
+	if !invitationCodeRegex.MatchString(code) {
+		return InvitationCode{}, fmt.Errorf("invalid invitation code: %s", code)
+	}
+
+	return InvitationCode{
+		code: code,
+	}, nil
+
+}
+
+func InvitationCodeFactory() (InvitationCode, error) {
+
+	bytes := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, bytes); err != nil {
+		return InvitationCode{}, err
+	}
+
+	return NewInvitationCode(hex.EncodeToString(bytes))
+
+}
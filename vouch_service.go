@@ -0,0 +1,224 @@
+package community_bl
+
+import (
+	"errors"
+	"github.com/satori/go.uuid"
+	"time"
+)
+
+// VouchingPolicy configures the approval of applications by the vouches of verified members.
+// Vouches approve an application independently of the ReviewPolicy: neither the quorum nor
+// reject votes below it hold the approval back. A veto still rejects the application right away,
+// as long as it's cast before the application has enough vouches.
+type VouchingPolicy struct {
+	// RequiredVouches is the amount of vouches from distinct verified members
+	// after which an application is approved. Only vouches of members that are still verified,
+	// not deleted and in good standing count. Zero disables vouching.
+	RequiredVouches uint
+	// Limit is the amount of vouches a member can give within LimitPeriod. Revoked
+	// vouches count towards the limit. Zero means unlimited.
+	Limit uint
+	// LimitPeriod is the period the Limit applies to. Zero applies the limit to all vouches ever given.
+	LimitPeriod time.Duration
+}
+
+var VouchErrorVouchingDisabled = errors.New("vouching is disabled")
+var VouchErrorLimitReached = errors.New("vouch limit reached")
+
+type vouchService struct {
+	memberRepository      MemberRepository
+	applicationRepository ApplicationRepository
+	vouchRepository       VouchRepository
+	communityService      *communityService
+	vouchingPolicy        VouchingPolicy
+}
+
+// Vouch vouches for an open application. The application is approved as soon as it has enough vouches,
+// which is reported by the returned bool.
+func (s *vouchService) Vouch(applicationID ApplicationID, voucherID MemberIdentifier) (VouchEntity, bool, error) {
+
+	if s.vouchingPolicy.RequiredVouches == 0 {
+		return VouchEntity{}, false, VouchErrorVouchingDisabled
+	}
+
+	voucher, application, err := s.authorize(applicationID, voucherID)
+	if err != nil {
+		return VouchEntity{}, false, err
+	}
+
+	if application.MemberID == voucher.ID {
+		return VouchEntity{}, false, errors.New("CannotVouchForYourself")
+	}
+
+	vouches, err := s.vouchRepository.FetchByApplication(application.ID)
+	if err != nil {
+		return VouchEntity{}, false, err
+	}
+
+	for _, vouch := range vouches {
+		if vouch.VoucherID == voucher.ID {
+			return VouchEntity{}, false, errors.New("AlreadyVouched")
+		}
+	}
+
+	if s.vouchingPolicy.Limit > 0 {
+
+		since := time.Time{}
+		if s.vouchingPolicy.LimitPeriod > 0 {
+			since = time.Now().Add(-s.vouchingPolicy.LimitPeriod)
+		}
+
+		given, err := s.vouchRepository.FetchByVoucherSince(voucher.ID, since)
+		if err != nil {
+			return VouchEntity{}, false, err
+		}
+
+		if uint(len(given)) >= s.vouchingPolicy.Limit {
+			return VouchEntity{}, false, VouchErrorLimitReached
+		}
+
+	}
+
+	vouch := VouchEntity{
+		ID:            uuid.NewV4(),
+		ApplicationID: application.ID,
+		VoucherID:     voucher.ID,
+		VouchedAt:     time.Now(),
+	}
+
+	if err := s.vouchRepository.Save(vouch); err != nil {
+		return VouchEntity{}, false, err
+	}
+
+	counted, err := s.count(vouches)
+	if err != nil {
+		return VouchEntity{}, false, err
+	}
+
+	approved := counted+1 >= s.vouchingPolicy.RequiredVouches
+
+	if approved {
+		if err := s.communityService.approve(application, []MemberIdentifier{}, voucher.ID, ""); err != nil {
+			return VouchEntity{}, false, err
+		}
+	}
+
+	return vouch, approved, nil
+
+}
+
+// RevokeVouch revokes the vouch of the member as long as the application is open
+func (s *vouchService) RevokeVouch(applicationID ApplicationID, voucherID MemberIdentifier) error {
+
+	voucher, application, err := s.authorize(applicationID, voucherID)
+	if err != nil {
+		return err
+	}
+
+	vouches, err := s.vouchRepository.FetchByApplication(application.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, vouch := range vouches {
+		if vouch.VoucherID != voucher.ID {
+			continue
+		}
+		now := time.Now()
+		vouch.RevokedAt = &now
+		return s.vouchRepository.Save(vouch)
+	}
+
+	return errors.New("VouchDoesNotExist")
+
+}
+
+// Vouches returns the vouches for the application that haven't been revoked
+func (s *vouchService) Vouches(applicationID ApplicationID, requesterID MemberIdentifier) ([]VouchEntity, error) {
+
+	requester, err := s.memberRepository.FetchByID(requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if requester == nil {
+		return nil, errors.New("RequesterNotFound")
+	}
+
+	application, err := s.applicationRepository.FetchByID(applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if application == nil {
+		return nil, errors.New("ApplicationDoesNotExist")
+	}
+
+	if application.MemberID != requester.ID && !requester.HasPermission(PermissionApplicationsRead) {
+		return nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	return s.vouchRepository.FetchByApplication(application.ID)
+
+}
+
+// count returns the amount of vouches whose voucher is still verified, not deleted and in good standing
+func (s *vouchService) count(vouches []VouchEntity) (uint, error) {
+
+	counted := uint(0)
+
+	for _, vouch := range vouches {
+
+		voucher, err := s.memberRepository.FetchByID(vouch.VoucherID)
+		if err != nil {
+			return 0, err
+		}
+
+		if voucher == nil || !voucher.Verified || voucher.DeletedAt != nil || standing(*voucher) != nil {
+			continue
+		}
+
+		counted++
+
+	}
+
+	return counted, nil
+
+}
+
+// authorize makes sure the voucher is a verified member in good standing and the application is still open
+func (s *vouchService) authorize(applicationID ApplicationID, voucherID MemberIdentifier) (*MemberEntity, *ApplicationEntity, error) {
+
+	voucher, err := s.memberRepository.FetchByID(voucherID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if voucher == nil {
+		return nil, nil, errors.New("VoucherDoesNotExist")
+	}
+
+	if !voucher.Verified || voucher.DeletedAt != nil {
+		return nil, nil, AuthorizationErrorInsufficientPermissions
+	}
+
+	if err := standing(*voucher); err != nil {
+		return nil, nil, err
+	}
+
+	application, err := s.applicationRepository.FetchByID(applicationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if application == nil {
+		return nil, nil, errors.New("ApplicationDoesNotExist")
+	}
+
+	if !application.State.open() {
+		return nil, nil, errors.New("ApplicationReviewed")
+	}
+
+	return voucher, application, nil
+
+}
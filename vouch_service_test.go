@@ -0,0 +1,147 @@
+package community_bl
+
+import (
+	"testing"
+	"time"
+)
+
+func withVouchingPolicy(policy VouchingPolicy) func(dependencies *Dependencies) {
+	return func(dependencies *Dependencies) {
+		dependencies.VouchingPolicy = policy
+	}
+}
+
+func TestVouch(t *testing.T) {
+
+	c := newTestCommunity(t, withVouchingPolicy(VouchingPolicy{RequiredVouches: 2}))
+
+	first := c.signUp("first", RoleReviewer)
+	second := c.signUp("second", RoleReviewer)
+	unverified := c.signUp("unverified")
+	applicant := c.signUp("applicant", RoleReviewer)
+	application := c.apply(c.signUp("member"))
+
+	_, err := c.Vouch(application.ID, unverified.ID)
+	expectError(t, err, "InsufficientPermissions")
+
+	if _, err := c.Vouch(application.ID, first.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.Vouch(application.ID, first.ID)
+	expectError(t, err, "AlreadyVouched")
+
+	if c.application(application.ID).State != ApplicationStatePending {
+		t.Fatal("expected the application to wait for the required vouches")
+	}
+
+	if _, err := c.Vouch(application.ID, second.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	approved := c.application(application.ID)
+	if approved.State != ApplicationStateApproved || !c.member(approved.MemberID).Verified {
+		t.Fatal("expected the vouches to approve the application")
+	}
+
+	_, err = c.Vouch(c.apply(applicant).ID, applicant.ID)
+	expectError(t, err, "CannotVouchForYourself")
+
+}
+
+func TestVouchingIsDisabledByDefault(t *testing.T) {
+
+	c := newTestCommunity(t)
+
+	voucher := c.signUp("voucher", RoleReviewer)
+	application := c.apply(c.signUp("applicant"))
+
+	_, err := c.Vouch(application.ID, voucher.ID)
+	if err != VouchErrorVouchingDisabled {
+		t.Fatalf("expected vouching to be disabled, got: %v", err)
+	}
+
+}
+
+func TestOnlyVouchesOfMembersInGoodStandingCount(t *testing.T) {
+
+	c := newTestCommunity(t, withVouchingPolicy(VouchingPolicy{RequiredVouches: 3}))
+
+	admin := c.signUp("admin", RoleAdmin)
+	suspended := c.signUp("suspended", RoleReviewer)
+	deleted := c.signUp("deleted", RoleReviewer)
+	first := c.signUp("first", RoleReviewer)
+	second := c.signUp("second", RoleReviewer)
+	application := c.apply(c.signUp("applicant"))
+
+	for _, voucher := range []MemberEntity{suspended, deleted} {
+		if _, err := c.Vouch(application.ID, voucher.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := c.SuspendMember(suspended.ID, "spam", time.Now().Add(time.Hour), admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.DeleteMember(deleted.ID, deleted.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Vouch(application.ID, first.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.application(application.ID).State != ApplicationStatePending {
+		t.Fatal("expected the vouches of the suspended and the deleted member not to count")
+	}
+
+	// the vouch counts again once the member is back in good standing
+	if err := c.Unsuspend(suspended.ID, "appealed", admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Vouch(application.ID, second.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.application(application.ID).State != ApplicationStateApproved {
+		t.Fatal("expected the vouches to approve the application")
+	}
+
+}
+
+func TestRevokeVouch(t *testing.T) {
+
+	c := newTestCommunity(t, withVouchingPolicy(VouchingPolicy{RequiredVouches: 2, Limit: 1}))
+
+	voucher := c.signUp("voucher", RoleReviewer)
+	application := c.apply(c.signUp("applicant"))
+	other := c.apply(c.signUp("other"))
+
+	expectError(t, c.RevokeVouch(application.ID, voucher.ID), "VouchDoesNotExist")
+
+	if _, err := c.Vouch(application.ID, voucher.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.RevokeVouch(application.ID, voucher.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	vouches, err := c.Vouches(application.ID, voucher.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(vouches) != 0 {
+		t.Fatalf("expected the revoked vouch to be left out, got: %v", vouches)
+	}
+
+	// revoked vouches count towards the limit
+	_, err = c.Vouch(other.ID, voucher.ID)
+	if err != VouchErrorLimitReached {
+		t.Fatalf("expected the vouch limit to be reached, got: %v", err)
+	}
+
+}